@@ -0,0 +1,66 @@
+package animations
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TextAlign is where text effects place their text horizontally.
+// The text is always centered vertically.
+type TextAlign int
+
+const (
+	AlignCenter TextAlign = iota // Center the text (default)
+	AlignLeft                    // Start the text at the left margin
+	AlignRight                   // End the text at the right margin
+)
+
+// TextAligns lists the alignment names in TextAlign order
+var TextAligns = []string{"center", "left", "right"}
+
+// ParseTextAlign returns the alignment named "center", "left" or "right"
+func ParseTextAlign(name string) (TextAlign, bool) {
+	i := slices.Index(TextAligns, name)
+	if i < 0 {
+		return AlignCenter, false
+	}
+	return TextAlign(i), true
+}
+
+// ErrTextTooLarge is returned by the checked text effect constructors when
+// the text doesn't fit the canvas
+var ErrTextTooLarge = errors.New("text does not fit the canvas")
+
+// checkTextFits returns an error wrapping ErrTextTooLarge if text placed
+// with align and margin would be cut off by a width x height canvas
+func checkTextFits(text string, width, height int, align TextAlign, margin int) error {
+	lines := strings.Split(text, "\n")
+	textWidth := 0
+	for _, line := range lines {
+		textWidth = max(textWidth, displayWidth(line))
+	}
+
+	room := width
+	if align != AlignCenter {
+		room -= max(margin, 0)
+	}
+	if textWidth > room || len(lines) > height {
+		return fmt.Errorf("%w: %dx%d text, %dx%d canvas", ErrTextTooLarge, textWidth, len(lines), room, height)
+	}
+	return nil
+}
+
+// startX returns the first column of a line, or block of lines, lineWidth
+// wide in a canvas width wide. Margin columns are kept clear at the aligned
+// edge; centered text has equal space on both sides and ignores it.
+func (a TextAlign) startX(width, lineWidth, margin int) int {
+	switch a {
+	case AlignLeft:
+		return margin
+	case AlignRight:
+		return width - margin - lineWidth
+	}
+	return (width - lineWidth) / 2
+}
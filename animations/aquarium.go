@@ -1,12 +1,10 @@
 package animations
 
 import (
+	"io"
 	"math"
 	"math/rand"
-	"strings"
-	"time"
-
-	"github.com/charmbracelet/lipgloss/v2"
+	"sort"
 )
 
 // AquariumEffect implements an animated aquarium scene
@@ -22,11 +20,25 @@ type AquariumEffect struct {
 	boat    *Boat
 	mermaid *Mermaid
 	anchor  *Anchor
+	chest   *Chest
 
 	// Spawn timers (in frames, 20fps)
 	lastMediumFishSpawn int
 	lastLargeFishSpawn  int
 	lastMermaidSpawn    int
+	lastChestOpen       int
+
+	// Entity caps and spawn intervals
+	maxFish            int
+	maxBubbles         int
+	mediumFishInterval int
+	largeFishInterval  int
+	mermaidInterval    int
+	chestInterval      int
+
+	// Ocean surface waves
+	waveAmplitude int
+	waveSpeed     float64
 
 	// Theme colors
 	waterColors   []string
@@ -36,9 +48,11 @@ type AquariumEffect struct {
 	diverColor    string
 	boatColor     string
 	mermaidColor  string
+	chestColor    string
 
 	frameCount int
 	rng        *rand.Rand
+	buf        frameBuffer // Canvas reused between frames
 }
 
 // Fish represents a swimming fish
@@ -51,6 +65,7 @@ type Fish struct {
 	pattern   []string // Multi-line pattern
 	color     string
 	swimPhase float64
+	depth     float64 // 0=far (dim, slow), 1=near (bright, fast)
 }
 
 // Seaweed represents swaying underwater plants
@@ -111,6 +126,15 @@ type Anchor struct {
 	pattern []string
 }
 
+// Chest represents a treasure chest on the ocean floor that opens to release bubbles
+type Chest struct {
+	x             int
+	y             int
+	openFrames    int // Frames left before the lid closes, 0 when closed
+	closedPattern []string
+	openPattern   []string
+}
+
 // AquariumConfig holds configuration for the aquarium effect
 type AquariumConfig struct {
 	Width         int
@@ -123,11 +147,57 @@ type AquariumConfig struct {
 	BoatColor     string
 	MermaidColor  string
 	AnchorColor   string
+	ChestColor    string
+
+	MaxFish            int // Most small fish at once (default 30)
+	MaxBubbles         int // Most bubbles at once (default 40)
+	MediumFishInterval int // Frames between medium fish, plus up to a third more (default 300)
+	LargeFishInterval  int // Frames between large fish (default 700)
+	MermaidInterval    int // Frames between mermaids, plus up to half more (default 2400)
+	ChestInterval      int // Frames between chest openings, plus up to half more (default 600)
+
+	WaveAmplitude int     // Surface wave height in rows, 0 keeps the surface flat
+	WaveSpeed     float64 // Surface wave phase change per frame (default 0.1)
+	Seed          int64   // Random seed for reproducible output, 0 = seeded from the clock
 }
 
 // NewAquariumEffect creates a new aquarium effect
 func NewAquariumEffect(config AquariumConfig) *AquariumEffect {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng := newRNG(config.Seed)
+
+	// Set defaults
+	maxFish := config.MaxFish
+	if maxFish <= 0 {
+		maxFish = 30
+	}
+	maxBubbles := config.MaxBubbles
+	if maxBubbles <= 0 {
+		maxBubbles = 40
+	}
+	mediumFishInterval := config.MediumFishInterval
+	if mediumFishInterval <= 0 {
+		mediumFishInterval = 300 // 15-20 seconds at 20fps
+	}
+	largeFishInterval := config.LargeFishInterval
+	if largeFishInterval <= 0 {
+		largeFishInterval = 700 // 35 seconds at 20fps
+	}
+	mermaidInterval := config.MermaidInterval
+	if mermaidInterval <= 0 {
+		mermaidInterval = 2400 // 2-3 minutes at 20fps
+	}
+	chestInterval := config.ChestInterval
+	if chestInterval <= 0 {
+		chestInterval = 600 // 30-45 seconds at 20fps
+	}
+	waveSpeed := config.WaveSpeed
+	if waveSpeed == 0 {
+		waveSpeed = 0.1
+	}
+	chestColor := config.ChestColor
+	if chestColor == "" {
+		chestColor = "#ffd700"
+	}
 
 	a := &AquariumEffect{
 		width:         config.Width,
@@ -139,8 +209,18 @@ func NewAquariumEffect(config AquariumConfig) *AquariumEffect {
 		diverColor:    config.DiverColor,
 		boatColor:     config.BoatColor,
 		mermaidColor:  config.MermaidColor,
+		chestColor:    chestColor,
 		frameCount:    0,
 		rng:           rng,
+
+		maxFish:            maxFish,
+		maxBubbles:         maxBubbles,
+		mediumFishInterval: mediumFishInterval,
+		largeFishInterval:  largeFishInterval,
+		mermaidInterval:    mermaidInterval,
+		chestInterval:      chestInterval,
+		waveAmplitude:      config.WaveAmplitude,
+		waveSpeed:          waveSpeed,
 	}
 
 	a.init()
@@ -153,7 +233,7 @@ func (a *AquariumEffect) init() {
 	seaweedCount := a.width / 8
 	for i := 0; i < seaweedCount; i++ {
 		x := a.rng.Intn(a.width)
-		height := 3 + a.rng.Intn(a.height/3)
+		height := 3 + a.rng.Intn(max(a.height/3, 1))
 		variant := a.rng.Intn(2) // 0=straight, 1=wavy
 
 		a.seaweed = append(a.seaweed, Seaweed{
@@ -180,13 +260,13 @@ func (a *AquariumEffect) init() {
 	}
 
 	// Create initial fish (mostly small/tiny)
-	fishCount := 8 + a.rng.Intn(15)
+	fishCount := min(8+a.rng.Intn(15), a.maxFish)
 	for i := 0; i < fishCount; i++ {
 		a.spawnFish()
 	}
 
 	// Create initial bubbles (increased count)
-	bubbleCount := 15 + a.rng.Intn(10)
+	bubbleCount := min(15+a.rng.Intn(10), a.maxBubbles)
 	for i := 0; i < bubbleCount; i++ {
 		a.spawnBubble()
 	}
@@ -211,7 +291,7 @@ func (a *AquariumEffect) init() {
 	}
 
 	a.boat = &Boat{
-		x:         float64(a.rng.Intn(a.width)),
+		x:         float64(a.rng.Intn(max(a.width, 1))),
 		y:         float64(oceanY - boatHeight), // Above ocean surface
 		speed:     0.4,
 		direction: boatDirection,
@@ -228,10 +308,20 @@ func (a *AquariumEffect) init() {
 		pattern: anchorPattern,
 	}
 
+	// Create treasure chest on ocean floor, left of the anchor
+	closedPattern, openPattern := a.getChestPatterns()
+	a.chest = &Chest{
+		x:             a.width/4 - 5,
+		y:             a.height - len(closedPattern) - 1,
+		closedPattern: closedPattern,
+		openPattern:   openPattern,
+	}
+
 	// Initialize spawn timers
 	a.lastMediumFishSpawn = -1000 // Allow immediate spawn
 	a.lastLargeFishSpawn = -1000  // Allow immediate spawn
 	a.lastMermaidSpawn = -1000    // Allow immediate spawn
+	a.lastChestOpen = 0           // Stay closed for the first interval
 }
 
 // spawnFish creates a new fish at a random or edge position (tiny/small only)
@@ -265,7 +355,9 @@ func (a *AquariumEffect) spawnFish() {
 		speed *= 1.5 // Small fish faster
 	}
 
-	color := a.fishColors[a.rng.Intn(len(a.fishColors))]
+	// Small fish fill every depth layer
+	depth := a.rng.Float64()
+	color := a.depthColor(a.fishColors[a.rng.Intn(len(a.fishColors))], depth)
 
 	oceanY := int(float64(a.height) * 0.15)
 	minY := oceanY + 2
@@ -276,12 +368,13 @@ func (a *AquariumEffect) spawnFish() {
 
 	fish := Fish{
 		x:         x,
-		y:         float64(minY + a.rng.Intn(maxY-minY)),
-		speed:     speed,
+		y:         a.spawnRow(minY, maxY),
+		speed:     speed * depthSpeed(depth),
 		size:      size,
 		direction: direction,
 		color:     color,
 		swimPhase: a.rng.Float64() * math.Pi * 2,
+		depth:     depth,
 		pattern:   a.getFishPattern(size, direction),
 	}
 
@@ -295,15 +388,21 @@ func (a *AquariumEffect) spawnMediumFish() {
 		direction = 1
 	}
 
+	// Right-facing art is as wide as the mirrored left-facing art, so start
+	// it fully off the left edge
+	pattern := a.getFishPattern(2, direction)
 	var x float64
 	if direction == 1 {
-		x = -15
+		x = -float64(patternWidth(pattern))
 	} else {
 		x = float64(a.width + 15)
 	}
 
 	speed := 0.4 + a.rng.Float64()*0.8
-	color := a.fishColors[a.rng.Intn(len(a.fishColors))]
+
+	// Medium fish stay in the middle to near layers
+	depth := 0.5 + a.rng.Float64()*0.5
+	color := a.depthColor(a.fishColors[a.rng.Intn(len(a.fishColors))], depth)
 
 	oceanY := int(float64(a.height) * 0.15)
 	minY := oceanY + 2
@@ -311,13 +410,14 @@ func (a *AquariumEffect) spawnMediumFish() {
 
 	fish := Fish{
 		x:         x,
-		y:         float64(minY + a.rng.Intn(maxY-minY)),
-		speed:     speed,
+		y:         a.spawnRow(minY, maxY),
+		speed:     speed * depthSpeed(depth),
 		size:      2, // Medium
 		direction: direction,
 		color:     color,
 		swimPhase: a.rng.Float64() * math.Pi * 2,
-		pattern:   a.getFishPattern(2, direction),
+		depth:     depth,
+		pattern:   pattern,
 	}
 
 	a.fish = append(a.fish, fish)
@@ -330,15 +430,21 @@ func (a *AquariumEffect) spawnLargeFish() {
 		direction = 1
 	}
 
+	// Right-facing art is as wide as the mirrored left-facing art, so start
+	// it fully off the left edge
+	pattern := a.getFishPattern(3, direction)
 	var x float64
 	if direction == 1 {
-		x = -20
+		x = -float64(patternWidth(pattern))
 	} else {
 		x = float64(a.width + 20)
 	}
 
 	speed := 0.3 + a.rng.Float64()*0.5
-	color := a.fishColors[a.rng.Intn(len(a.fishColors))]
+
+	// Large fish are always close to the glass
+	depth := 0.7 + a.rng.Float64()*0.3
+	color := a.depthColor(a.fishColors[a.rng.Intn(len(a.fishColors))], depth)
 
 	oceanY := int(float64(a.height) * 0.15)
 	minY := oceanY + 5
@@ -346,18 +452,42 @@ func (a *AquariumEffect) spawnLargeFish() {
 
 	fish := Fish{
 		x:         x,
-		y:         float64(minY + a.rng.Intn(maxY-minY)),
-		speed:     speed,
+		y:         a.spawnRow(minY, maxY),
+		speed:     speed * depthSpeed(depth),
 		size:      3, // Large
 		direction: direction,
 		color:     color,
 		swimPhase: a.rng.Float64() * math.Pi * 2,
-		pattern:   a.getFishPattern(3, direction),
+		depth:     depth,
+		pattern:   pattern,
 	}
 
 	a.fish = append(a.fish, fish)
 }
 
+// spawnRow picks a row in [minY, maxY) to spawn a fish or bubble on. Tanks
+// too short for the range spawn it on minY instead.
+func (a *AquariumEffect) spawnRow(minY, maxY int) float64 {
+	if maxY <= minY {
+		return float64(minY)
+	}
+	return float64(minY + a.rng.Intn(maxY-minY))
+}
+
+// depthColor dims a fish color toward the water color the farther away it swims
+func (a *AquariumEffect) depthColor(color string, depth float64) string {
+	waterColor := "#4a9eff"
+	if len(a.waterColors) > 0 {
+		waterColor = a.waterColors[0]
+	}
+	return blendColor(color, waterColor, (1-depth)*0.6)
+}
+
+// depthSpeed scales fish speed by depth: far fish move at 0.6x, near fish at 1.4x
+func depthSpeed(depth float64) float64 {
+	return 0.6 + depth*0.8
+}
+
 // getFishPattern returns ASCII art for a fish based on size and direction
 func (a *AquariumEffect) getFishPattern(size int, direction int) []string {
 	var pattern []string
@@ -391,80 +521,64 @@ func (a *AquariumEffect) getFishPattern(size int, direction int) []string {
 		}
 
 	case 2: // Medium fish
-		if direction == -1 { // Left-facing
-			mediumPatterns := [][]string{
-				{
-					"          ,,////,",
-					"        _////////_",
-					"      .' -,  / / /`'-._     _.-'|",
-					"     / _  \\\\/ / / / /  ',.='_.'/",
-					"    / (o)  ||/_/_/_/_/_/_.-'_.'",
-					"  .'       ||\\ \\ \\ \\ \\ \\ '-._'.",
-					" '.--.    //\\ \\ \\ \\ \\  .'\"-._ '.",
-					"   `'-.\\ \\   \\ \\ \\__.-'\\)    '-.|",
-					"       \\\\)`\"\"\"\"\"` ",
-					"        `",
-				},
-				{
-					"                ,      /",
-					"             . ~ ~ . ,/{",
-					"           .'@ ))ejm'~.~",
-					"           = - ~``   ",
-				},
-			}
-			pattern = mediumPatterns[a.rng.Intn(len(mediumPatterns))]
-		} else { // Right-facing
-			// Simple right-facing medium fish
-			pattern = []string{
-				"\\o    o",
-				" \\     \\",
-				"  )=====>",
-				" /     /",
-				"/o    o",
-			}
+		mediumPatterns := [][]string{
+			{
+				"          ,,////,",
+				"        _////////_",
+				"      .' -,  / / /`'-._     _.-'|",
+				"     / _  \\\\/ / / / /  ',.='_.'/",
+				"    / (o)  ||/_/_/_/_/_/_.-'_.'",
+				"  .'       ||\\ \\ \\ \\ \\ \\ '-._'.",
+				" '.--.    //\\ \\ \\ \\ \\  .'\"-._ '.",
+				"   `'-.\\ \\   \\ \\ \\__.-'\\)    '-.|",
+				"       \\\\)`\"\"\"\"\"` ",
+				"        `",
+			},
+			{
+				"                ,      /",
+				"             . ~ ~ . ,/{",
+				"           .'@ ))ejm'~.~",
+				"           = - ~``   ",
+			},
+		}
+		pattern = mediumPatterns[a.rng.Intn(len(mediumPatterns))]
+		if direction == 1 { // Right-facing
+			pattern = mirrorPattern(pattern)
 		}
 
 	case 3: // Large fish
-		if direction == -1 { // Left-facing
-			largePatterns := [][]string{
-				{
-					"                 __,",
-					"               .-'_-'`",
-					"             .' {`",
-					"         .-'````'-.    .-'``'.",
-					"       .'(0)       '._/ _.-.  `\\",
-					"      }     '. ))    _<`    )`  |",
-					"       `-.,\\'.\\_, -\\` \\`---; .' /",
-					"            )  )       '-.  '--:",
-					"           ( ' (          ) '.  \\",
-					"            '.  )      .'(   /   )",
-					"              )/      (   '.    /",
-					"                       '._( ) .'",
-					"                           ( (",
-					"                            `-.",
-				},
-				{
-					"    o   o",
-					"                  /^^^^^7",
-					"    '  '     ,oO))))))))Oo,",
-					"           ,'))))))))))))))), /{",
-					"      '  ,'o  ))))))))))))))))={",
-					"         >    ))))))))))))))))={",
-					"         `,   ))))))\\\\\\)))))))={ ",
-					"           ',))))))))\\/)))))' \\{",
-					"             '*O))))))))O*'",
-				},
-			}
-			pattern = largePatterns[a.rng.Intn(len(largePatterns))]
-		} else { // Right-facing (no large right-facing in fish.txt, use reversed positions visually)
-			// For now, just use a simple right-facing large fish
-			pattern = []string{
-				"    __,",
-				"   / - \\",
-				"  (  O  )======>",
-				"   \\ - /",
-				"    `-'",
-			}
+		largePatterns := [][]string{
+			{
+				"                 __,",
+				"               .-'_-'`",
+				"             .' {`",
+				"         .-'````'-.    .-'``'.",
+				"       .'(0)       '._/ _.-.  `\\",
+				"      }     '. ))    _<`    )`  |",
+				"       `-.,\\'.\\_, -\\` \\`---; .' /",
+				"            )  )       '-.  '--:",
+				"           ( ' (          ) '.  \\",
+				"            '.  )      .'(   /   )",
+				"              )/      (   '.    /",
+				"                       '._( ) .'",
+				"                           ( (",
+				"                            `-.",
+			},
+			{
+				"    o   o",
+				"                  /^^^^^7",
+				"    '  '     ,oO))))))))Oo,",
+				"           ,'))))))))))))))), /{",
+				"      '  ,'o  ))))))))))))))))={",
+				"         >    ))))))))))))))))={",
+				"         `,   ))))))\\\\\\)))))))={ ",
+				"           ',))))))))\\/)))))' \\{",
+				"             '*O))))))))O*'",
+			},
+		}
+		pattern = largePatterns[a.rng.Intn(len(largePatterns))]
+		if direction == 1 { // Right-facing
+			pattern = mirrorPattern(pattern)
 		}
 	}
 
@@ -541,6 +655,28 @@ func (a *AquariumEffect) getAnchorPattern() []string {
 	}
 }
 
+// getChestPatterns returns ASCII art for a closed and an open treasure chest.
+// Both have the same height so the chest stays on the floor when it opens.
+func (a *AquariumEffect) getChestPatterns() ([]string, []string) {
+	closed := []string{
+		"",
+		"",
+		"  _______",
+		" /______/|",
+		"|  [o]  ||",
+		"|_______|/",
+	}
+	open := []string{
+		"  _______",
+		" |  ___  |",
+		" |_/   \\_|",
+		" /$$$$$$/|",
+		"|  [o]  ||",
+		"|_______|/",
+	}
+	return closed, open
+}
+
 // getMermaidPattern returns ASCII art for a mermaid
 func (a *AquariumEffect) getMermaidPattern() []string {
 	return []string{
@@ -562,9 +698,14 @@ func (a *AquariumEffect) spawnBubble() {
 	minY := oceanY + 2
 	maxY := a.height - 1
 
+	a.spawnBubbleAt(float64(a.rng.Intn(max(a.width, 1))), a.spawnRow(minY, maxY))
+}
+
+// spawnBubbleAt creates a new bubble at the given position
+func (a *AquariumEffect) spawnBubbleAt(x, y float64) {
 	a.bubbles = append(a.bubbles, Bubble{
-		x:         float64(a.rng.Intn(a.width)),
-		y:         float64(minY + a.rng.Intn(maxY-minY)),
+		x:         x,
+		y:         y,
 		speed:     0.2 + a.rng.Float64()*0.3,
 		wobble:    a.rng.Float64() * math.Pi * 2,
 		wobbleAmt: 0.3 + a.rng.Float64()*0.3,
@@ -622,6 +763,11 @@ func (a *AquariumEffect) Update() {
 		// Add slight vertical bobbing
 		fish.y += math.Sin(fish.swimPhase) * 0.1
 
+		// Keep fish under the moving surface
+		if a.waveAmplitude > 0 {
+			fish.y = math.Max(fish.y, float64(a.surfaceTop(int(fish.x), patternWidth(fish.pattern))+1))
+		}
+
 		// Remove fish that swim off screen
 		if (fish.direction == 1 && fish.x > float64(a.width+30)) ||
 			(fish.direction == -1 && fish.x < -30) {
@@ -630,7 +776,6 @@ func (a *AquariumEffect) Update() {
 	}
 
 	// Update bubbles
-	oceanY := int(float64(a.height) * 0.15)
 	for i := len(a.bubbles) - 1; i >= 0; i-- {
 		bubble := &a.bubbles[i]
 
@@ -642,7 +787,7 @@ func (a *AquariumEffect) Update() {
 		bubble.x += math.Sin(bubble.wobble) * bubble.wobbleAmt
 
 		// Remove bubbles that reach ocean surface
-		if bubble.y < float64(oceanY) {
+		if bubble.y < float64(a.surfaceY(int(bubble.x))) {
 			a.bubbles = append(a.bubbles[:i], a.bubbles[i+1:]...)
 		}
 	}
@@ -718,53 +863,83 @@ func (a *AquariumEffect) Update() {
 	}
 
 	// Spawn new tiny/small fish regularly
-	if a.frameCount%25 == 0 && len(a.fish) < 30 {
+	if a.frameCount%25 == 0 && len(a.fish) < a.maxFish {
 		a.spawnFish()
 	}
 
-	// Spawn medium fish (max 1, every 15-20 seconds)
-	// 15-20 seconds at 20fps = 300-400 frames
-	if mediumCount == 0 && a.frameCount-a.lastMediumFishSpawn >= 300+a.rng.Intn(100) {
+	// Spawn medium fish (max 1, every interval plus up to a third)
+	if mediumCount == 0 && a.frameCount-a.lastMediumFishSpawn >= a.mediumFishInterval+a.rng.Intn(a.mediumFishInterval/3+1) {
 		a.spawnMediumFish()
 		a.lastMediumFishSpawn = a.frameCount
 	}
 
-	// Spawn large fish (max 1, every 35 seconds)
-	// 35 seconds at 20fps = 700 frames
-	if largeCount == 0 && a.frameCount-a.lastLargeFishSpawn >= 700 {
+	// Spawn large fish (max 1, every interval)
+	if largeCount == 0 && a.frameCount-a.lastLargeFishSpawn >= a.largeFishInterval {
 		a.spawnLargeFish()
 		a.lastLargeFishSpawn = a.frameCount
 	}
 
-	// Spawn mermaid (every 2-3 minutes if not present)
-	// 2-3 minutes at 20fps = 2400-3600 frames
+	// Spawn mermaid (every interval plus up to half if not present)
 	// Mermaid and diver are mutually exclusive
-	if a.mermaid == nil && a.frameCount-a.lastMermaidSpawn >= 2400+a.rng.Intn(1200) {
+	if a.mermaid == nil && a.frameCount-a.lastMermaidSpawn >= a.mermaidInterval+a.rng.Intn(a.mermaidInterval/2+1) {
 		a.spawnMermaid()
 		a.lastMermaidSpawn = a.frameCount
 		// Remove diver when mermaid appears
 		a.diver = nil
 	}
 
+	// Open the chest every interval plus up to half, releasing a burst of bubbles
+	if a.chest != nil {
+		if a.chest.openFrames > 0 {
+			a.chest.openFrames--
+			if a.chest.openFrames%3 == 0 {
+				// Bubbles rise out of the gold in the chest's mouth
+				a.spawnBubbleAt(float64(a.chest.x+2+a.rng.Intn(6)), float64(a.chest.y+2))
+			}
+		} else if a.frameCount-a.lastChestOpen >= a.chestInterval+a.rng.Intn(a.chestInterval/2+1) {
+			a.chest.openFrames = 60 // Stay open for 3 seconds
+			a.lastChestOpen = a.frameCount
+		}
+	}
+
 	// Spawn bubbles more frequently (increased count)
-	if a.frameCount%15 == 0 && len(a.bubbles) < 40 {
+	if a.frameCount%15 == 0 && len(a.bubbles) < a.maxBubbles {
 		a.spawnBubble()
 	}
 }
 
+// Stats reports the frame count and how many creatures are on screen
+func (a *AquariumEffect) Stats() Stats {
+	counts := map[string]int{
+		"fish":    len(a.fish),
+		"bubbles": len(a.bubbles),
+		"seaweed": len(a.seaweed),
+	}
+	if a.diver != nil {
+		counts["divers"] = 1
+	}
+	if a.boat != nil {
+		counts["boats"] = 1
+	}
+	if a.mermaid != nil {
+		counts["mermaids"] = 1
+	}
+	return Stats{Frame: a.frameCount, Counts: counts}
+}
+
 // Render converts the aquarium to colored text output
 func (a *AquariumEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, a.height)
-	colors := make([][]string, a.height)
-	for i := range canvas {
-		canvas[i] = make([]rune, a.width)
-		colors[i] = make([]string, a.width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
-			colors[i][j] = ""
-		}
-	}
+	return renderCells(a.RenderCells())
+}
+
+// WriteTo streams the aquarium to w, see io.WriterTo
+func (a *AquariumEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, a.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (a *AquariumEffect) RenderCells() [][]Cell {
+	canvas, colors := a.buf.clear(a.width, a.height)
 
 	// Draw ocean surface at 15% from top
 	waterColor := "#4a9eff"
@@ -777,8 +952,11 @@ func (a *AquariumEffect) Render() string {
 	}
 	for x := 0; x < a.width; x++ {
 		if (a.frameCount/2+x)%3 == 0 {
-			canvas[oceanY][x] = '~'
-			colors[oceanY][x] = waterColor
+			y := a.surfaceY(x)
+			if y >= 0 && y < a.height {
+				canvas[y][x] = '~'
+				colors[y][x] = waterColor
+			}
 		}
 	}
 
@@ -787,7 +965,7 @@ func (a *AquariumEffect) Render() string {
 	if len(a.waterColors) > 1 {
 		sandColor = a.waterColors[1]
 	}
-	for y := a.height - 2; y < a.height; y++ {
+	for y := max(a.height-2, 0); y < a.height; y++ {
 		for x := 0; x < a.width; x++ {
 			if y == a.height-2 {
 				// Top of ocean floor with variation
@@ -861,6 +1039,27 @@ func (a *AquariumEffect) Render() string {
 		}
 	}
 
+	// Draw treasure chest (static on ocean floor)
+	if a.chest != nil {
+		pattern := a.chest.closedPattern
+		if a.chest.openFrames > 0 {
+			pattern = a.chest.openPattern
+		}
+
+		for lineIdx, line := range pattern {
+			y := a.chest.y + lineIdx
+			if y >= 0 && y < a.height {
+				for charIdx, char := range []rune(line) {
+					x := a.chest.x + charIdx
+					if x >= 0 && x < a.width && char != ' ' {
+						canvas[y][x] = char
+						colors[y][x] = a.chestColor
+					}
+				}
+			}
+		}
+	}
+
 	// Draw bubbles
 	for _, bubble := range a.bubbles {
 		x := int(bubble.x)
@@ -929,8 +1128,17 @@ func (a *AquariumEffect) Render() string {
 		}
 	}
 
-	// Draw fish (on top of everything else)
-	for _, fish := range a.fish {
+	// Draw fish (on top of everything else), far fish first so near ones occlude them
+	order := make([]int, len(a.fish))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return a.fish[order[i]].depth < a.fish[order[j]].depth
+	})
+
+	for _, idx := range order {
+		fish := a.fish[idx]
 		startX := int(fish.x)
 		startY := int(fish.y)
 
@@ -948,25 +1156,30 @@ func (a *AquariumEffect) Render() string {
 		}
 	}
 
-	// Convert to colored string
-	var lines []string
-	for y := 0; y < a.height; y++ {
-		var line strings.Builder
-		for x := 0; x < a.width; x++ {
-			char := canvas[y][x]
-			if char != ' ' && colors[y][x] != "" {
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(colors[y][x])).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
-			}
-		}
-		lines = append(lines, line.String())
+	return a.buf.toCells()
+}
+
+// surfaceY returns the row of the ocean surface at column x
+func (a *AquariumEffect) surfaceY(x int) int {
+	oceanY := int(float64(a.height) * 0.15) // 15% from top
+	if oceanY < 2 {
+		oceanY = 2
+	}
+	if a.waveAmplitude == 0 {
+		return oceanY
 	}
 
-	return strings.Join(lines, "\n")
+	phase := float64(a.frameCount)*a.waveSpeed + float64(x)*0.15
+	return oceanY + int(math.Round(float64(a.waveAmplitude)*math.Sin(phase)))
+}
+
+// surfaceTop returns the deepest surface row over width columns starting at x
+func (a *AquariumEffect) surfaceTop(x, width int) int {
+	top := a.surfaceY(x)
+	for i := 1; i < width; i++ {
+		top = max(top, a.surfaceY(x+i))
+	}
+	return top
 }
 
 // Reset restarts the animation
@@ -985,11 +1198,11 @@ func (a *AquariumEffect) Resize(width, height int) {
 	a.Reset()
 }
 
-// Helper function to reverse a string
-func reverseString(s string) string {
-	runes := []rune(s)
-	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
-		runes[i], runes[j] = runes[j], runes[i]
+// patternWidth returns the width in runes of the widest line of a pattern
+func patternWidth(pattern []string) int {
+	width := 0
+	for _, line := range pattern {
+		width = max(width, len([]rune(line)))
 	}
-	return string(runes)
+	return width
 }
@@ -0,0 +1,24 @@
+package animations
+
+import "testing"
+
+func TestAquarium_ShortTank(t *testing.T) {
+	theme, _ := GetTheme("dracula")
+	a := NewAquariumEffect(AquariumConfig{
+		Width:         40,
+		Height:        12,
+		FishColors:    theme.AquariumFishColors,
+		WaterColors:   theme.AquariumWaterColors,
+		SeaweedColors: theme.AquariumSeaweedColors,
+		Seed:          1,
+	})
+	// Medium and large fish spawn within the first frames, and both used to
+	// pick their row from an empty range on a tank this short
+	for range 1000 {
+		a.Update()
+		a.RenderCells()
+	}
+	if a.Stats().Frame != 1000 {
+		t.Errorf("frame = %d, want 1000", a.Stats().Frame)
+	}
+}
@@ -0,0 +1,47 @@
+package animations
+
+import "math"
+
+// artSettleFrames is how long matrix-art and rain-art take to fade the
+// revealed art into its final colors
+const artSettleFrames = 20
+
+// artReveal is the completion phase shared by matrix-art and rain-art.
+// After revealFrames of falling characters the rest of the art appears,
+// the falling characters drain away and the art fades into its final
+// gradient, then it holds for holdFrames before the effect reports Done.
+type artReveal struct {
+	revealFrames int       // Frames before the art settles, 0 = loop forever
+	holdFrames   int       // Frames the settled art holds before Done
+	final        *Gradient // Final colors from the top of the art to the bottom, nil = keep the frozen colors
+}
+
+// newArtReveal creates the completion phase for an art effect
+func newArtReveal(revealFrames, holdFrames int, finalStops []string) artReveal {
+	a := artReveal{revealFrames: revealFrames, holdFrames: holdFrames}
+	if len(finalStops) > 0 {
+		a.final = NewGradient(finalStops, 32)
+	}
+	return a
+}
+
+// settling reports whether the art has started settling by frame
+func (a artReveal) settling(frame int) bool {
+	return a.revealFrames > 0 && frame >= a.revealFrames
+}
+
+// done reports whether the art has settled and held by frame
+func (a artReveal) done(frame int) bool {
+	return a.settling(frame) && frame >= a.revealFrames+artSettleFrames+a.holdFrames
+}
+
+// color returns the color of an art character at frame, blending its frozen
+// color into the final gradient at row of an art block height rows tall
+func (a artReveal) color(frozen string, frame, row, height int) string {
+	if !a.settling(frame) || a.final == nil {
+		return frozen
+	}
+	final := a.final.At(float64(row) / float64(max(height-1, 1)))
+	t := math.Min(float64(frame-a.revealFrames)/artSettleFrames, 1)
+	return formatHexColor(mixRGB(parseHexColor(frozen), parseHexColor(final), t, Oklab))
+}
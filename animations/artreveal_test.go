@@ -0,0 +1,56 @@
+package animations
+
+import "testing"
+
+// artEffect is the part of matrix-art and rain-art the reveal test needs
+type artEffect interface {
+	Update()
+	Done() bool
+	Stats() Stats
+}
+
+func TestArtEffects_Reveal(t *testing.T) {
+	const text = "SYSC\nGO"
+	palette := []string{"#003300", "#00ff00"}
+	final := []string{"#ffffff", "#ff00ff"}
+
+	effects := map[string]artEffect{
+		"matrix-art": NewMatrixArtEffectWithConfig(MatrixArtConfig{
+			Width: 30, Height: 10, Palette: palette, Text: text, Seed: 1,
+			RevealFrames: 10, HoldFrames: 5, FinalGradientStops: final,
+		}),
+		"rain-art": NewRainArtEffectWithConfig(RainArtConfig{
+			Width: 30, Height: 10, Palette: palette, Text: text, Seed: 1,
+			RevealFrames: 10, HoldFrames: 5, FinalGradientStops: final,
+		}),
+	}
+
+	for name, effect := range effects {
+		t.Run(name, func(t *testing.T) {
+			// Reveal, settle and hold take 10 + 20 + 5 frames
+			for frame := 1; frame <= 35; frame++ {
+				if effect.Done() {
+					t.Fatalf("done before frame %d", frame)
+				}
+				effect.Update()
+			}
+			if !effect.Done() {
+				t.Fatal("not done after the hold")
+			}
+
+			stats := effect.Stats()
+			if got := stats.Counts["frozen"]; got != 6 {
+				t.Errorf("%d art characters revealed, want 6", got)
+			}
+
+			// The falling characters drain away instead of recycling
+			for i := 0; i < 200; i++ {
+				effect.Update()
+			}
+			stats = effect.Stats()
+			if falling := stats.Counts["streaks"] + stats.Counts["drops"]; falling != 0 {
+				t.Errorf("%d falling characters left after the reveal", falling)
+			}
+		})
+	}
+}
@@ -2,13 +2,11 @@ package animations
 
 import (
 	"fmt"
+	"io"
 	"math"
 	"math/rand"
 	"sort"
 	"strings"
-	"time"
-
-	"github.com/charmbracelet/lipgloss/v2"
 )
 
 // BeamsEffect implements beams as a full-screen background animation
@@ -29,13 +27,15 @@ type BeamsEffect struct {
 	finalGradientSteps   int
 	finalGradientFrames  int
 	finalWipeSpeed       int
+	finalWipeDirection   string
+	beamTrailLength      int
+	backgroundMode       bool
 
 	// Character data
 	Chars []BeamCharacter
 
-	// Beam groups
-	rowGroups    []BeamGroup
-	columnGroups []BeamGroup
+	// Row and column beam groups, in shuffled activation order
+	allGroups []BeamGroup
 
 	// Final wipe diagonal groups
 	diagonalGroups [][]int
@@ -49,8 +49,12 @@ type BeamsEffect struct {
 	holdCounter    int // Current hold frame count
 
 	rng *rand.Rand
+	buf frameBuffer // Canvas reused between frames
 }
 
+// beamsGlowSymbol marks the grid points lit by BeamsEffect's final wipe
+const beamsGlowSymbol = '·'
+
 // BeamCharacter represents a single character in the beams animation
 type BeamCharacter struct {
 	original rune
@@ -66,6 +70,7 @@ type BeamCharacter struct {
 	beamGradient     []string
 	fadeGradient     []string
 	brightenGradient []string
+	word             int // Index of the word this character belongs to
 }
 
 // BeamGroup represents a group of characters for beam animation
@@ -80,6 +85,7 @@ type BeamGroup struct {
 	beamGradientSteps  int
 	beamGradientFrames int
 	beamLength         int // Length of visible beam trail
+	stage              int // Reveal stage, groups wait until earlier stages finish
 }
 
 // BeamsConfig holds configuration for the beams background effect
@@ -98,19 +104,19 @@ type BeamsConfig struct {
 	FinalGradientSteps   int
 	FinalGradientFrames  int
 	FinalWipeSpeed       int
+	FinalWipeDirection   string // "diagonal" (default), "reverse-diagonal", "horizontal", "vertical" or "radial"
+	BeamTrailLength      int    // Characters lit behind each beam head (default: number of beam symbols)
+	BackgroundMode       bool   // Loop endlessly (true) or finish with a final wipe and hold the glow (false)
+	Seed                 int64  // Random seed for reproducible output, 0 = seeded from the clock
 }
 
 // NewBeamsEffect creates a new beams effect with given configuration
 func NewBeamsEffect(config BeamsConfig) *BeamsEffect {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng := newRNG(config.Seed)
 
 	// Set defaults if not provided
-	if len(config.BeamRowSymbols) == 0 {
-		config.BeamRowSymbols = []rune{'▂', '▁', '_'}
-	}
-	if len(config.BeamColumnSymbols) == 0 {
-		config.BeamColumnSymbols = []rune{'▌', '▍', '▎', '▏'}
-	}
+	config.BeamRowSymbols = validRunes(config.BeamRowSymbols, defaultBeamRowSymbols)
+	config.BeamColumnSymbols = validRunes(config.BeamColumnSymbols, defaultBeamColumnSymbols)
 	if config.BeamDelay == 0 {
 		config.BeamDelay = 2 // Faster group activation
 	}
@@ -158,6 +164,9 @@ func NewBeamsEffect(config BeamsConfig) *BeamsEffect {
 		finalGradientSteps:   config.FinalGradientSteps,
 		finalGradientFrames:  config.FinalGradientFrames,
 		finalWipeSpeed:       config.FinalWipeSpeed,
+		finalWipeDirection:   config.FinalWipeDirection,
+		beamTrailLength:      config.BeamTrailLength,
+		backgroundMode:       config.BackgroundMode,
 		phase:                "beams",
 		frameCount:           0,
 		beamDelayCount:       0,
@@ -191,8 +200,14 @@ func (b *BeamsEffect) init() {
 // initBackgroundMode initializes full-screen background mode with sparse sampling
 func (b *BeamsEffect) initBackgroundMode() {
 	// Create beam gradients
-	beamGradient := b.createGradient(b.beamGradientStops, b.beamGradientSteps)
-	fadeGradient := b.createFadeGradient(beamGradient[len(beamGradient)-1], 3)
+	beamGradient := NewGradient(b.beamGradientStops, b.beamGradientSteps).Colors()
+	fadeGradient := createFadeGradient(beamGradient[len(beamGradient)-1], 3)
+
+	// Only a finishing wipe brightens the grid, background mode loops before it
+	var brightenGradient []string
+	if !b.backgroundMode {
+		brightenGradient = NewGradient(b.finalGradientStops, b.finalGradientSteps).Colors()
+	}
 
 	// OPTIMIZATION: Use sparse sampling to drastically reduce character count
 	// Only create characters at intervals for performance
@@ -212,7 +227,7 @@ func (b *BeamsEffect) initBackgroundMode() {
 				sceneFrame:       0,
 				beamGradient:     beamGradient,
 				fadeGradient:     fadeGradient,
-				brightenGradient: nil, // No final brighten in background mode
+				brightenGradient: brightenGradient,
 			})
 		}
 	}
@@ -226,8 +241,9 @@ func (b *BeamsEffect) createRowGroups() {
 		rowMap[char.y] = append(rowMap[char.y], i)
 	}
 
-	// Create groups
-	for _, indices := range rowMap {
+	// Create groups in a stable order so seeded runs are reproducible
+	for _, key := range sortedKeys(rowMap) {
+		indices := rowMap[key]
 		// Sort by x coordinate
 		sort.Slice(indices, func(i, j int) bool {
 			return b.Chars[indices[i]].x < b.Chars[indices[j]].x
@@ -243,7 +259,7 @@ func (b *BeamsEffect) createRowGroups() {
 
 		speed := float64(b.rng.Intn(b.beamRowSpeedRange[1]-b.beamRowSpeedRange[0])+b.beamRowSpeedRange[0]) * 0.1
 
-		b.rowGroups = append(b.rowGroups, BeamGroup{
+		b.allGroups = append(b.allGroups, BeamGroup{
 			charIndices:        indices,
 			direction:          "row",
 			speed:              speed,
@@ -253,7 +269,7 @@ func (b *BeamsEffect) createRowGroups() {
 			beamGradientStops:  b.beamGradientStops,
 			beamGradientSteps:  b.beamGradientSteps,
 			beamGradientFrames: b.beamGradientFrames,
-			beamLength:         len(b.beamRowSymbols),
+			beamLength:         beamTrailLength(b.beamTrailLength, b.beamRowSymbols),
 		})
 	}
 }
@@ -266,8 +282,9 @@ func (b *BeamsEffect) createColumnGroups() {
 		colMap[char.x] = append(colMap[char.x], i)
 	}
 
-	// Create groups
-	for _, indices := range colMap {
+	// Create groups in a stable order so seeded runs are reproducible
+	for _, key := range sortedKeys(colMap) {
+		indices := colMap[key]
 		// Sort by y coordinate
 		sort.Slice(indices, func(i, j int) bool {
 			return b.Chars[indices[i]].y < b.Chars[indices[j]].y
@@ -283,7 +300,7 @@ func (b *BeamsEffect) createColumnGroups() {
 
 		speed := float64(b.rng.Intn(b.beamColumnSpeedRange[1]-b.beamColumnSpeedRange[0])+b.beamColumnSpeedRange[0]) * 0.1
 
-		b.columnGroups = append(b.columnGroups, BeamGroup{
+		b.allGroups = append(b.allGroups, BeamGroup{
 			charIndices:        indices,
 			direction:          "column",
 			speed:              speed,
@@ -293,106 +310,88 @@ func (b *BeamsEffect) createColumnGroups() {
 			beamGradientStops:  b.beamGradientStops,
 			beamGradientSteps:  b.beamGradientSteps,
 			beamGradientFrames: b.beamGradientFrames,
-			beamLength:         len(b.beamColumnSymbols),
+			beamLength:         beamTrailLength(b.beamTrailLength, b.beamColumnSymbols),
 		})
 	}
 }
 
-// shuffleGroups shuffles row and column groups together
+// shuffleGroups shuffles row and column groups together so activation interleaves them
 func (b *BeamsEffect) shuffleGroups() {
-	// Combine both types of groups
-	allGroups := append(b.rowGroups, b.columnGroups...)
-
 	// Fisher-Yates shuffle
-	for i := len(allGroups) - 1; i > 0; i-- {
+	for i := len(b.allGroups) - 1; i > 0; i-- {
 		j := b.rng.Intn(i + 1)
-		allGroups[i], allGroups[j] = allGroups[j], allGroups[i]
+		b.allGroups[i], b.allGroups[j] = b.allGroups[j], b.allGroups[i]
 	}
+}
 
-	// Split back
-	b.rowGroups = b.rowGroups[:0]
-	b.columnGroups = b.columnGroups[:0]
+// createDiagonalGroups creates the groups for the final wipe in its configured direction
+func (b *BeamsEffect) createDiagonalGroups() {
+	b.diagonalGroups = append(b.diagonalGroups, wipeGroups(b.Chars, b.finalWipeDirection, b.width, b.height)...)
+}
 
-	for _, group := range allGroups {
-		if group.direction == "row" {
-			b.rowGroups = append(b.rowGroups, group)
-		} else {
-			b.columnGroups = append(b.columnGroups, group)
-		}
+// beamTrailLength returns the configured trail length, or one character per symbol when unset
+func beamTrailLength(length int, symbols []rune) int {
+	if length <= 0 {
+		return len(symbols)
 	}
+	return length
 }
 
-// createDiagonalGroups creates diagonal groups for final wipe
-func (b *BeamsEffect) createDiagonalGroups() {
-	// Group by diagonal (top-left to bottom-right)
-	diagMap := make(map[int][]int)
-	for i, char := range b.Chars {
-		diag := char.x + char.y
-		diagMap[diag] = append(diagMap[diag], i)
+// holdBeamTrail keeps a trailing character lit on the last step of its beam
+// gradient so it only starts fading once it falls out of the trail
+func holdBeamTrail(char *BeamCharacter, scene string, gradientFrames int) {
+	lastFrame := len(char.beamGradient)*gradientFrames - 1
+	if char.sceneActive == "fade" || char.sceneFrame > lastFrame {
+		char.sceneActive = scene
+		char.sceneFrame = lastFrame
 	}
+}
 
-	// Sort by diagonal index and create groups
-	keys := make([]int, 0, len(diagMap))
-	for k := range diagMap {
+// sortedKeys returns the keys of a beam group map in ascending order
+func sortedKeys(groups map[int][]int) []int {
+	keys := make([]int, 0, len(groups))
+	for k := range groups {
 		keys = append(keys, k)
 	}
 	sort.Ints(keys)
-
-	for _, k := range keys {
-		b.diagonalGroups = append(b.diagonalGroups, diagMap[k])
-	}
+	return keys
 }
 
-// createGradient creates a color gradient from stops
-func (b *BeamsEffect) createGradient(stops []string, steps int) []string {
-	if len(stops) == 0 {
-		return []string{"#ffffff"}
-	}
-	if len(stops) == 1 {
-		return []string{stops[0]}
-	}
-
-	var gradient []string
-	stepsPerSegment := steps / (len(stops) - 1)
-
-	for i := 0; i < len(stops)-1; i++ {
-		c1 := parseHexColor(stops[i])
-		c2 := parseHexColor(stops[i+1])
-
-		for j := 0; j < stepsPerSegment; j++ {
-			t := float64(j) / float64(stepsPerSegment)
-			r := uint8(float64(c1[0])*(1-t) + float64(c2[0])*t)
-			g := uint8(float64(c1[1])*(1-t) + float64(c2[1])*t)
-			b := uint8(float64(c1[2])*(1-t) + float64(c2[2])*t)
-			gradient = append(gradient, formatHexColor([3]uint8{r, g, b}))
+// FinalWipeDirections lists the directions accepted by FinalWipeDirection
+var FinalWipeDirections = []string{"diagonal", "reverse-diagonal", "horizontal", "vertical", "radial"}
+
+// wipeGroups groups character indices into the steps of a final wipe,
+// ordered from the first characters lit to the last
+func wipeGroups(chars []BeamCharacter, direction string, width, height int) [][]int {
+	centerX := float64(width) / 2
+	centerY := float64(height) / 2
+
+	groupMap := make(map[int][]int)
+	for i, char := range chars {
+		var key int
+		switch direction {
+		case "reverse-diagonal":
+			key = char.x - char.y // Bottom-left to top-right
+		case "horizontal":
+			key = char.x // Column by column, left to right
+		case "vertical":
+			key = char.y // Row by row, top to bottom
+		case "radial":
+			// Cells are about twice as tall as wide, so scale rows to keep the wipe round
+			dx := float64(char.x) - centerX
+			dy := (float64(char.y) - centerY) * 2
+			key = int(math.Round(math.Sqrt(dx*dx + dy*dy)))
+		default:
+			key = char.x + char.y // Top-left to bottom-right
 		}
+		groupMap[key] = append(groupMap[key], i)
 	}
 
-	// Add final color
-	gradient = append(gradient, stops[len(stops)-1])
-
-	return gradient
-}
-
-// createFadeGradient creates a fade to dark gradient
-func (b *BeamsEffect) createFadeGradient(startColor string, steps int) []string {
-	rgb := parseHexColor(startColor)
-	targetRGB := [3]uint8{
-		uint8(float64(rgb[0]) * 0.3),
-		uint8(float64(rgb[1]) * 0.3),
-		uint8(float64(rgb[2]) * 0.3),
-	}
-
-	var gradient []string
-	for i := 0; i <= steps; i++ {
-		t := float64(i) / float64(steps)
-		r := uint8(float64(rgb[0])*(1-t) + float64(targetRGB[0])*t)
-		g := uint8(float64(rgb[1])*(1-t) + float64(targetRGB[1])*t)
-		b := uint8(float64(rgb[2])*(1-t) + float64(targetRGB[2])*t)
-		gradient = append(gradient, formatHexColor([3]uint8{r, g, b}))
+	groups := make([][]int, 0, len(groupMap))
+	for _, k := range sortedKeys(groupMap) {
+		groups = append(groups, groupMap[k])
 	}
-
-	return gradient
+	return groups
 }
 
 // Update advances the beams animation by one frame
@@ -424,22 +423,12 @@ func (b *BeamsEffect) updateBeamsPhase() {
 	activated := false
 
 	for i := 0; i < groupsToActivate; i++ {
-		// Try to activate a row group
-		for j := range b.rowGroups {
-			if b.rowGroups[j].currentCharIndex == 0 && b.rowGroups[j].nextCharCounter == 0 {
-				b.rowGroups[j].nextCharCounter = 0.01 // Start the group
-				activated = true
-				break
-			}
-		}
-
-		// Try to activate a column group
-		for j := range b.columnGroups {
-			if b.columnGroups[j].currentCharIndex == 0 && b.columnGroups[j].nextCharCounter == 0 {
-				b.columnGroups[j].nextCharCounter = 0.01
-				activated = true
+		// Start the next two waiting groups, rows and columns alike
+		for started := 0; started < 2; started++ {
+			if !b.activateNextGroup() {
 				break
 			}
+			activated = true
 		}
 	}
 
@@ -450,14 +439,8 @@ func (b *BeamsEffect) updateBeamsPhase() {
 	// Update all active groups
 	allGroupsComplete := true
 
-	for i := range b.rowGroups {
-		if b.updateGroup(&b.rowGroups[i]) {
-			allGroupsComplete = false
-		}
-	}
-
-	for i := range b.columnGroups {
-		if b.updateGroup(&b.columnGroups[i]) {
+	for i := range b.allGroups {
+		if b.updateGroup(&b.allGroups[i]) {
 			allGroupsComplete = false
 		}
 	}
@@ -468,6 +451,18 @@ func (b *BeamsEffect) updateBeamsPhase() {
 	}
 }
 
+// activateNextGroup starts the first group in shuffled order that hasn't run yet,
+// reporting false when every group has already been started
+func (b *BeamsEffect) activateNextGroup() bool {
+	for i := range b.allGroups {
+		if b.allGroups[i].currentCharIndex == 0 && b.allGroups[i].nextCharCounter == 0 {
+			b.allGroups[i].nextCharCounter = 0.01 // Start the group
+			return true
+		}
+	}
+	return false
+}
+
 // updateGroup updates a single beam group and returns true if still active
 func (b *BeamsEffect) updateGroup(group *BeamGroup) bool {
 	if group.nextCharCounter == 0 {
@@ -511,7 +506,8 @@ func (b *BeamsEffect) updateGroup(group *BeamGroup) bool {
 			trailCharIdx := group.charIndices[group.currentCharIndex-j]
 			trailChar := &b.Chars[trailCharIdx]
 
-			if trailChar.sceneActive == "beam_row" || trailChar.sceneActive == "beam_column" {
+			if trailChar.sceneActive == "beam_row" || trailChar.sceneActive == "beam_column" || trailChar.sceneActive == "fade" {
+				holdBeamTrail(trailChar, char.sceneActive, b.beamGradientFrames)
 				symbolIdx := j
 				if symbolIdx >= len(group.symbols) {
 					symbolIdx = len(group.symbols) - 1
@@ -529,14 +525,46 @@ func (b *BeamsEffect) updateGroup(group *BeamGroup) bool {
 // updateFinalWipePhase handles the final diagonal wipe
 func (b *BeamsEffect) updateFinalWipePhase() {
 	// In background mode, skip final wipe and go straight to hold
-	b.phase = "hold"
-	b.holdCounter = 0
+	if b.backgroundMode {
+		b.phase = "hold"
+		b.holdCounter = 0
+		return
+	}
+
+	// Activate wipe groups at specified speed, lighting every grid point
+	for i := 0; i < b.finalWipeSpeed && b.currentDiag < len(b.diagonalGroups); i++ {
+		for _, charIdx := range b.diagonalGroups[b.currentDiag] {
+			char := &b.Chars[charIdx]
+			char.sceneActive = "brighten"
+			char.sceneFrame = 0
+			char.visible = true
+			char.currentSymbol = beamsGlowSymbol
+		}
+		b.currentDiag++
+	}
+
+	// Hold once the wipe has passed and every character finished brightening
+	if b.currentDiag >= len(b.diagonalGroups) {
+		for i := range b.Chars {
+			char := &b.Chars[i]
+			if char.sceneActive == "brighten" && char.sceneFrame < len(char.brightenGradient)*b.finalGradientFrames {
+				return
+			}
+		}
+		b.phase = "hold"
+		b.holdCounter = 0
+	}
 }
 
 // updateHoldPhase handles the hold period after completion
 func (b *BeamsEffect) updateHoldPhase() {
 	b.holdCounter++
 
+	// Outside background mode, hold the final glow
+	if !b.backgroundMode {
+		return
+	}
+
 	// In background mode, loop immediately without hold
 	if b.holdCounter >= 0 {
 		b.Reset()
@@ -616,19 +644,38 @@ func (b *BeamsEffect) updateCharacterAnimations() {
 	}
 }
 
-// Render converts the beams effect to colored text output
-func (b *BeamsEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, b.height)
-	colors := make([][]string, b.height)
-	for i := range canvas {
-		canvas[i] = make([]rune, b.width)
-		colors[i] = make([]string, b.width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
-			colors[i][j] = ""
+// Stats reports the phase, frame count and how many characters are lit
+func (b *BeamsEffect) Stats() Stats {
+	visible := 0
+	for _, char := range b.Chars {
+		if char.visible {
+			visible++
 		}
 	}
+	return Stats{
+		Phase: b.phase,
+		Frame: b.frameCount,
+		Counts: map[string]int{
+			"characters": len(b.Chars),
+			"visible":    visible,
+			"groups":     len(b.allGroups),
+		},
+	}
+}
+
+// Render converts the beams effect to colored text output
+func (b *BeamsEffect) Render() string {
+	return renderCells(b.RenderCells())
+}
+
+// WriteTo streams the beams effect to w, see io.WriterTo
+func (b *BeamsEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, b.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (b *BeamsEffect) RenderCells() [][]Cell {
+	canvas, colors := b.buf.clear(b.width, b.height)
 
 	// Draw characters
 	for _, char := range b.Chars {
@@ -642,25 +689,7 @@ func (b *BeamsEffect) Render() string {
 		}
 	}
 
-	// Convert to colored string
-	var lines []string
-	for y := 0; y < b.height; y++ {
-		var line strings.Builder
-		for x := 0; x < b.width; x++ {
-			char := canvas[y][x]
-			if char != ' ' && colors[y][x] != "" {
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(colors[y][x])).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
-			}
-		}
-		lines = append(lines, line.String())
-	}
-
-	return strings.Join(lines, "\n")
+	return b.buf.toCells()
 }
 
 // Reset restarts the animation from the beginning
@@ -681,13 +710,9 @@ func (b *BeamsEffect) Reset() {
 	}
 
 	// Reset all groups
-	for i := range b.rowGroups {
-		b.rowGroups[i].nextCharCounter = 0
-		b.rowGroups[i].currentCharIndex = 0
-	}
-	for i := range b.columnGroups {
-		b.columnGroups[i].nextCharCounter = 0
-		b.columnGroups[i].currentCharIndex = 0
+	for i := range b.allGroups {
+		b.allGroups[i].nextCharCounter = 0
+		b.allGroups[i].currentCharIndex = 0
 	}
 }
 
@@ -708,13 +733,23 @@ func formatHexColor(rgb [3]uint8) string {
 	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
 }
 
+// createFadeGradient creates a fade from startColor to a dark shade of it
+func createFadeGradient(startColor string, steps int) []string {
+	rgb := parseHexColor(startColor)
+	dark := formatHexColor([3]uint8{
+		uint8(float64(rgb[0]) * 0.3),
+		uint8(float64(rgb[1]) * 0.3),
+		uint8(float64(rgb[2]) * 0.3),
+	})
+	return NewGradient([]string{startColor, dark}, steps).Colors()
+}
+
 // Resize reinitializes the beams effect with new dimensions
 func (b *BeamsEffect) Resize(width, height int) {
 	b.width = width
 	b.height = height
 	b.Chars = b.Chars[:0]
-	b.rowGroups = b.rowGroups[:0]
-	b.columnGroups = b.columnGroups[:0]
+	b.allGroups = b.allGroups[:0]
 	b.diagonalGroups = b.diagonalGroups[:0]
 	b.init()
 }
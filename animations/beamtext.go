@@ -1,12 +1,10 @@
 package animations
 
 import (
+	"io"
 	"math/rand"
 	"sort"
 	"strings"
-	"time"
-
-	"github.com/charmbracelet/lipgloss/v2"
 )
 
 // BeamTextEffect implements beams that travel across rows and columns, illuminating text
@@ -14,6 +12,8 @@ type BeamTextEffect struct {
 	width   int
 	height  int
 	text    string
+	align   TextAlign
+	margin  int
 	auto    bool // Auto-size canvas to fit text
 	display bool // Display mode: complete once and hold (true) or loop continuously (false)
 
@@ -30,6 +30,9 @@ type BeamTextEffect struct {
 	finalGradientSteps   int
 	finalGradientFrames  int
 	finalWipeSpeed       int
+	finalWipeDirection   string
+	beamTrailLength      int
+	revealOrder          string
 
 	// Background beams effect
 	backgroundBeams *BeamsEffect
@@ -37,9 +40,8 @@ type BeamTextEffect struct {
 	// Character data
 	chars []BeamCharacter
 
-	// Beam groups
-	rowGroups    []BeamGroup
-	columnGroups []BeamGroup
+	// Row and column beam groups, in shuffled activation order
+	allGroups []BeamGroup
 
 	// Final wipe diagonal groups
 	diagonalGroups [][]int
@@ -52,7 +54,9 @@ type BeamTextEffect struct {
 	holdFrames     int // Frames to hold after completion
 	holdCounter    int // Current hold frame count
 
-	rng *rand.Rand
+	rng        *rand.Rand
+	completion completion
+	buf        frameBuffer // Canvas reused between frames
 }
 
 // BeamTextConfig holds configuration for the beam text effect
@@ -74,19 +78,22 @@ type BeamTextConfig struct {
 	FinalGradientSteps   int
 	FinalGradientFrames  int
 	FinalWipeSpeed       int
+	FinalWipeDirection   string    // "diagonal" (default), "reverse-diagonal", "horizontal", "vertical" or "radial"
+	BeamTrailLength      int       // Characters lit behind each beam head (default: number of beam symbols)
+	RevealOrder          string    // "random" (default), "left-to-right" or "word-by-word"
+	Align                TextAlign // Horizontal placement of the text (default: center)
+	Margin               int       // Columns kept clear at the aligned edge
+	Seed                 int64     // Random seed for reproducible output, 0 = seeded from the clock
+	OnComplete           func()    // Called once, the first time the effect finishes
 }
 
 // NewBeamTextEffect creates a new beam text effect with given configuration
 func NewBeamTextEffect(config BeamTextConfig) *BeamTextEffect {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng := newRNG(config.Seed)
 
 	// Set defaults if not provided
-	if len(config.BeamRowSymbols) == 0 {
-		config.BeamRowSymbols = []rune{'▂', '▁', '_'}
-	}
-	if len(config.BeamColumnSymbols) == 0 {
-		config.BeamColumnSymbols = []rune{'▌', '▍', '▎', '▏'}
-	}
+	config.BeamRowSymbols = validRunes(config.BeamRowSymbols, defaultBeamRowSymbols)
+	config.BeamColumnSymbols = validRunes(config.BeamColumnSymbols, defaultBeamColumnSymbols)
 	if config.BeamDelay == 0 {
 		config.BeamDelay = 2
 	}
@@ -111,6 +118,9 @@ func NewBeamTextEffect(config BeamTextConfig) *BeamTextEffect {
 	if config.FinalWipeSpeed == 0 {
 		config.FinalWipeSpeed = 3
 	}
+	if config.RevealOrder != "left-to-right" && config.RevealOrder != "word-by-word" {
+		config.RevealOrder = "random"
+	}
 
 	// If auto-sizing, calculate dimensions from text
 	width := config.Width
@@ -135,12 +145,18 @@ func NewBeamTextEffect(config BeamTextConfig) *BeamTextEffect {
 		FinalGradientSteps:   config.FinalGradientSteps,
 		FinalGradientFrames:  config.FinalGradientFrames,
 		FinalWipeSpeed:       config.FinalWipeSpeed,
+		FinalWipeDirection:   config.FinalWipeDirection,
+		BeamTrailLength:      config.BeamTrailLength,
+		BackgroundMode:       true,
+		Seed:                 config.Seed,
 	}
 
 	b := &BeamTextEffect{
 		width:                width,
 		height:               height,
 		text:                 config.Text,
+		align:                config.Align,
+		margin:               config.Margin,
 		auto:                 config.Auto,
 		display:              config.Display,
 		beamRowSymbols:       config.BeamRowSymbols,
@@ -155,6 +171,9 @@ func NewBeamTextEffect(config BeamTextConfig) *BeamTextEffect {
 		finalGradientSteps:   config.FinalGradientSteps,
 		finalGradientFrames:  config.FinalGradientFrames,
 		finalWipeSpeed:       config.FinalWipeSpeed,
+		finalWipeDirection:   config.FinalWipeDirection,
+		beamTrailLength:      config.BeamTrailLength,
+		revealOrder:          config.RevealOrder,
 		backgroundBeams:      NewBeamsEffect(beamsConfig),
 		phase:                "beams",
 		frameCount:           0,
@@ -163,6 +182,7 @@ func NewBeamTextEffect(config BeamTextConfig) *BeamTextEffect {
 		holdFrames:           100,
 		holdCounter:          0,
 		rng:                  rng,
+		completion:           completion{callback: config.OnComplete},
 	}
 
 	b.init()
@@ -174,10 +194,7 @@ func calculateTextDimensions(text string) (int, int) {
 	lines := strings.Split(text, "\n")
 	maxWidth := 0
 	for _, line := range lines {
-		runes := []rune(line)
-		if len(runes) > maxWidth {
-			maxWidth = len(runes)
-		}
+		maxWidth = max(maxWidth, displayWidth(line))
 	}
 	return maxWidth, len(lines)
 }
@@ -199,12 +216,12 @@ func (b *BeamTextEffect) init() {
 	b.createDiagonalGroups()
 }
 
-// initTextMode initializes with centered text (or left-aligned if auto-sized)
+// initTextMode initializes with aligned text (or left-aligned if auto-sized)
 func (b *BeamTextEffect) initTextMode() {
 	lines := strings.Split(b.text, "\n")
 
 	// If auto-sizing, align text to left-top (no centering needed)
-	// Otherwise, center the text in the given canvas
+	// Otherwise, align the text in the given canvas
 	var startY, blockStartX int
 	if b.auto {
 		startY = 0
@@ -216,32 +233,38 @@ func (b *BeamTextEffect) initTextMode() {
 			startY = 0
 		}
 
-		// Find the longest line for centering the entire block
+		// Find the longest line for aligning the entire block
 		maxWidth := 0
 		for _, line := range lines {
-			runes := []rune(line)
-			if len(runes) > maxWidth {
-				maxWidth = len(runes)
-			}
+			maxWidth = max(maxWidth, displayWidth(line))
 		}
 
-		// Center based on longest line
-		blockStartX = (b.width - maxWidth) / 2
+		// Align based on longest line
+		blockStartX = b.align.startX(b.width, maxWidth, b.margin)
 		if blockStartX < 0 {
 			blockStartX = 0
 		}
 	}
 
-	// Create characters from text
+	// Create characters from text, numbering words in reading order
+	word := -1
 	for lineIdx, line := range lines {
-		runes := []rune(line)
+		inWord := false
 
-		for charIdx, char := range runes {
+		// Full-width characters take two columns, everything else one
+		nextX := blockStartX
+		for _, char := range line {
+			x := nextX
+			nextX += runeWidth(char)
 			if char == ' ' || char == '\t' {
+				inWord = false
 				continue
 			}
+			if !inWord {
+				word++
+				inWord = true
+			}
 
-			x := blockStartX + charIdx
 			y := startY + lineIdx
 
 			if x >= b.width || y >= b.height {
@@ -249,9 +272,9 @@ func (b *BeamTextEffect) initTextMode() {
 			}
 
 			// Create beam gradients for this character
-			beamGradient := b.createGradient(b.beamGradientStops, b.beamGradientSteps)
-			fadeGradient := b.createFadeGradient(beamGradient[len(beamGradient)-1], 5)
-			brightenGradient := b.createGradient(b.finalGradientStops, b.finalGradientSteps)
+			beamGradient := NewGradient(b.beamGradientStops, b.beamGradientSteps).Colors()
+			fadeGradient := createFadeGradient(beamGradient[len(beamGradient)-1], 5)
+			brightenGradient := NewGradient(b.finalGradientStops, b.finalGradientSteps).Colors()
 
 			b.chars = append(b.chars, BeamCharacter{
 				original:         char,
@@ -265,6 +288,7 @@ func (b *BeamTextEffect) initTextMode() {
 				beamGradient:     beamGradient,
 				fadeGradient:     fadeGradient,
 				brightenGradient: brightenGradient,
+				word:             word,
 			})
 		}
 	}
@@ -272,21 +296,26 @@ func (b *BeamTextEffect) initTextMode() {
 
 // createRowGroups creates beam groups for each row
 func (b *BeamTextEffect) createRowGroups() {
-	// Group characters by row
+	// Group characters by row, splitting rows into words for a word-by-word reveal
 	rowMap := make(map[int][]int)
 	for i, char := range b.chars {
-		rowMap[char.y] = append(rowMap[char.y], i)
+		key := char.y
+		if b.revealOrder == "word-by-word" {
+			key += char.word * b.height
+		}
+		rowMap[key] = append(rowMap[key], i)
 	}
 
-	// Create groups
-	for _, indices := range rowMap {
+	// Create groups in a stable order so seeded runs are reproducible
+	for _, key := range sortedKeys(rowMap) {
+		indices := rowMap[key]
 		// Sort by x coordinate
 		sort.Slice(indices, func(i, j int) bool {
 			return b.chars[indices[i]].x < b.chars[indices[j]].x
 		})
 
-		// Randomly reverse
-		if b.rng.Float64() < 0.5 {
+		// Randomly reverse, unless the reveal reads left to right
+		if b.revealOrder == "random" && b.rng.Float64() < 0.5 {
 			for i := 0; i < len(indices)/2; i++ {
 				j := len(indices) - 1 - i
 				indices[i], indices[j] = indices[j], indices[i]
@@ -295,7 +324,7 @@ func (b *BeamTextEffect) createRowGroups() {
 
 		speed := float64(b.rng.Intn(b.beamRowSpeedRange[1]-b.beamRowSpeedRange[0])+b.beamRowSpeedRange[0]) * 0.1
 
-		b.rowGroups = append(b.rowGroups, BeamGroup{
+		b.allGroups = append(b.allGroups, BeamGroup{
 			charIndices:        indices,
 			direction:          "row",
 			speed:              speed,
@@ -305,21 +334,27 @@ func (b *BeamTextEffect) createRowGroups() {
 			beamGradientStops:  b.beamGradientStops,
 			beamGradientSteps:  b.beamGradientSteps,
 			beamGradientFrames: b.beamGradientFrames,
-			beamLength:         len(b.beamRowSymbols),
+			beamLength:         beamTrailLength(b.beamTrailLength, b.beamRowSymbols),
+			stage:              b.revealStage(indices),
 		})
 	}
 }
 
 // createColumnGroups creates beam groups for each column
 func (b *BeamTextEffect) createColumnGroups() {
-	// Group characters by column
+	// Group characters by column, splitting columns into words for a word-by-word reveal
 	colMap := make(map[int][]int)
 	for i, char := range b.chars {
-		colMap[char.x] = append(colMap[char.x], i)
+		key := char.x
+		if b.revealOrder == "word-by-word" {
+			key += char.word * b.width
+		}
+		colMap[key] = append(colMap[key], i)
 	}
 
-	// Create groups
-	for _, indices := range colMap {
+	// Create groups in a stable order so seeded runs are reproducible
+	for _, key := range sortedKeys(colMap) {
+		indices := colMap[key]
 		// Sort by y coordinate
 		sort.Slice(indices, func(i, j int) bool {
 			return b.chars[indices[i]].y < b.chars[indices[j]].y
@@ -335,7 +370,7 @@ func (b *BeamTextEffect) createColumnGroups() {
 
 		speed := float64(b.rng.Intn(b.beamColumnSpeedRange[1]-b.beamColumnSpeedRange[0])+b.beamColumnSpeedRange[0]) * 0.1
 
-		b.columnGroups = append(b.columnGroups, BeamGroup{
+		b.allGroups = append(b.allGroups, BeamGroup{
 			charIndices:        indices,
 			direction:          "column",
 			speed:              speed,
@@ -345,106 +380,63 @@ func (b *BeamTextEffect) createColumnGroups() {
 			beamGradientStops:  b.beamGradientStops,
 			beamGradientSteps:  b.beamGradientSteps,
 			beamGradientFrames: b.beamGradientFrames,
-			beamLength:         len(b.beamColumnSymbols),
+			beamLength:         beamTrailLength(b.beamTrailLength, b.beamColumnSymbols),
+			stage:              b.revealStage(indices),
 		})
 	}
 }
 
-// shuffleGroups shuffles row and column groups together
+// shuffleGroups shuffles row and column groups together so activation interleaves them
 func (b *BeamTextEffect) shuffleGroups() {
-	// Combine both types of groups
-	allGroups := append(b.rowGroups, b.columnGroups...)
-
 	// Fisher-Yates shuffle
-	for i := len(allGroups) - 1; i > 0; i-- {
+	for i := len(b.allGroups) - 1; i > 0; i-- {
 		j := b.rng.Intn(i + 1)
-		allGroups[i], allGroups[j] = allGroups[j], allGroups[i]
+		b.allGroups[i], b.allGroups[j] = b.allGroups[j], b.allGroups[i]
 	}
 
-	// Split back
-	b.rowGroups = b.rowGroups[:0]
-	b.columnGroups = b.columnGroups[:0]
-
-	for _, group := range allGroups {
-		if group.direction == "row" {
-			b.rowGroups = append(b.rowGroups, group)
-		} else {
-			b.columnGroups = append(b.columnGroups, group)
-		}
+	// A left-to-right reveal starts groups by their leftmost character
+	if b.revealOrder == "left-to-right" {
+		sort.SliceStable(b.allGroups, func(i, j int) bool {
+			return b.groupLeft(b.allGroups[i]) < b.groupLeft(b.allGroups[j])
+		})
 	}
 }
 
-// createDiagonalGroups creates diagonal groups for final wipe
-func (b *BeamTextEffect) createDiagonalGroups() {
-	// Group by diagonal (top-left to bottom-right)
-	diagMap := make(map[int][]int)
-	for i, char := range b.chars {
-		diag := char.x + char.y
-		diagMap[diag] = append(diagMap[diag], i)
-	}
-
-	// Sort by diagonal index and create groups
-	keys := make([]int, 0, len(diagMap))
-	for k := range diagMap {
-		keys = append(keys, k)
-	}
-	sort.Ints(keys)
-
-	for _, k := range keys {
-		b.diagonalGroups = append(b.diagonalGroups, diagMap[k])
+// revealStage returns the stage a group's characters are revealed in.
+// Word-by-word reveals one word per stage, other orders use a single stage.
+func (b *BeamTextEffect) revealStage(indices []int) int {
+	if b.revealOrder != "word-by-word" || len(indices) == 0 {
+		return 0
 	}
+	return b.chars[indices[0]].word
 }
 
-// createGradient creates a color gradient from stops
-func (b *BeamTextEffect) createGradient(stops []string, steps int) []string {
-	if len(stops) == 0 {
-		return []string{"#ffffff"}
-	}
-	if len(stops) == 1 {
-		return []string{stops[0]}
-	}
-
-	var gradient []string
-	stepsPerSegment := steps / (len(stops) - 1)
-
-	for i := 0; i < len(stops)-1; i++ {
-		c1 := parseHexColor(stops[i])
-		c2 := parseHexColor(stops[i+1])
-
-		for j := 0; j < stepsPerSegment; j++ {
-			t := float64(j) / float64(stepsPerSegment)
-			r := uint8(float64(c1[0])*(1-t) + float64(c2[0])*t)
-			g := uint8(float64(c1[1])*(1-t) + float64(c2[1])*t)
-			b := uint8(float64(c1[2])*(1-t) + float64(c2[2])*t)
-			gradient = append(gradient, formatHexColor([3]uint8{r, g, b}))
+// groupLeft returns the column of a group's leftmost character
+func (b *BeamTextEffect) groupLeft(group BeamGroup) int {
+	left := b.width
+	for _, idx := range group.charIndices {
+		if b.chars[idx].x < left {
+			left = b.chars[idx].x
 		}
 	}
-
-	// Add final color
-	gradient = append(gradient, stops[len(stops)-1])
-
-	return gradient
+	return left
 }
 
-// createFadeGradient creates a fade to dark gradient
-func (b *BeamTextEffect) createFadeGradient(startColor string, steps int) []string {
-	rgb := parseHexColor(startColor)
-	targetRGB := [3]uint8{
-		uint8(float64(rgb[0]) * 0.3),
-		uint8(float64(rgb[1]) * 0.3),
-		uint8(float64(rgb[2]) * 0.3),
-	}
-
-	var gradient []string
-	for i := 0; i <= steps; i++ {
-		t := float64(i) / float64(steps)
-		r := uint8(float64(rgb[0])*(1-t) + float64(targetRGB[0])*t)
-		g := uint8(float64(rgb[1])*(1-t) + float64(targetRGB[1])*t)
-		b := uint8(float64(rgb[2])*(1-t) + float64(targetRGB[2])*t)
-		gradient = append(gradient, formatHexColor([3]uint8{r, g, b}))
+// currentRevealStage returns the earliest stage that still has unfinished groups
+func (b *BeamTextEffect) currentRevealStage() int {
+	stage := -1
+	for i := range b.allGroups {
+		group := &b.allGroups[i]
+		if group.currentCharIndex < len(group.charIndices) && (stage < 0 || group.stage < stage) {
+			stage = group.stage
+		}
 	}
+	return stage
+}
 
-	return gradient
+// createDiagonalGroups creates the groups for the final wipe in its configured direction
+func (b *BeamTextEffect) createDiagonalGroups() {
+	b.diagonalGroups = append(b.diagonalGroups, wipeGroups(b.chars, b.finalWipeDirection, b.width, b.height)...)
 }
 
 // Update advances the beams animation by one frame
@@ -481,22 +473,12 @@ func (b *BeamTextEffect) updateBeamsPhase() {
 	activated := false
 
 	for i := 0; i < groupsToActivate; i++ {
-		// Try to activate a row group
-		for j := range b.rowGroups {
-			if b.rowGroups[j].currentCharIndex == 0 && b.rowGroups[j].nextCharCounter == 0 {
-				b.rowGroups[j].nextCharCounter = 0.01 // Start the group
-				activated = true
-				break
-			}
-		}
-
-		// Try to activate a column group
-		for j := range b.columnGroups {
-			if b.columnGroups[j].currentCharIndex == 0 && b.columnGroups[j].nextCharCounter == 0 {
-				b.columnGroups[j].nextCharCounter = 0.01
-				activated = true
+		// Start the next two waiting groups, rows and columns alike
+		for started := 0; started < 2; started++ {
+			if !b.activateNextGroup() {
 				break
 			}
+			activated = true
 		}
 	}
 
@@ -507,14 +489,8 @@ func (b *BeamTextEffect) updateBeamsPhase() {
 	// Update all active groups
 	allGroupsComplete := true
 
-	for i := range b.rowGroups {
-		if b.updateGroup(&b.rowGroups[i]) {
-			allGroupsComplete = false
-		}
-	}
-
-	for i := range b.columnGroups {
-		if b.updateGroup(&b.columnGroups[i]) {
+	for i := range b.allGroups {
+		if b.updateGroup(&b.allGroups[i]) {
 			allGroupsComplete = false
 		}
 	}
@@ -525,6 +501,19 @@ func (b *BeamTextEffect) updateBeamsPhase() {
 	}
 }
 
+// activateNextGroup starts the first group of the current reveal stage that hasn't run yet,
+// reporting false when there is none
+func (b *BeamTextEffect) activateNextGroup() bool {
+	stage := b.currentRevealStage()
+	for i := range b.allGroups {
+		if b.allGroups[i].stage == stage && b.allGroups[i].currentCharIndex == 0 && b.allGroups[i].nextCharCounter == 0 {
+			b.allGroups[i].nextCharCounter = 0.01 // Start the group
+			return true
+		}
+	}
+	return false
+}
+
 // updateGroup updates a single beam group and returns true if still active
 func (b *BeamTextEffect) updateGroup(group *BeamGroup) bool {
 	if group.nextCharCounter == 0 {
@@ -567,7 +556,8 @@ func (b *BeamTextEffect) updateGroup(group *BeamGroup) bool {
 			trailCharIdx := group.charIndices[group.currentCharIndex-j]
 			trailChar := &b.chars[trailCharIdx]
 
-			if trailChar.sceneActive == "beam_row" || trailChar.sceneActive == "beam_column" {
+			if trailChar.sceneActive == "beam_row" || trailChar.sceneActive == "beam_column" || trailChar.sceneActive == "fade" {
+				holdBeamTrail(trailChar, char.sceneActive, b.beamGradientFrames)
 				symbolIdx := j
 				if symbolIdx >= len(group.symbols) {
 					symbolIdx = len(group.symbols) - 1
@@ -615,6 +605,7 @@ func (b *BeamTextEffect) updateFinalWipePhase() {
 
 		if allComplete {
 			b.phase = "hold"
+			b.completion.fire()
 			b.holdCounter = 0
 		}
 	}
@@ -704,19 +695,38 @@ func (b *BeamTextEffect) updateCharacterAnimations() {
 	}
 }
 
-// Render converts the beams effect to colored text output
-func (b *BeamTextEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, b.height)
-	colors := make([][]string, b.height)
-	for i := range canvas {
-		canvas[i] = make([]rune, b.width)
-		colors[i] = make([]string, b.width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
-			colors[i][j] = ""
+// Stats reports the phase, frame count and how many characters are lit
+func (b *BeamTextEffect) Stats() Stats {
+	visible := 0
+	for _, char := range b.chars {
+		if char.visible {
+			visible++
 		}
 	}
+	return Stats{
+		Phase: b.phase,
+		Frame: b.frameCount,
+		Counts: map[string]int{
+			"characters": len(b.chars),
+			"visible":    visible,
+			"groups":     len(b.allGroups),
+		},
+	}
+}
+
+// Render converts the beam text effect to colored text output
+func (b *BeamTextEffect) Render() string {
+	return renderCells(b.RenderCells())
+}
+
+// WriteTo streams the beam text effect to w, see io.WriterTo
+func (b *BeamTextEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, b.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (b *BeamTextEffect) RenderCells() [][]Cell {
+	canvas, colors := b.buf.clear(b.width, b.height)
 
 	// First, render background beams directly from their character data (as base layer)
 	if b.backgroundBeams != nil {
@@ -738,37 +748,18 @@ func (b *BeamTextEffect) Render() string {
 	}
 
 	// Draw text characters (these overlay the background)
+	cells := b.buf.toCells()
 	for _, char := range b.chars {
 		if !char.visible {
 			continue
 		}
 
-		if char.y >= 0 && char.y < b.height && char.x >= 0 && char.x < b.width {
-			canvas[char.y][char.x] = char.currentSymbol
-			colors[char.y][char.x] = char.currentColor
+		if char.y >= 0 && char.y < b.height {
+			putCell(cells[char.y], char.x, Cell{Rune: char.currentSymbol, Color: char.currentColor})
 		}
 	}
 
-	// Convert to colored string
-	var lines []string
-	for y := 0; y < b.height; y++ {
-		var line strings.Builder
-		for x := 0; x < b.width; x++ {
-			char := canvas[y][x]
-			if char != ' ' && colors[y][x] != "" {
-				// Characters with explicit colors
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(colors[y][x])).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
-			}
-		}
-		lines = append(lines, line.String())
-	}
-
-	return strings.Join(lines, "\n")
+	return cells
 }
 
 // getBeamsCharacters is a helper to access the background beams' character array
@@ -803,13 +794,9 @@ func (b *BeamTextEffect) Reset() {
 	}
 
 	// Reset all groups
-	for i := range b.rowGroups {
-		b.rowGroups[i].nextCharCounter = 0
-		b.rowGroups[i].currentCharIndex = 0
-	}
-	for i := range b.columnGroups {
-		b.columnGroups[i].nextCharCounter = 0
-		b.columnGroups[i].currentCharIndex = 0
+	for i := range b.allGroups {
+		b.allGroups[i].nextCharCounter = 0
+		b.allGroups[i].currentCharIndex = 0
 	}
 }
 
@@ -824,8 +811,7 @@ func (b *BeamTextEffect) Resize(width, height int) {
 	}
 
 	b.chars = b.chars[:0]
-	b.rowGroups = b.rowGroups[:0]
-	b.columnGroups = b.columnGroups[:0]
+	b.allGroups = b.allGroups[:0]
 	b.diagonalGroups = b.diagonalGroups[:0]
 	b.init()
 }
@@ -0,0 +1,82 @@
+package animations
+
+import "testing"
+
+// Benchmarks run at a typical terminal size with a fixed seed so results
+// are comparable between runs
+const (
+	benchWidth  = 120
+	benchHeight = 40
+	benchSeed   = 1
+)
+
+// benchmarkEffect times one Update and Render per iteration after a short warm-up
+func benchmarkEffect(b *testing.B, effect interface {
+	Update()
+	Render() string
+}) {
+	for i := 0; i < 50; i++ {
+		effect.Update()
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		effect.Update()
+		_ = effect.Render()
+	}
+}
+
+func benchTheme(b *testing.B) Theme {
+	theme, ok := GetTheme("dracula")
+	if !ok {
+		b.Fatal("dracula theme missing")
+	}
+	return theme
+}
+
+func BenchmarkFireEffect(b *testing.B) {
+	theme := benchTheme(b)
+	benchmarkEffect(b, NewFireEffectWithConfig(FireConfig{
+		Width:   benchWidth,
+		Height:  benchHeight,
+		Palette: theme.FirePalette,
+		Seed:    benchSeed,
+	}))
+}
+
+func BenchmarkMatrixEffect(b *testing.B) {
+	theme := benchTheme(b)
+	benchmarkEffect(b, NewMatrixEffectWithConfig(MatrixConfig{
+		Width:   benchWidth,
+		Height:  benchHeight,
+		Palette: theme.MatrixPalette,
+		Seed:    benchSeed,
+	}))
+}
+
+func BenchmarkAquariumEffect(b *testing.B) {
+	theme := benchTheme(b)
+	benchmarkEffect(b, NewAquariumEffect(AquariumConfig{
+		Width:         benchWidth,
+		Height:        benchHeight,
+		FishColors:    theme.AquariumFishColors,
+		WaterColors:   theme.AquariumWaterColors,
+		SeaweedColors: theme.AquariumSeaweedColors,
+		BubbleColor:   theme.AquariumBubbleColor,
+		DiverColor:    theme.AquariumDiverColor,
+		BoatColor:     theme.AquariumBoatColor,
+		MermaidColor:  theme.AquariumMermaidColor,
+		AnchorColor:   theme.AquariumAnchorColor,
+		ChestColor:    theme.AquariumChestColor,
+		Seed:          benchSeed,
+	}))
+}
+
+func BenchmarkBeamsEffect(b *testing.B) {
+	benchmarkEffect(b, NewBeamsEffect(BeamsConfig{
+		Width:  benchWidth,
+		Height: benchHeight,
+		Seed:   benchSeed,
+	}))
+}
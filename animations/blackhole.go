@@ -1,12 +1,12 @@
 package animations
 
 import (
+	"io"
 	"math"
 	"math/rand"
 	"strings"
-	"time"
 
-	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/Nomadcxx/sysc-Go/animations/ease"
 )
 
 // BlackholeConfig holds the configuration for the Blackhole effect
@@ -21,12 +21,22 @@ type BlackholeConfig struct {
 	FinalGradientDir    GradientDirection
 	StaticGradientStops []string // Gradient for static ASCII
 	StaticGradientDir   GradientDirection
-	FormingFrames       int // Frames for border formation
-	ConsumingFrames     int // Frames for consumption
-	CollapsingFrames    int // Frames for border collapse
-	ExplodingFrames     int // Frames for explosion scatter
-	ReturningFrames     int // Frames for return to text
-	StaticFrames        int // Frames to display static text initially
+	FormingFrames       int       // Frames for border formation
+	ConsumingFrames     int       // Frames for consumption
+	CollapsingFrames    int       // Frames for border collapse
+	ExplodingFrames     int       // Frames for explosion scatter
+	ReturningFrames     int       // Frames for return to text
+	StaticFrames        int       // Frames to display static text initially (default 100, negative skips the intro)
+	IntroOnce           bool      // Show the static intro on the first loop only; later loops start forming at once
+	ShowBorder          bool      // Draw the swirling border ring around the singularity
+	AccretionColors     []string  // Gradient consumed characters heat through as they near the center
+	CenterX             float64   // Column of the singularity, negative = centered
+	CenterY             float64   // Row of the singularity, negative = centered
+	Twinkle             bool      // Stars twinkle during the static phase of no-text mode
+	Align               TextAlign // Horizontal placement of the text (default: center)
+	Margin              int       // Columns kept clear at the aligned edge
+	Seed                int64     // Random seed for reproducible output, 0 = seeded from the clock
+	OnComplete          func()    // Called once, the first time the effect finishes
 }
 
 // BlackholeEffect represents the multi-phase blackhole animation
@@ -34,6 +44,8 @@ type BlackholeEffect struct {
 	width  int
 	height int
 	text   string
+	align  TextAlign
+	margin int
 
 	// Blackhole configuration
 	blackholeColor      string
@@ -49,11 +61,19 @@ type BlackholeEffect struct {
 	explodingFrames     int
 	returningFrames     int
 	staticFrames        int
+	introOnce           bool
+	looped              bool // Set once the animation has started over after holding
+	showBorder          bool
+	accretionColors     []string
+	centerXOverride     float64
+	centerYOverride     float64
+	twinkle             bool
 
 	// Gradients
-	finalGradient  []string
-	staticGradient []string
-	starGradient   []string
+	finalGradient     []string
+	staticGradient    []string
+	starGradient      []string
+	accretionGradient []string
 
 	// Character data
 	chars           []BlackholeCharacter
@@ -62,6 +82,7 @@ type BlackholeEffect struct {
 	centerY         float64
 	blackholeRadius float64
 	rng             *rand.Rand
+	completion      completion
 	frameCount      int
 
 	// Animation state
@@ -70,6 +91,8 @@ type BlackholeEffect struct {
 	nextConsumeDelay   int    // Random delay before next character consumption
 	currentConsumeWait int    // Current wait counter for consumption
 	particleMode       bool   // True for particle mode (no text), false for text mode
+
+	buf frameBuffer // Canvas reused between frames
 }
 
 // BlackholeCharacter represents a single character in the animation
@@ -83,10 +106,14 @@ type BlackholeCharacter struct {
 	scatterY     float64 // Scatter position for explosion
 	visible      bool
 	currentColor string
+	baseColor    string  // Static color before consumption
 	consumed     bool    // Has been consumed by blackhole
 	consumeOrder int     // Order in which character is consumed
 	scatterAngle float64 // Direction for explosion scatter
 	scatterDist  float64 // Distance for explosion scatter
+
+	twinklePhase  float64  // Offset into the twinkle cycle
+	twinkleColors []string // Dim to bright gradient around baseColor
 }
 
 // BorderCharacter represents a character on the blackhole border
@@ -104,7 +131,7 @@ var unstableSymbols = []rune{'◦', '◎', '◉', '●'}
 
 // NewBlackholeEffect creates a new Blackhole effect
 func NewBlackholeEffect(config BlackholeConfig) *BlackholeEffect {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng := newRNG(config.Seed)
 
 	// Set defaults
 	if config.BlackholeColor == "" {
@@ -140,11 +167,16 @@ func NewBlackholeEffect(config BlackholeConfig) *BlackholeEffect {
 	if config.StaticFrames == 0 {
 		config.StaticFrames = 100
 	}
+	if len(config.AccretionColors) == 0 {
+		config.AccretionColors = []string{"#ff2000", "#ff8c00", "#ffffff"} // Red, orange, white hot
+	}
 
 	effect := &BlackholeEffect{
 		width:               config.Width,
 		height:              config.Height,
 		text:                config.Text,
+		align:               config.Align,
+		margin:              config.Margin,
 		blackholeColor:      config.BlackholeColor,
 		starColors:          config.StarColors,
 		finalGradientStops:  config.FinalGradientStops,
@@ -158,7 +190,14 @@ func NewBlackholeEffect(config BlackholeConfig) *BlackholeEffect {
 		explodingFrames:     config.ExplodingFrames,
 		returningFrames:     config.ReturningFrames,
 		staticFrames:        config.StaticFrames,
+		introOnce:           config.IntroOnce,
+		showBorder:          config.ShowBorder,
+		accretionColors:     config.AccretionColors,
+		centerXOverride:     config.CenterX,
+		centerYOverride:     config.CenterY,
+		twinkle:             config.Twinkle,
 		rng:                 rng,
+		completion:          completion{callback: config.OnComplete},
 		phase:               "static",
 		frameCount:          0,
 		consumeCounter:      0,
@@ -168,11 +207,31 @@ func NewBlackholeEffect(config BlackholeConfig) *BlackholeEffect {
 	return effect
 }
 
+// NewBlackholeEffectChecked creates a new Blackhole effect, or returns an
+// error wrapping ErrTextTooLarge instead of cutting off text that doesn't fit.
+// Empty text, which draws particles, always fits.
+func NewBlackholeEffectChecked(config BlackholeConfig) (*BlackholeEffect, error) {
+	if config.Text != "" {
+		if err := checkTextFits(config.Text, config.Width, config.Height, config.Align, config.Margin); err != nil {
+			return nil, err
+		}
+	}
+	return NewBlackholeEffect(config), nil
+}
+
 // init initializes the effect
 func (e *BlackholeEffect) init() {
 	e.centerX = float64(e.width) / 2
 	e.centerY = float64(e.height) / 2
 
+	// Explicit centers only apply when they are on the canvas
+	if e.centerXOverride >= 0 && e.centerXOverride < float64(e.width) {
+		e.centerX = e.centerXOverride
+	}
+	if e.centerYOverride >= 0 && e.centerYOverride < float64(e.height) {
+		e.centerY = e.centerYOverride
+	}
+
 	// Determine if we're in particle mode (no text)
 	e.particleMode = (e.text == "")
 
@@ -188,9 +247,10 @@ func (e *BlackholeEffect) init() {
 	e.blackholeRadius = math.Max(smallestDim*radiusPercent, 3)
 
 	// Create gradients
-	e.finalGradient = e.createGradient(e.finalGradientStops, e.finalGradientSteps)
-	e.staticGradient = e.createGradient(e.staticGradientStops, 100)
-	e.starGradient = e.createGradient(e.starColors, 100)
+	e.finalGradient = NewGradient(e.finalGradientStops, e.finalGradientSteps).Colors()
+	e.staticGradient = NewGradient(e.staticGradientStops, 100).Colors()
+	e.starGradient = NewGradient(e.starColors, 100).Colors()
+	e.accretionGradient = NewGradient(e.accretionColors, 100).Colors()
 
 	// Parse text and create characters (or generate random particles if no text)
 	if e.particleMode {
@@ -204,6 +264,9 @@ func (e *BlackholeEffect) init() {
 
 	// Apply initial static gradient
 	e.applyStaticGradient()
+	if e.twinkle && e.particleMode {
+		e.createTwinkleGradients()
+	}
 
 	// Generate scatter positions for explosion
 	e.generateScatterPositions()
@@ -219,16 +282,16 @@ func (e *BlackholeEffect) parseText() {
 	e.chars = make([]BlackholeCharacter, 0)
 
 	for lineIdx, line := range lines {
-		lineRunes := []rune(line)
-		lineLen := len(lineRunes)
-		startX := (e.width - lineLen) / 2
+		// Full-width characters take two columns, everything else one
+		nextX := e.align.startX(e.width, displayWidth(line), e.margin)
 
-		for charIdx, char := range lineRunes {
+		for _, char := range line {
+			x := nextX
+			nextX += runeWidth(char)
 			if char == ' ' || char == '\n' {
 				continue
 			}
 
-			x := startX + charIdx
 			y := startY + lineIdx
 
 			character := BlackholeCharacter{
@@ -293,6 +356,7 @@ func (e *BlackholeEffect) generateRandomParticles() {
 			currentColor: color,
 			consumed:     false,
 			consumeOrder: i, // Sequential order for smooth consumption
+			twinklePhase: e.rng.Float64() * 2 * math.Pi,
 		}
 
 		e.chars = append(e.chars, character)
@@ -308,11 +372,8 @@ func (e *BlackholeEffect) createBorder() {
 	}
 	e.borderChars = make([]BorderCharacter, numBorderChars)
 
-	// Calculate staggered formation delay (matching TTE)
-	formationDelayIncrement := 100 / numBorderChars
-	if formationDelayIncrement < 10 {
-		formationDelayIncrement = 10
-	}
+	// Stagger formation so the whole ring has appeared by the end of forming
+	formationDelayIncrement := float64(e.formingFrames) / float64(numBorderChars)
 
 	for i := range e.borderChars {
 		angle := (float64(i) / float64(numBorderChars)) * 2 * math.Pi
@@ -323,7 +384,7 @@ func (e *BlackholeEffect) createBorder() {
 			symbol:         '●',
 			currentColor:   e.blackholeColor,
 			visible:        false,
-			formationDelay: i * formationDelayIncrement,
+			formationDelay: int(float64(i) * formationDelayIncrement),
 		}
 	}
 }
@@ -419,6 +480,29 @@ func (e *BlackholeEffect) applyStaticGradient() {
 		}
 
 		e.chars[i].currentColor = e.staticGradient[gradientIndex]
+		e.chars[i].baseColor = e.chars[i].currentColor
+	}
+}
+
+// createTwinkleGradients builds each particle's short dim-to-bright gradient around its static color
+func (e *BlackholeEffect) createTwinkleGradients() {
+	for i := range e.chars {
+		base := e.chars[i].baseColor
+		dim := blendColor(base, "#000000", 0.6)
+		bright := blendColor(base, "#ffffff", 0.6)
+		e.chars[i].twinkleColors = NewGradient([]string{dim, base, bright}, 8).Colors()
+	}
+}
+
+// updateTwinkle cycles each particle through its twinkle gradient
+func (e *BlackholeEffect) updateTwinkle() {
+	for i := range e.chars {
+		colors := e.chars[i].twinkleColors
+		if len(colors) == 0 {
+			continue
+		}
+		wave := (math.Sin(float64(e.frameCount)*0.15+e.chars[i].twinklePhase) + 1) / 2
+		e.chars[i].currentColor = colors[int(wave*float64(len(colors)-1))]
 	}
 }
 
@@ -438,9 +522,16 @@ func (e *BlackholeEffect) Update() {
 
 	switch e.phase {
 	case "static":
-		if e.frameCount >= e.staticFrames {
+		if e.twinkle && e.particleMode {
+			e.updateTwinkle()
+		}
+
+		if e.frameCount >= e.staticFrames || (e.introOnce && e.looped) {
 			e.phase = "forming"
 			e.frameCount = 0
+			for i := range e.chars {
+				e.chars[i].currentColor = e.chars[i].baseColor
+			}
 		}
 
 	case "forming":
@@ -530,6 +621,7 @@ func (e *BlackholeEffect) Update() {
 					if brightness < 0.5 {
 						e.chars[i].visible = false
 					}
+					e.chars[i].currentColor = e.accretionColor(e.chars[i].baseColor, dist)
 				}
 			}
 		} else {
@@ -537,7 +629,7 @@ func (e *BlackholeEffect) Update() {
 			for i := range e.chars {
 				if e.chars[i].consumed {
 					// Exponential ease toward center (gravity effect)
-					easedProgress := e.easeInExpo(progress)
+					easedProgress := ease.InExpo(progress)
 
 					// Bézier curve toward center
 					startX := float64(e.chars[i].x)
@@ -560,6 +652,7 @@ func (e *BlackholeEffect) Update() {
 					if brightness < 0.3 {
 						e.chars[i].visible = false
 					}
+					e.chars[i].currentColor = e.accretionColor(e.chars[i].baseColor, dist)
 				}
 			}
 		}
@@ -615,7 +708,7 @@ func (e *BlackholeEffect) Update() {
 
 		if e.particleMode {
 			// Particle mode: Use enhanced explosion (easeOutQuart, 1.5x scatter)
-			easedProgress := e.easeOutQuart(progress)
+			easedProgress := ease.OutQuart(progress)
 
 			for i := range e.chars {
 				// Calculate scatter distance (particles fly further out - 150% of original scatter distance)
@@ -648,7 +741,7 @@ func (e *BlackholeEffect) Update() {
 			}
 		} else {
 			// Text mode: Use original explosion (easeOutExpo, 1.0x scatter)
-			easedProgress := e.easeOutExpo(progress)
+			easedProgress := ease.OutExpo(progress)
 
 			for i := range e.chars {
 				// Scatter from center to scatter position
@@ -673,7 +766,7 @@ func (e *BlackholeEffect) Update() {
 			progress = 1.0
 		}
 
-		easedProgress := e.easeInOutCubic(progress)
+		easedProgress := ease.InOutCubic(progress)
 
 		for i := range e.chars {
 			// Return from scatter position to original
@@ -690,47 +783,67 @@ func (e *BlackholeEffect) Update() {
 
 		if e.frameCount >= e.returningFrames {
 			e.phase = "hold"
+			e.completion.fire()
 			e.frameCount = 0
 		}
 
 	case "hold":
 		if e.frameCount >= 60 {
 			e.Reset()
+			e.looped = true
 		}
 	}
 }
 
-// Render returns the current frame as a colored string
-func (e *BlackholeEffect) Render() string {
-	buffer := make([][]rune, e.height)
-	colors := make([][]string, e.height)
-	for i := range buffer {
-		buffer[i] = make([]rune, e.width)
-		colors[i] = make([]string, e.width)
-		for j := range buffer[i] {
-			buffer[i][j] = ' '
-			colors[i][j] = ""
-		}
+// accretionColor tints a consumed character's color along the accretion gradient,
+// from its own color at the blackhole radius to fully hot at 30% of the radius
+func (e *BlackholeEffect) accretionColor(base string, dist float64) string {
+	heat := (e.blackholeRadius - dist) / (e.blackholeRadius * 0.7)
+	if heat <= 0 {
+		return base
+	}
+	if heat > 1 {
+		heat = 1
 	}
 
-	// Draw characters
+	hot := e.accretionGradient[int(heat*float64(len(e.accretionGradient)-1))]
+	return blendColor(base, hot, heat)
+}
+
+// Stats reports the phase, frame count and how many characters have been consumed
+func (e *BlackholeEffect) Stats() Stats {
+	consumed := 0
 	for _, char := range e.chars {
-		if !char.visible {
-			continue
+		if char.consumed {
+			consumed++
 		}
+	}
+	return Stats{
+		Phase: e.phase,
+		Frame: e.frameCount,
+		Counts: map[string]int{
+			"characters": len(e.chars),
+			"consumed":   consumed,
+		},
+	}
+}
 
-		x := int(math.Round(char.currentX))
-		y := int(math.Round(char.currentY))
+// Render converts the blackhole effect to colored text output
+func (e *BlackholeEffect) Render() string {
+	return renderCells(e.RenderCells())
+}
 
-		if x >= 0 && x < e.width && y >= 0 && y < e.height {
-			buffer[y][x] = char.original
-			colors[y][x] = char.currentColor
-		}
-	}
+// WriteTo streams the blackhole effect to w, see io.WriterTo
+func (e *BlackholeEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, e.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (e *BlackholeEffect) RenderCells() [][]Cell {
+	buffer, colors := e.buf.clear(e.width, e.height)
 
-	// Border animation removed - doesn't work well with ASCII characters
-	/*
-		// Draw border
+	// Draw border first so characters being consumed pass over it
+	if e.showBorder {
 		for _, borderChar := range e.borderChars {
 			if !borderChar.visible {
 				continue
@@ -744,29 +857,24 @@ func (e *BlackholeEffect) Render() string {
 				colors[y][x] = borderChar.currentColor
 			}
 		}
-	*/
-
-	// Build output (line-by-line like other effects)
-	var lines []string
-	for y := 0; y < e.height; y++ {
-		var line strings.Builder
-		for x := 0; x < e.width; x++ {
-			char := buffer[y][x]
-			color := colors[y][x]
-
-			if color != "" && char != ' ' {
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(color)).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
-			}
+	}
+
+	// Draw characters
+	cells := e.buf.toCells()
+	for _, char := range e.chars {
+		if !char.visible {
+			continue
+		}
+
+		x := int(math.Round(char.currentX))
+		y := int(math.Round(char.currentY))
+
+		if y >= 0 && y < e.height {
+			putCell(cells[y], x, Cell{Rune: char.original, Color: char.currentColor})
 		}
-		lines = append(lines, line.String())
 	}
 
-	return strings.Join(lines, "\n")
+	return cells
 }
 
 // Reset restarts the animation
@@ -801,57 +909,10 @@ func (e *BlackholeEffect) Reset() {
 	e.generateScatterPositions()
 }
 
-// createGradient creates a gradient between color stops
-func (e *BlackholeEffect) createGradient(stops []string, steps int) []string {
-	if len(stops) == 0 {
-		return []string{"#ffffff"}
-	}
-	if len(stops) == 1 {
-		return []string{stops[0]}
-	}
-
-	gradient := make([]string, 0)
-	stepsPerSegment := steps / (len(stops) - 1)
-
-	for i := 0; i < len(stops)-1; i++ {
-		startColor := parseHexColor(stops[i])
-		endColor := parseHexColor(stops[i+1])
-
-		for j := 0; j < stepsPerSegment; j++ {
-			t := float64(j) / float64(stepsPerSegment)
-			r := uint8(float64(startColor[0]) + (float64(endColor[0])-float64(startColor[0]))*t)
-			g := uint8(float64(startColor[1]) + (float64(endColor[1])-float64(startColor[1]))*t)
-			b := uint8(float64(startColor[2]) + (float64(endColor[2])-float64(startColor[2]))*t)
-			gradient = append(gradient, formatHexColor([3]uint8{r, g, b}))
-		}
-	}
-
-	gradient = append(gradient, stops[len(stops)-1])
-	return gradient
-}
-
-// Easing functions
-func (e *BlackholeEffect) easeInExpo(t float64) float64 {
-	if t == 0 {
-		return 0
-	}
-	return math.Pow(2, 10*(t-1))
-}
-
-func (e *BlackholeEffect) easeOutExpo(t float64) float64 {
-	if t == 1 {
-		return 1
-	}
-	return 1 - math.Pow(2, -10*t)
-}
-
-func (e *BlackholeEffect) easeInOutCubic(t float64) float64 {
-	if t < 0.5 {
-		return 4 * t * t * t
-	}
-	return 1 - math.Pow(-2*t+2, 3)/2
-}
-
-func (e *BlackholeEffect) easeOutQuart(t float64) float64 {
-	return 1 - math.Pow(1-t, 4)
+// Resize reinitializes the blackhole effect with new dimensions
+func (e *BlackholeEffect) Resize(width, height int) {
+	e.width = width
+	e.height = height
+	e.init()
+	e.Reset()
 }
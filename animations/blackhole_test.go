@@ -0,0 +1,53 @@
+package animations
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBlackhole_Center(t *testing.T) {
+	tests := []struct {
+		centerX, centerY float64
+		wantX, wantY     float64
+	}{
+		{-1, -1, 20, 10}, // Negative centers on the canvas
+		{0, 0, 0, 0},     // The first column and row can be chosen
+		{5, 3, 5, 3},
+		{40, 20, 20, 10}, // Off the canvas falls back to centered
+	}
+	for _, tt := range tests {
+		e := NewBlackholeEffect(BlackholeConfig{Width: 40, Height: 20, CenterX: tt.centerX, CenterY: tt.centerY, Seed: 1})
+		if e.centerX != tt.wantX || e.centerY != tt.wantY {
+			t.Errorf("center %v,%v: got %v,%v, want %v,%v", tt.centerX, tt.centerY, e.centerX, e.centerY, tt.wantX, tt.wantY)
+		}
+	}
+}
+
+func TestNewBlackholeEffectChecked(t *testing.T) {
+	config := BlackholeConfig{Width: 10, Height: 4, Text: "WIDE TEXT\nOK", Seed: 1}
+	if _, err := NewBlackholeEffectChecked(config); err != nil {
+		t.Errorf("text that fits: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*BlackholeConfig)
+	}{
+		{"too wide", func(c *BlackholeConfig) { c.Width = 8 }},
+		{"too tall", func(c *BlackholeConfig) { c.Height = 1 }},
+		{"pushed off by the margin", func(c *BlackholeConfig) { c.Align, c.Margin = AlignRight, 2 }},
+	}
+	for _, tt := range tests {
+		c := config
+		tt.modify(&c)
+		if _, err := NewBlackholeEffectChecked(c); !errors.Is(err, ErrTextTooLarge) {
+			t.Errorf("%s: err = %v, want ErrTextTooLarge", tt.name, err)
+		}
+	}
+
+	// Without text the effect draws particles, which always fit
+	config.Text, config.Width, config.Height = "", 1, 1
+	if _, err := NewBlackholeEffectChecked(config); err != nil {
+		t.Errorf("no text: %v", err)
+	}
+}
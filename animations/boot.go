@@ -0,0 +1,274 @@
+package animations
+
+import (
+	"io"
+	"math/rand"
+	"strings"
+)
+
+// BootEffect types out text line by line like a system boot log. Status
+// markers such as [ OK ] and [FAIL] are colorized, a blinking cursor follows
+// the current line, and the log scrolls up once it fills the screen.
+type BootEffect struct {
+	width         int
+	height        int
+	lines         [][]rune
+	colors        [][]string // Color of every rune, markers already colorized
+	framesPerChar int
+	printSpeed    int
+	lineDelay     int
+	cursorSymbol  rune
+	blinkFrames   int
+	textColor     string
+	okColor       string
+	failColor     string
+	display       bool
+	holdFrames    int
+
+	phase          string // "printing" or "holding"
+	currentLine    int
+	currentCol     int
+	frameCounter   int
+	pause          int // Frames left to wait before the next line starts
+	frameCount     int
+	holdFrameCount int
+
+	rng        *rand.Rand
+	completion completion
+	cells      [][]Cell // Frame grid reused between renders
+}
+
+// BootConfig holds configuration for the boot log effect
+type BootConfig struct {
+	Width         int
+	Height        int
+	Text          string
+	FramesPerChar int    // Frames to wait before printing the next characters (default 1)
+	PrintSpeed    int    // Characters printed per step (default 3)
+	LineDelay     int    // Average frames to pause after each line, jittered per line (default 6)
+	CursorSymbol  string // Cursor glyph (default "█")
+	BlinkFrames   int    // Frames the cursor stays shown, then hidden (default 8)
+	TextColor     string // Color of the log text (default "#cccccc")
+	OKColor       string // Color of OK markers (default "#50fa7b")
+	FailColor     string // Color of FAIL markers (default "#ff5555")
+	Display       bool   // Display mode: print once and hold (true) or loop (false)
+	HoldFrames    int    // Frames to hold the finished log before looping (default 100)
+	Seed          int64  // Random seed for reproducible output, 0 = seeded from the clock
+	OnComplete    func() // Called once, the first time the log finishes printing
+}
+
+// NewBootEffect creates a boot log effect with the given configuration
+func NewBootEffect(config BootConfig) *BootEffect {
+	framesPerChar := config.FramesPerChar
+	if framesPerChar <= 0 {
+		framesPerChar = 1
+	}
+	printSpeed := config.PrintSpeed
+	if printSpeed <= 0 {
+		printSpeed = 3
+	}
+	lineDelay := config.LineDelay
+	if lineDelay <= 0 {
+		lineDelay = 6
+	}
+	cursorSymbol := config.CursorSymbol
+	if !validSymbol(cursorSymbol) {
+		cursorSymbol = "█"
+	}
+	blinkFrames := config.BlinkFrames
+	if blinkFrames <= 0 {
+		blinkFrames = 8
+	}
+	holdFrames := config.HoldFrames
+	if holdFrames <= 0 {
+		holdFrames = 100 // Default ~5 seconds at 20fps
+	}
+	textColor := config.TextColor
+	if textColor == "" {
+		textColor = "#cccccc"
+	}
+	okColor := config.OKColor
+	if okColor == "" {
+		okColor = "#50fa7b"
+	}
+	failColor := config.FailColor
+	if failColor == "" {
+		failColor = "#ff5555"
+	}
+
+	b := &BootEffect{
+		width:         config.Width,
+		height:        config.Height,
+		framesPerChar: framesPerChar,
+		printSpeed:    printSpeed,
+		lineDelay:     lineDelay,
+		cursorSymbol:  []rune(cursorSymbol)[0],
+		blinkFrames:   blinkFrames,
+		textColor:     textColor,
+		okColor:       okColor,
+		failColor:     failColor,
+		display:       config.Display,
+		holdFrames:    holdFrames,
+		rng:           newRNG(config.Seed),
+		completion:    completion{callback: config.OnComplete},
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(config.Text, "\n"), "\n") {
+		runes := []rune(strings.TrimRight(line, "\r"))
+		b.lines = append(b.lines, runes)
+		b.colors = append(b.colors, b.colorLine(runes))
+	}
+
+	b.Reset()
+	return b
+}
+
+// colorLine colors a line in the text color, with the word inside the first
+// bracketed OK or FAIL marker in its status color, e.g. "[  OK  ]"
+func (b *BootEffect) colorLine(line []rune) []string {
+	colors := make([]string, len(line))
+	for i := range colors {
+		colors[i] = b.textColor
+	}
+
+	open := -1
+	for i, r := range line {
+		switch r {
+		case '[':
+			open = i
+		case ']':
+			if open < 0 {
+				continue
+			}
+			color := ""
+			switch strings.ToUpper(strings.TrimSpace(string(line[open+1 : i]))) {
+			case "OK", "DONE":
+				color = b.okColor
+			case "FAIL", "FAILED", "ERROR":
+				color = b.failColor
+			}
+			if color != "" {
+				for j := open + 1; j < i; j++ {
+					colors[j] = color
+				}
+				return colors
+			}
+			open = -1
+		}
+	}
+	return colors
+}
+
+// Update advances the boot log by one frame
+func (b *BootEffect) Update() {
+	b.frameCount++
+
+	switch b.phase {
+	case "printing":
+		b.updatePrinting()
+	case "holding":
+		b.holdFrameCount++
+		if !b.display && b.holdFrameCount >= b.holdFrames {
+			b.Reset()
+		}
+	}
+}
+
+// updatePrinting types the current line, pausing between lines
+func (b *BootEffect) updatePrinting() {
+	if b.pause > 0 {
+		b.pause--
+		return
+	}
+	if b.currentLine >= len(b.lines) {
+		b.phase = "holding"
+		b.holdFrameCount = 0
+		b.completion.fire()
+		return
+	}
+
+	b.frameCounter++
+	if b.frameCounter < b.framesPerChar {
+		return
+	}
+	b.frameCounter = 0
+
+	b.currentCol = min(b.currentCol+b.printSpeed, len(b.lines[b.currentLine]))
+	if b.currentCol >= len(b.lines[b.currentLine]) {
+		b.currentLine++
+		b.currentCol = 0
+		b.pause = b.lineDelay/2 + b.rng.Intn(b.lineDelay+1) // Some services take longer to start
+	}
+}
+
+// Stats reports the phase, frame count and which line is being printed
+func (b *BootEffect) Stats() Stats {
+	return Stats{
+		Phase: b.phase,
+		Frame: b.frameCount,
+		Counts: map[string]int{
+			"lines":       len(b.lines),
+			"currentLine": b.currentLine,
+		},
+	}
+}
+
+// RenderCells draws the printed lines with the cursor after the last
+// character, scrolled so the cursor line stays on screen
+func (b *BootEffect) RenderCells() [][]Cell {
+	b.cells = resetCellGrid(b.cells, b.width, b.height)
+
+	// Printed lines plus the row the cursor is on
+	rows := b.currentLine + 1
+	first := max(rows-b.height, 0)
+	for row := first; row < rows; row++ {
+		y := row - first
+		count := 0
+		if row < len(b.lines) {
+			count = len(b.lines[row])
+			if row == b.currentLine {
+				count = b.currentCol
+			}
+		}
+		for x := 0; x < count && x < b.width; x++ {
+			b.cells[y][x] = Cell{Rune: b.lines[row][x], Color: b.colors[row][x]}
+		}
+
+		if row == b.currentLine && count < b.width && (b.frameCount/b.blinkFrames)%2 == 0 {
+			b.cells[y][count] = Cell{Rune: b.cursorSymbol, Color: b.textColor}
+		}
+	}
+	return b.cells
+}
+
+// Render converts the boot log to colored text output
+func (b *BootEffect) Render() string {
+	return renderCellsBatched(b.RenderCells())
+}
+
+// WriteTo streams the boot log to w, see io.WriterTo
+func (b *BootEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCellsBatched(w, b.RenderCells())
+}
+
+// Reset clears the screen and starts the log from the first line
+func (b *BootEffect) Reset() {
+	b.phase = "printing"
+	b.currentLine = 0
+	b.currentCol = 0
+	b.frameCounter = 0
+	b.pause = 0
+	b.frameCount = 0
+	b.holdFrameCount = 0
+}
+
+// Resize updates the effect dimensions; the log keeps printing where it was
+func (b *BootEffect) Resize(width, height int) {
+	b.width = width
+	b.height = height
+}
+
+// IsComplete returns whether the whole log has been printed
+func (b *BootEffect) IsComplete() bool {
+	return b.phase == "holding"
+}
@@ -0,0 +1,43 @@
+package animations
+
+import "testing"
+
+func TestBoot_MarkerColors(t *testing.T) {
+	b := NewBootEffect(BootConfig{Width: 40, Height: 5, Text: "[  OK  ] Started a\n[FAIL] b\nplain [x]", OKColor: "#00ff00", FailColor: "#ff0000", TextColor: "#aaaaaa"})
+
+	if got := b.colors[0][4]; got != "#00ff00" {
+		t.Errorf("OK marker colored %s, want #00ff00", got)
+	}
+	if got := b.colors[0][0]; got != "#aaaaaa" {
+		t.Errorf("marker bracket colored %s, want the text color", got)
+	}
+	if got := b.colors[1][2]; got != "#ff0000" {
+		t.Errorf("FAIL marker colored %s, want #ff0000", got)
+	}
+	if got := b.colors[2][7]; got != "#aaaaaa" {
+		t.Errorf("unknown marker colored %s, want the text color", got)
+	}
+}
+
+func TestBoot_Scrolls(t *testing.T) {
+	text := "one\ntwo\nthree\nfour\nfive"
+	b := NewBootEffect(BootConfig{Width: 10, Height: 3, Text: text, PrintSpeed: 10, LineDelay: 1, Display: true, Seed: 1})
+	for i := 0; i < 100 && !b.IsComplete(); i++ {
+		b.Update()
+	}
+	if !b.IsComplete() {
+		t.Fatal("log never finished printing")
+	}
+
+	// The last two lines stay on screen above the cursor row
+	cells := b.RenderCells()
+	for y, want := range []string{"four", "five"} {
+		got := ""
+		for _, cell := range cells[y][:len(want)] {
+			got += string(cell.Rune)
+		}
+		if got != want {
+			t.Errorf("row %d = %q, want %q", y, got, want)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package animations
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss/v2"
+)
+
+// Cell is a single character position of a rendered frame. A wide glyph
+// such as a CJK character fills two cells: its own and a WideFill cell after it.
+type Cell struct {
+	Rune       rune   // Character to draw, WideFill for the right half of a wide glyph
+	Color      string // Foreground hex color, empty for the terminal default
+	Background string // Background hex color, empty for the terminal default
+	Bold       bool   // Draw bold, which many terminals also show brighter
+}
+
+// WideFill is the Rune of the cell covered by the right half of the wide
+// glyph before it. Renderers draw nothing for it, since the terminal already
+// advanced past that column when it drew the wide glyph.
+const WideFill rune = 0
+
+// CellRenderer is implemented by effects that can expose their frame as raw cells.
+// The returned grid is height rows of width cells and may be reused by the
+// next call, so copy it if it needs to outlive the frame.
+type CellRenderer interface {
+	RenderCells() [][]Cell
+}
+
+// styleKey is the part of a cell that decides its lipgloss style
+type styleKey struct {
+	color      string
+	background string
+	bold       bool
+}
+
+// maxCachedStyles bounds the style cache; effects with smooth gradients can
+// produce many distinct colors, so the cache is cleared rather than left to grow
+const maxCachedStyles = 4096
+
+// styleCache shares lipgloss styles between frames and effects
+var styleCache = struct {
+	sync.Mutex
+	styles map[styleKey]lipgloss.Style
+}{styles: make(map[styleKey]lipgloss.Style)}
+
+// styleFor returns the lipgloss style for a cell's colors and weight,
+// building it once and reusing it on later calls
+func styleFor(cell Cell) lipgloss.Style {
+	cell = CurrentColorProfile().Apply(cell)
+	key := styleKey{color: cell.Color, background: cell.Background, bold: cell.Bold}
+
+	styleCache.Lock()
+	defer styleCache.Unlock()
+
+	if style, ok := styleCache.styles[key]; ok {
+		return style
+	}
+
+	style := lipgloss.NewStyle().Bold(cell.Bold)
+	if cell.Color != "" {
+		style = style.Foreground(lipgloss.Color(cell.Color))
+	}
+	if cell.Background != "" {
+		style = style.Background(lipgloss.Color(cell.Background))
+	}
+
+	if len(styleCache.styles) >= maxCachedStyles {
+		clear(styleCache.styles)
+	}
+	styleCache.styles[key] = style
+	return style
+}
+
+// newCellGrid allocates a grid of blank cells
+func newCellGrid(width, height int) [][]Cell {
+	grid := make([][]Cell, height)
+	for y := range grid {
+		grid[y] = make([]Cell, width)
+		for x := range grid[y] {
+			grid[y][x] = Cell{Rune: ' '}
+		}
+	}
+	return grid
+}
+
+// resetCellGrid blanks grid for reuse as the next frame, allocating a new
+// grid only when it doesn't match the requested size
+func resetCellGrid(grid [][]Cell, width, height int) [][]Cell {
+	if len(grid) != height || (height > 0 && len(grid[0]) != width) {
+		return newCellGrid(width, height)
+	}
+	for y := range grid {
+		for x := range grid[y] {
+			grid[y][x] = Cell{Rune: ' '}
+		}
+	}
+	return grid
+}
+
+// frameBuffer keeps the canvas/colors pair used by most effects, and the
+// cell grid built from it, alive between frames
+type frameBuffer struct {
+	canvas [][]rune
+	colors [][]string
+	cells  [][]Cell
+}
+
+// clear blanks the canvas and colors for a new frame and returns them,
+// reallocating only when the size changed
+func (f *frameBuffer) clear(width, height int) ([][]rune, [][]string) {
+	if len(f.canvas) != height || (height > 0 && len(f.canvas[0]) != width) {
+		f.canvas = make([][]rune, height)
+		f.colors = make([][]string, height)
+		for y := range f.canvas {
+			f.canvas[y] = make([]rune, width)
+			f.colors[y] = make([]string, width)
+		}
+		f.cells = newCellGrid(width, height)
+	}
+	for y := range f.canvas {
+		for x := range f.canvas[y] {
+			f.canvas[y][x] = ' '
+			f.colors[y][x] = ""
+		}
+	}
+	return f.canvas, f.colors
+}
+
+// toCells combines the canvas and colors into the buffer's cell grid
+func (f *frameBuffer) toCells() [][]Cell {
+	for y := range f.canvas {
+		for x, char := range f.canvas[y] {
+			f.cells[y][x] = Cell{Rune: char, Color: f.colors[y][x]}
+		}
+	}
+	return f.cells
+}
+
+// frameWriter streams a frame to w, counting the bytes written and keeping
+// the first error, after which the rest of the frame is dropped
+type frameWriter struct {
+	w       io.Writer
+	n       int64
+	err     error
+	scratch [utf8.UTFMax]byte
+}
+
+// WriteString writes s unless an earlier write failed
+func (f *frameWriter) WriteString(s string) {
+	if f.err != nil {
+		return
+	}
+	n, err := io.WriteString(f.w, s)
+	f.n += int64(n)
+	f.err = err
+}
+
+// WriteRune writes r unless an earlier write failed
+func (f *frameWriter) WriteRune(r rune) {
+	if f.err != nil {
+		return
+	}
+	n, err := f.w.Write(f.scratch[:utf8.EncodeRune(f.scratch[:], r)])
+	f.n += int64(n)
+	f.err = err
+}
+
+// writeFrame writes a frame already rendered to a string, for effects that
+// only render strings
+func writeFrame(w io.Writer, frame string) (int64, error) {
+	n, err := io.WriteString(w, frame)
+	return int64(n), err
+}
+
+// renderCells converts a cell grid to colored text output using lipgloss
+func renderCells(cells [][]Cell) string {
+	var output strings.Builder
+	writeCells(&output, cells)
+	return output.String()
+}
+
+// writeCells streams a cell grid to w as renderCells would render it
+func writeCells(w io.Writer, cells [][]Cell) (int64, error) {
+	out := &frameWriter{w: w}
+	for y, row := range cells {
+		if y > 0 {
+			out.WriteString("\n")
+		}
+		for _, cell := range row {
+			if cell.Rune == WideFill {
+				continue
+			}
+			if cell.Background != "" || (cell.Rune != ' ' && cell.Color != "") {
+				out.WriteString(styleFor(cell).Render(string(cell.Rune)))
+			} else {
+				out.WriteRune(cell.Rune)
+			}
+		}
+	}
+	return out.n, out.err
+}
+
+// renderCellsBatched converts a cell grid to colored text output, emitting one
+// raw ANSI color code per run of same-styled cells instead of one per cell
+func renderCellsBatched(cells [][]Cell) string {
+	var output strings.Builder
+	writeCellsBatched(&output, cells)
+	return output.String()
+}
+
+// writeCellsBatched streams a cell grid to w as renderCellsBatched would render it
+func writeCellsBatched(w io.Writer, cells [][]Cell) (int64, error) {
+	out := &frameWriter{w: w}
+
+	profile := CurrentColorProfile()
+	var batchChars strings.Builder
+	for y, row := range cells {
+		var current Cell
+
+		flush := func() {
+			if batchChars.Len() > 0 {
+				out.WriteString("\033[" + sgrParams(current) + "m" + batchChars.String() + "\033[0m")
+				batchChars.Reset()
+			}
+		}
+
+		for _, cell := range row {
+			if cell.Rune == WideFill {
+				continue
+			}
+			cell = profile.Apply(cell)
+			if cell.Background == "" && (cell.Rune == ' ' || cell.Color == "") {
+				flush()
+				out.WriteRune(cell.Rune)
+				current = Cell{}
+				continue
+			}
+
+			// If style changed, flush previous batch and start new one
+			if cell.Color != current.Color || cell.Bold != current.Bold || cell.Background != current.Background {
+				flush()
+				current = cell
+			}
+			batchChars.WriteRune(cell.Rune)
+		}
+		flush()
+
+		if y < len(cells)-1 {
+			out.WriteString("\n")
+		}
+	}
+
+	return out.n, out.err
+}
+
+// writeColored writes text in a foreground color, or plain without color
+func writeColored(out *strings.Builder, hex, text string) {
+	if hex == "" || CurrentColorProfile() == NoColor {
+		out.WriteString(text)
+		return
+	}
+	r, g, b := hexToRGB(hex)
+	fmt.Fprintf(out, "\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, text)
+}
+
+// sgrParams returns the SGR parameters that select a cell's bold, foreground and background
+func sgrParams(cell Cell) string {
+	var params []string
+	if cell.Bold {
+		params = append(params, "1")
+	}
+	if cell.Color != "" {
+		r, g, b := hexToRGB(cell.Color)
+		params = append(params, fmt.Sprintf("38;2;%d;%d;%d", r, g, b))
+	}
+	if cell.Background != "" {
+		r, g, b := hexToRGB(cell.Background)
+		params = append(params, fmt.Sprintf("48;2;%d;%d;%d", r, g, b))
+	}
+	return strings.Join(params, ";")
+}
@@ -0,0 +1,61 @@
+package animations
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+// streamingEffect is an effect that can both render and stream its frames
+type streamingEffect interface {
+	Update()
+	Render() string
+	io.WriterTo
+}
+
+func TestWriteTo_MatchesRender(t *testing.T) {
+	// Twin effects from the same seed, one rendered to a string and one
+	// streamed, covering lipgloss and batched renderers
+	effects := map[string]func() streamingEffect{
+		"matrix": func() streamingEffect {
+			return NewMatrixEffectWithConfig(MatrixConfig{Width: 30, Height: 8, Seed: 1})
+		},
+		"starfield": func() streamingEffect {
+			return NewStarfieldEffect(StarfieldConfig{Width: 30, Height: 8, Seed: 1})
+		},
+		"rain": func() streamingEffect {
+			return NewRainEffectWithConfig(RainConfig{Width: 30, Height: 8, Seed: 1})
+		},
+		"fire-text": func() streamingEffect {
+			return NewFireTextEffectWithConfig(FireTextConfig{Width: 30, Height: 8, Text: "SYSC", Palette: []string{"#330000", "#ff6600", "#ffff00"}, Seed: 1})
+		},
+		"print": func() streamingEffect {
+			return NewPrintEffect(PrintConfig{Width: 30, Height: 8, Text: "SYSC", Seed: 1})
+		},
+		"matrix-art": func() streamingEffect {
+			return NewMatrixArtEffectWithConfig(MatrixArtConfig{Width: 30, Height: 8, Text: "SYSC", Seed: 1})
+		},
+		"rain-art": func() streamingEffect {
+			return NewRainArtEffectWithConfig(RainArtConfig{Width: 30, Height: 8, Text: "SYSC", Seed: 1})
+		},
+	}
+	for name, create := range effects {
+		rendered, streamed := create(), create()
+		for range 5 {
+			rendered.Update()
+			streamed.Update()
+		}
+
+		var out bytes.Buffer
+		n, err := streamed.WriteTo(&out)
+		if err != nil {
+			t.Fatalf("%s: WriteTo failed: %v", name, err)
+		}
+		if want := rendered.Render(); out.String() != want {
+			t.Errorf("%s: WriteTo wrote %q, Render returned %q", name, out.String(), want)
+		}
+		if n != int64(out.Len()) {
+			t.Errorf("%s: WriteTo reported %d bytes, wrote %d", name, n, out.Len())
+		}
+	}
+}
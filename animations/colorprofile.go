@@ -0,0 +1,56 @@
+package animations
+
+import (
+	"os"
+	"sync/atomic"
+)
+
+// ColorProfile is how effects color their output
+type ColorProfile int32
+
+const (
+	// TrueColor draws with 24-bit color escapes (default)
+	TrueColor ColorProfile = iota
+	// NoColor draws glyphs only, without any color or bold escapes
+	NoColor
+)
+
+// colorProfile is the profile every effect renders with
+var colorProfile atomic.Int32
+
+func init() {
+	SetColorProfile(DetectColorProfile())
+}
+
+// DetectColorProfile picks the profile the environment asks for: NoColor when
+// NO_COLOR is set to anything non-empty (see no-color.org), TrueColor
+// otherwise. CLICOLOR_FORCE set to anything but "0" forces TrueColor, so color
+// can be turned back on for one run when NO_COLOR is set in a shell profile.
+func DetectColorProfile() ColorProfile {
+	if force := os.Getenv("CLICOLOR_FORCE"); force != "" && force != "0" {
+		return TrueColor
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return NoColor
+	}
+	return TrueColor
+}
+
+// SetColorProfile changes the profile every effect renders with.
+// It is detected from the environment when the package loads.
+func SetColorProfile(profile ColorProfile) {
+	colorProfile.Store(int32(profile))
+}
+
+// CurrentColorProfile returns the profile effects render with
+func CurrentColorProfile() ColorProfile {
+	return ColorProfile(colorProfile.Load())
+}
+
+// Apply returns cell as it should be drawn with the profile
+func (p ColorProfile) Apply(cell Cell) Cell {
+	if p == NoColor {
+		return Cell{Rune: cell.Rune}
+	}
+	return cell
+}
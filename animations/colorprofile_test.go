@@ -0,0 +1,53 @@
+package animations
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectColorProfile(t *testing.T) {
+	tests := []struct {
+		noColor, force string
+		want           ColorProfile
+	}{
+		{"", "", TrueColor},
+		{"1", "", NoColor},
+		{"1", "0", NoColor},
+		{"1", "1", TrueColor},
+		{"", "1", TrueColor},
+	}
+	for _, tt := range tests {
+		t.Setenv("NO_COLOR", tt.noColor)
+		t.Setenv("CLICOLOR_FORCE", tt.force)
+		if got := DetectColorProfile(); got != tt.want {
+			t.Errorf("NO_COLOR=%q CLICOLOR_FORCE=%q: got %v, want %v", tt.noColor, tt.force, got, tt.want)
+		}
+	}
+}
+
+func TestNoColorProfile_Render(t *testing.T) {
+	defer SetColorProfile(CurrentColorProfile())
+	SetColorProfile(NoColor)
+
+	fire := NewFireEffectWithConfig(FireConfig{
+		Width: 30, Height: 10, Palette: []string{"#000000", "#ff0000", "#ffff00"}, Bold: 2, Seed: 1,
+	})
+	matrix := NewMatrixEffectWithConfig(MatrixConfig{Width: 30, Height: 10, Palette: []string{"#003300", "#00ff00"}, Seed: 1})
+	fireText := NewFireTextEffectWithConfig(FireTextConfig{
+		Width: 30, Height: 10, Palette: []string{"#000000", "#ff0000"}, Text: "HI", Seed: 1,
+	})
+	for i := 0; i < 20; i++ {
+		fire.Update()
+		matrix.Update()
+		fireText.Update()
+	}
+
+	for name, frame := range map[string]string{"fire": fire.Render(), "matrix": matrix.Render(), "fire-text": fireText.Render()} {
+		if strings.Contains(frame, "\x1b[") {
+			t.Errorf("%s drew escape codes under NoColor", name)
+		}
+		if strings.TrimSpace(frame) == "" {
+			t.Errorf("%s drew nothing under NoColor", name)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package animations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// commandMaxOutput caps how much of a command's output is kept, so a
+// runaway command can't eat memory before its timeout
+const commandMaxOutput = 4096
+
+// commandGap is the space between the end of the output and its next pass
+const commandGap = 8
+
+// CommandEffect runs a shell command every so often and scrolls its output
+// across the middle of the screen like a marquee, colored by a gradient
+// that stays fixed to the screen as the text moves through it. Output lines
+// are joined into one line separated by │, so commands like date, uptime
+// or a small script make a live status display.
+type CommandEffect struct {
+	width       int
+	height      int
+	command     string
+	interval    time.Duration
+	timeout     time.Duration
+	scrollSpeed float64
+	gradient    *Gradient
+
+	text    []rune  // Output of the last run, as one line
+	offset  float64 // Columns scrolled into the current pass
+	lastRun time.Time
+	results chan commandResult // Delivers the output of a run in the background, nil when idle
+	runs    int
+	fails   int
+	frame   int
+	cells   [][]Cell // Frame grid reused between renders
+}
+
+// CommandConfig holds configuration for the command ticker effect
+type CommandConfig struct {
+	Width       int
+	Height      int
+	Command     string        // Shell command whose output scrolls by, run with sh -c
+	Interval    time.Duration // Time between runs of the command (default 5s)
+	Timeout     time.Duration // Time a run may take before it is killed (default 2s)
+	ScrollSpeed float64       // Columns scrolled per frame (default 0.5)
+	Colors      []string      // Gradient stops across the screen (default white)
+}
+
+// commandResult is the cleaned up output of one run of the command
+type commandResult struct {
+	text []rune
+	err  error
+}
+
+// NewCommandEffect creates a command ticker with the given configuration.
+// The first run starts in the background like every later one, so the
+// effect is ready at once and shows the command itself until its output
+// arrives.
+func NewCommandEffect(config CommandConfig) *CommandEffect {
+	interval := config.Interval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	scrollSpeed := config.ScrollSpeed
+	if scrollSpeed <= 0 {
+		scrollSpeed = 0.5
+	}
+
+	c := &CommandEffect{
+		width:       config.Width,
+		height:      config.Height,
+		command:     config.Command,
+		interval:    interval,
+		timeout:     timeout,
+		scrollSpeed: scrollSpeed,
+		gradient:    NewGradient(config.Colors, 24),
+		text:        []rune("$ " + config.Command),
+	}
+	c.startRun()
+	return c
+}
+
+// runCommand runs command through the shell with no input, killing it after
+// timeout, and returns its output flattened to one printable line
+func runCommand(command string, timeout time.Duration) commandResult {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	cmd := exec.CommandContext(ctx, shell, flag, command)
+	out := &cappedBuffer{limit: commandMaxOutput}
+	cmd.Stdout = out
+	cmd.Stderr = out
+	// Children that outlive the shell can hold the output open; stop
+	// waiting for them shortly after the shell is killed
+	cmd.WaitDelay = 200 * time.Millisecond
+
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out after %v", timeout)
+	}
+	return commandResult{text: flattenOutput(out.String()), err: err}
+}
+
+// cappedBuffer collects written bytes up to limit and silently drops the rest
+type cappedBuffer struct {
+	strings.Builder
+	limit int
+}
+
+// Write keeps what still fits and always reports success, so the command
+// isn't killed by a broken pipe
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); room > 0 {
+		b.Builder.Write(p[:min(len(p), room)])
+	}
+	return len(p), nil
+}
+
+// flattenOutput joins the non-blank lines of output with │, turning tabs
+// into spaces and dropping escape sequences and other control characters
+func flattenOutput(output string) []rune {
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		var clean strings.Builder
+		inEscape := false
+		for _, r := range line {
+			switch {
+			case inEscape:
+				// CSI sequences end with a letter, e.g. ESC[1;31m
+				inEscape = !unicode.IsLetter(r)
+			case r == '\x1b':
+				inEscape = true
+			case r == '\t':
+				clean.WriteRune(' ')
+			case unicode.IsPrint(r):
+				clean.WriteRune(r)
+			}
+		}
+		if trimmed := strings.TrimSpace(clean.String()); trimmed != "" {
+			lines = append(lines, trimmed)
+		}
+	}
+	return []rune(strings.Join(lines, " │ "))
+}
+
+// finishRun takes the output of a run, showing the error instead when the
+// run failed without printing anything
+func (c *CommandEffect) finishRun(result commandResult) {
+	c.runs++
+	if result.err != nil {
+		c.fails++
+		if len(result.text) == 0 {
+			result.text = []rune(fmt.Sprintf("%s: %v", c.command, result.err))
+		}
+	}
+	c.text = result.text
+}
+
+// startRun runs the command in the background, delivering its output to
+// results for Update to collect
+func (c *CommandEffect) startRun() {
+	c.lastRun = time.Now()
+	c.results = make(chan commandResult, 1)
+	go func(results chan<- commandResult) {
+		results <- runCommand(c.command, c.timeout)
+	}(c.results)
+}
+
+// Update scrolls the output one step and starts or collects a background
+// run of the command
+func (c *CommandEffect) Update() {
+	c.frame++
+
+	if c.results != nil {
+		select {
+		case result := <-c.results:
+			c.results = nil
+			c.finishRun(result)
+		default:
+		}
+	} else if time.Since(c.lastRun) >= c.interval {
+		c.startRun()
+	}
+
+	c.offset += c.scrollSpeed
+	if c.offset >= float64(len(c.text)+c.width+commandGap) {
+		c.offset = 0
+	}
+}
+
+// Stats returns the frame counter and how many runs there were and failed
+func (c *CommandEffect) Stats() Stats {
+	return Stats{Frame: c.frame, Counts: map[string]int{"runs": c.runs, "failures": c.fails}}
+}
+
+// RenderCells draws the visible part of the output on the middle row
+func (c *CommandEffect) RenderCells() [][]Cell {
+	c.cells = resetCellGrid(c.cells, c.width, c.height)
+	if c.height == 0 {
+		return c.cells
+	}
+
+	row := c.cells[c.height/2]
+	x := 0
+	for _, r := range marquee(c.text, int(c.offset), c.width) {
+		if r != ' ' {
+			putCell(row, x, Cell{Rune: r, Color: c.gradient.At(float64(x) / float64(max(c.width-1, 1)))})
+		}
+		x += runeWidth(r)
+	}
+	return c.cells
+}
+
+// Render converts the frame to colored text output
+func (c *CommandEffect) Render() string {
+	return renderCellsBatched(c.RenderCells())
+}
+
+// WriteTo streams the frame to w, see io.WriterTo
+func (c *CommandEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCellsBatched(w, c.RenderCells())
+}
+
+// Reset starts the output scrolling in from the right again
+func (c *CommandEffect) Reset() {
+	c.offset = 0
+	c.frame = 0
+}
+
+// Resize updates the effect dimensions
+func (c *CommandEffect) Resize(width, height int) {
+	c.width = width
+	c.height = height
+}
@@ -0,0 +1,82 @@
+package animations
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFlattenOutput(t *testing.T) {
+	got := string(flattenOutput("\x1b[1;32mup\x1b[0m 3 days\n\n\tload: 0.5\r\n"))
+	if want := "up 3 days │ load: 0.5"; got != want {
+		t.Errorf("flattenOutput = %q, want %q", got, want)
+	}
+}
+
+// commandRow returns the middle row of the effect as plain text
+func commandRow(c *CommandEffect) string {
+	var row strings.Builder
+	for _, cell := range c.RenderCells()[c.height/2] {
+		row.WriteRune(cell.Rune)
+	}
+	return row.String()
+}
+
+// awaitRun waits for the background run of the command and takes its output
+func awaitRun(c *CommandEffect) {
+	c.finishRun(<-c.results)
+	c.results = nil
+}
+
+func TestCommandEffect_ScrollsOutput(t *testing.T) {
+	c := NewCommandEffect(CommandConfig{Width: 20, Height: 3, Command: "printf 'hi\\nthere'", ScrollSpeed: 1, Interval: time.Hour})
+	awaitRun(c)
+	if got := commandRow(c); strings.TrimSpace(got) != "" {
+		t.Errorf("first frame = %q, want the output still off screen", got)
+	}
+
+	// After a screen width of steps the output starts at the left edge
+	for range 20 {
+		c.Update()
+	}
+	if got, want := commandRow(c), "hi │ there"; !strings.HasPrefix(got, want) {
+		t.Errorf("row = %q, want it to start with %q", got, want)
+	}
+	if stats := c.Stats(); stats.Counts["runs"] != 1 || stats.Counts["failures"] != 0 {
+		t.Errorf("stats = %v, want 1 run and no failures", stats.Counts)
+	}
+}
+
+func TestCommandEffect_Timeout(t *testing.T) {
+	start := time.Now()
+	c := NewCommandEffect(CommandConfig{Width: 80, Height: 1, Command: "sleep 5", Timeout: 50 * time.Millisecond})
+	awaitRun(c)
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("run took %v, want it killed after the timeout", elapsed)
+	}
+	if got := string(c.text); !strings.Contains(got, "timed out") {
+		t.Errorf("text = %q, want a timeout message", got)
+	}
+	if c.fails != 1 {
+		t.Errorf("failures = %d, want 1", c.fails)
+	}
+}
+
+// The constructor doesn't wait for the command, which shows until its
+// output arrives
+func TestCommandEffect_FirstRunInBackground(t *testing.T) {
+	start := time.Now()
+	c := NewCommandEffect(CommandConfig{Width: 40, Height: 1, Command: "sleep 0.3; echo done", ScrollSpeed: 40})
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("NewCommandEffect took %v, want it to return before the command finishes", elapsed)
+	}
+
+	c.Update()
+	if got, want := commandRow(c), "$ sleep 0.3; echo done"; !strings.HasPrefix(got, want) {
+		t.Errorf("row while running = %q, want it to start with %q", got, want)
+	}
+	awaitRun(c)
+	if got := string(c.text); got != "done" {
+		t.Errorf("text = %q, want the command output", got)
+	}
+}
@@ -17,9 +17,20 @@
 //	    fmt.Print(output)
 //	}
 //
+// Effects are not safe for concurrent use. Call Update and Render from one
+// goroutine, or wrap the effect with NewSyncedEffect and use its Tick method
+// when updating and drawing happen on different goroutines.
+//
 // See GUIDE.md for detailed usage examples and integration patterns.
 package animations
 
+import (
+	"math/rand"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
 // Animation interface that all effects implement
 type Animation interface {
 	// Update advances the animation by one frame
@@ -32,9 +43,114 @@ type Animation interface {
 	Reset()
 }
 
+// Stats is a read-only snapshot of what an effect is doing, for debugging
+// and status bars
+type Stats struct {
+	Phase  string         // Current phase, empty for effects without phases
+	Frame  int            // Frame counter; multi-phase effects restart it in each phase
+	Counts map[string]int // Effect-specific counts such as "fish" or "consumed"
+}
+
+// StatsReporter is implemented by effects that can report Stats
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// Resizable is implemented by effects that can adapt to a new terminal size
+type Resizable interface {
+	// Resize reinitializes the effect for the given dimensions
+	Resize(width, height int)
+}
+
 // Config holds common animation settings
 type Config struct {
 	Width  int    // Terminal width in characters
 	Height int    // Terminal height in characters
 	Theme  string // Color theme name
 }
+
+// validSpeedRange returns r if it is a usable min/max speed range,
+// otherwise the zero range that selects an effect's default speeds
+func validSpeedRange(r [2]float64) [2]float64 {
+	if r[0] <= 0 || r[1] < r[0] {
+		return [2]float64{}
+	}
+	return r
+}
+
+// Default beam symbols, thickest first
+var (
+	defaultBeamRowSymbols    = []rune{'▂', '▁', '_'}
+	defaultBeamColumnSymbols = []rune{'▌', '▍', '▎', '▏'}
+)
+
+// validSymbol reports whether s draws as exactly one printable character
+func validSymbol(s string) bool {
+	r, size := utf8.DecodeRuneInString(s)
+	return size > 0 && size == len(s) && r != utf8.RuneError && unicode.IsGraphic(r)
+}
+
+// validSymbols returns the entries of symbols that draw as one printable
+// character, or fallback when none do. Empty, multi-character or control
+// symbols from user configs would otherwise shift or blank whole rows.
+func validSymbols(symbols, fallback []string) []string {
+	var valid []string
+	for _, s := range symbols {
+		if validSymbol(s) {
+			valid = append(valid, s)
+		}
+	}
+	if len(valid) == 0 {
+		return fallback
+	}
+	return valid
+}
+
+// validRunes is validSymbols for rune symbol sets
+func validRunes(symbols, fallback []rune) []rune {
+	var valid []rune
+	for _, r := range symbols {
+		if r != utf8.RuneError && unicode.IsGraphic(r) {
+			valid = append(valid, r)
+		}
+	}
+	if len(valid) == 0 {
+		return fallback
+	}
+	return valid
+}
+
+// randomInRange returns a random value between r[0] and r[1]
+func randomInRange(rng *rand.Rand, r [2]float64) float64 {
+	return r[0] + rng.Float64()*(r[1]-r[0])
+}
+
+// newRNG returns a random source for an effect. A zero seed uses the clock,
+// any other seed makes the effect's output reproducible.
+func newRNG(seed int64) *rand.Rand {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return rand.New(rand.NewSource(seed))
+}
+
+// blendColor mixes two hex colors in Oklab, t=0 returns from and t=1 returns to
+func blendColor(from, to string, t float64) string {
+	return formatHexColor(mixRGB(parseHexColor(from), parseHexColor(to), t, Oklab))
+}
+
+// completion fires an effect's OnComplete callback the first time it finishes.
+// Reset does not rearm it, so looping effects report completion only once.
+type completion struct {
+	callback func()
+	fired    bool
+}
+
+// fire calls the callback unless it is unset or has already run
+func (c *completion) fire() {
+	if c.fired || c.callback == nil {
+		return
+	}
+	c.fired = true
+	c.callback()
+}
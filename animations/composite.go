@@ -0,0 +1,140 @@
+package animations
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// NewCellGrid returns a width x height grid of blank cells, e.g. a screen
+// buffer to composite one or more effects into with RenderInto
+func NewCellGrid(width, height int) [][]Cell {
+	return newCellGrid(width, height)
+}
+
+// RenderInto draws effect's current frame into buf with its top-left corner
+// at column ox, row oy. Only the effect's own rectangle is written, and the
+// parts that fall outside buf are clipped, so an effect sized to a box can be
+// placed inside a border or next to other content without padding its output.
+func RenderInto(buf [][]Cell, effect CellRenderer, ox, oy int) {
+	CopyCells(buf, effect.RenderCells(), ox, oy)
+}
+
+// CopyCells copies src into dst with its top-left corner at column ox, row oy,
+// clipping at the edges of dst. A wide glyph is copied together with its
+// WideFill, and is drawn blank when the edge cuts it in half.
+func CopyCells(dst, src [][]Cell, ox, oy int) {
+	for y, row := range src {
+		dy := oy + y
+		if dy < 0 {
+			continue
+		}
+		if dy >= len(dst) {
+			return
+		}
+		for x := range row {
+			copyCell(dst[dy], ox+x, row, x)
+		}
+	}
+}
+
+// copyCell draws src[i] at column x of row as part of copying src cell by
+// cell, keeping wide glyphs whole. A WideFill is skipped when the glyph
+// before it was just drawn, since putCell already covered it, and is drawn
+// blank otherwise. A wide glyph without room for its right half is blank too.
+func copyCell(row []Cell, x int, src []Cell, i int) {
+	if x < 0 || x >= len(row) {
+		return
+	}
+	cell := src[i]
+	switch {
+	case cell.Rune == WideFill:
+		if i > 0 && x > 0 && row[x].Rune == WideFill {
+			return
+		}
+		cell = Cell{Rune: ' '}
+	case runeWidth(cell.Rune) == 2 && x+1 >= len(row):
+		cell = Cell{Rune: ' ', Background: cell.Background}
+	}
+	putCell(row, x, cell)
+}
+
+// Transitions lists the styles NewTransition accepts
+var Transitions = []string{"dissolve", "wipe", "none"}
+
+// IsValidTransition reports whether name is one of Transitions
+func IsValidTransition(name string) bool {
+	return slices.Contains(Transitions, name)
+}
+
+// Transition blends an outgoing frame into an incoming one, e.g. when a
+// playlist switches effects. Every cell flips from the old frame to the new
+// one once progress passes its threshold: random for dissolve, left to right
+// for wipe, and immediately for none.
+type Transition struct {
+	style      string
+	rng        *rand.Rand
+	thresholds [][]float64 // Progress at which each cell flips, rebuilt when the size changes
+	cells      [][]Cell    // Blended grid reused between calls
+}
+
+// NewTransition returns a transition in the given style, falling back to
+// dissolve for unknown styles. seed fixes the dissolve order, 0 = random.
+func NewTransition(style string, seed int64) *Transition {
+	if !IsValidTransition(style) {
+		style = "dissolve"
+	}
+	return &Transition{style: style, rng: newRNG(seed)}
+}
+
+// Blend returns from and to mixed at progress in [0, 1], sized like to.
+// Cells outside from are blank, so frames of different sizes still blend.
+// Both halves of a wide glyph flip on the threshold of its left half, so
+// wide glyphs are never split between the two frames.
+func (t *Transition) Blend(from, to [][]Cell, progress float64) [][]Cell {
+	height := len(to)
+	width := 0
+	if height > 0 {
+		width = len(to[0])
+	}
+	if len(t.thresholds) != height || (height > 0 && len(t.thresholds[0]) != width) {
+		t.thresholds = t.newThresholds(width, height)
+	}
+
+	t.cells = resetCellGrid(t.cells, width, height)
+	for y, row := range t.cells {
+		var old []Cell
+		if y < len(from) {
+			old = from[y]
+		}
+		for x := range row {
+			// The right half of a wide glyph in either frame follows its left half
+			lead := x
+			if x > 0 && (to[y][x].Rune == WideFill || (x < len(old) && old[x].Rune == WideFill)) {
+				lead = x - 1
+			}
+			switch {
+			case t.thresholds[y][lead] < progress || progress >= 1:
+				copyCell(row, x, to[y], x)
+			case x < len(old):
+				copyCell(row, x, old, x)
+			}
+		}
+	}
+	return t.cells
+}
+
+func (t *Transition) newThresholds(width, height int) [][]float64 {
+	thresholds := make([][]float64, height)
+	for y := range thresholds {
+		thresholds[y] = make([]float64, width)
+		for x := range thresholds[y] {
+			switch t.style {
+			case "dissolve":
+				thresholds[y][x] = t.rng.Float64()
+			case "wipe":
+				thresholds[y][x] = (float64(x) + 0.5) / float64(width)
+			}
+		}
+	}
+	return thresholds
+}
@@ -0,0 +1,144 @@
+package animations
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderInto(t *testing.T) {
+	buf := NewCellGrid(6, 3)
+	RenderInto(buf, &checkerEffect{width: 2, height: 2}, 1, 1)
+	// Partly off the left and bottom edges
+	RenderInto(buf, &checkerEffect{width: 3, height: 3}, -1, 2)
+
+	want := []string{"      ", " ab   ", "baa   "}
+	for y, row := range buf {
+		var got strings.Builder
+		for _, cell := range row {
+			got.WriteRune(cell.Rune)
+		}
+		if got.String() != want[y] {
+			t.Errorf("row %d = %q, want %q", y, got.String(), want[y])
+		}
+	}
+}
+
+// wideRow lays text out as cells the way effects draw it, each wide glyph
+// followed by its WideFill
+func wideRow(text string) []Cell {
+	row := newCellGrid(displayWidth(text), 1)[0]
+	x := 0
+	for _, r := range text {
+		putCell(row, x, Cell{Rune: r})
+		x += runeWidth(r)
+	}
+	return row
+}
+
+// checkWideCells fails the test if any row of grid has a wide glyph without
+// its WideFill or a WideFill without its glyph, which would shift the rest
+// of the row on screen
+func checkWideCells(t *testing.T, grid [][]Cell) {
+	t.Helper()
+	for y, row := range grid {
+		for x, cell := range row {
+			switch {
+			case cell.Rune == WideFill && (x == 0 || runeWidth(row[x-1].Rune) != 2):
+				t.Fatalf("row %d has a WideFill at %d without a wide glyph before it", y, x)
+			case runeWidth(cell.Rune) == 2 && (x+1 >= len(row) || row[x+1].Rune != WideFill):
+				t.Fatalf("wide glyph %q at %d,%d overlaps the next cell", cell.Rune, x, y)
+			}
+		}
+	}
+}
+
+func TestCopyCells_WideGlyphs(t *testing.T) {
+	src := [][]Cell{wideRow("ア日b")}
+	tests := []struct {
+		ox   int
+		want string
+	}{
+		{-1, " 日\x00bxx"}, // ア loses its left half
+		{0, "ア\x00日\x00bx"},
+		{2, "xxア\x00日\x00"}, // b is clipped whole
+		{3, "xxxア\x00 "},    // 日 loses its right half
+	}
+	for _, tt := range tests {
+		dst := [][]Cell{wideRow("xxxxxx")}
+		CopyCells(dst, src, tt.ox, 0)
+		checkWideCells(t, dst)
+		var got strings.Builder
+		for _, cell := range dst[0] {
+			got.WriteRune(cell.Rune)
+		}
+		if got.String() != tt.want {
+			t.Errorf("copy at %d = %q, want %q", tt.ox, got.String(), tt.want)
+		}
+	}
+
+	// Writing over half of a wide glyph already in dst blanks its other half
+	dst := [][]Cell{wideRow("本本")}
+	CopyCells(dst, [][]Cell{wideRow("ab")}, 1, 0)
+	checkWideCells(t, dst)
+	if got := string([]rune{dst[0][0].Rune, dst[0][1].Rune, dst[0][2].Rune, dst[0][3].Rune}); got != " ab " {
+		t.Errorf("copy over wide glyphs = %q, want %q", got, " ab ")
+	}
+}
+
+func TestTransition_BlendWideGlyphs(t *testing.T) {
+	// Wide glyphs at odd columns in one frame and even columns in the other
+	from := [][]Cell{wideRow("aア日bア "), wideRow("日本語xyz")}
+	to := [][]Cell{wideRow("日本語xyz"), wideRow("aア日bア ")}
+	for _, style := range []string{"dissolve", "wipe"} {
+		for seed := int64(1); seed <= 5; seed++ {
+			transition := NewTransition(style, seed)
+			for step := 0; step <= 20; step++ {
+				checkWideCells(t, transition.Blend(from, to, float64(step)/20))
+			}
+		}
+	}
+}
+
+func TestTransition_Blend(t *testing.T) {
+	from := NewCellGrid(4, 2)
+	to := NewCellGrid(4, 2)
+	for y := range to {
+		for x := range to[y] {
+			from[y][x].Rune = 'a'
+			to[y][x].Rune = 'b'
+		}
+	}
+	count := func(cells [][]Cell) int {
+		n := 0
+		for _, row := range cells {
+			for _, cell := range row {
+				if cell.Rune == 'b' {
+					n++
+				}
+			}
+		}
+		return n
+	}
+
+	wipe := NewTransition("wipe", 1)
+	if got := wipe.Blend(from, to, 0.5); got[0][1].Rune != 'b' || got[0][2].Rune != 'a' {
+		t.Errorf("half-way wipe row = %q%q%q%q, want bbaa", got[0][0].Rune, got[0][1].Rune, got[0][2].Rune, got[0][3].Rune)
+	}
+
+	dissolve := NewTransition("sparkle", 1) // Unknown styles dissolve
+	prev := 0
+	for _, progress := range []float64{0, 0.3, 0.6, 1} {
+		n := count(dissolve.Blend(from, to, progress))
+		if n < prev {
+			t.Errorf("progress %.1f reveals %d cells, fewer than %d before", progress, n, prev)
+		}
+		prev = n
+	}
+	if prev != 8 {
+		t.Errorf("finished dissolve reveals %d cells, want 8", prev)
+	}
+
+	if n := count(NewTransition("none", 1).Blend(from, to, 0.01)); n != 8 {
+		t.Errorf("none reveals %d cells once started, want 8", n)
+	}
+}
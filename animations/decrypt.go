@@ -1,13 +1,11 @@
 package animations
 
 import (
-	"fmt"
+	"io"
+	"math"
 	"math/rand"
-	"strconv"
+	"slices"
 	"strings"
-	"time"
-
-	"github.com/charmbracelet/lipgloss/v2"
 )
 
 // DecryptEffect implements a movie-style text decryption animation
@@ -15,6 +13,8 @@ type DecryptEffect struct {
 	width                  int
 	height                 int
 	text                   string
+	align                  TextAlign
+	margin                 int
 	chars                  []DecryptCharacter
 	palette                []string
 	typingSpeed            int
@@ -22,9 +22,23 @@ type DecryptEffect struct {
 	finalGradientStops     []string
 	finalGradientSteps     int
 	finalGradientDirection string
+	cipherMode             string
+	mode                   string // "scatter" or "lines"
+	line                   int    // Line being typed and decrypted in lines mode
+	lastLine               int    // Last line with characters on screen
+	holdFrames             int    // Frames to hold the decrypted text
+	loop                   bool   // Restart after holding instead of staying decrypted
 	phase                  string
 	frameCount             int
+	noise                  float64  // Share of the background filled with ciphertext, 0 = none
+	noiseSymbols           []rune   // Glyphs the background cycles through
+	noiseColors            []string // Ciphertext colors dimmed for the background
+	noiseSeed              uint64   // Varies the background pattern between runs
+	tick                   int      // Frames since the effect started, never reset, so the background keeps its pace
 	rng                    *rand.Rand
+	rngSource              *seekableSource // Behind rng, for snapshots
+	completion             completion
+	cells                  [][]Cell // Frame grid reused between renders
 }
 
 // DecryptCharacter represents a single character in the decryption effect
@@ -33,6 +47,7 @@ type DecryptCharacter struct {
 	current    rune
 	x          int
 	y          int
+	line       int // Line of the text the character is on
 	visible    bool
 	animation  []DecryptAnimationFrame
 	frameIndex int
@@ -52,29 +67,96 @@ type DecryptConfig struct {
 	Height                 int
 	Text                   string
 	Palette                []string
-	TypingSpeed            int
+	TypingSpeed            int // Characters revealed per typing step (default 1)
 	CiphertextColors       []string
 	FinalGradientStops     []string
 	FinalGradientSteps     int
 	FinalGradientDirection string
+	CipherMode             string    // Scramble symbols: "full", "alnum" or "matrix" (default: "full")
+	Mode                   string    // "scatter" decrypts the whole block at once, "lines" one line after another (default: "scatter")
+	BackgroundNoise        float64   // Share of empty cells filled with faint, slowly changing ciphertext, 0 disables (max 1)
+	HoldFrames             int       // Frames to hold the decrypted text before looping (default 200)
+	Loop                   bool      // Restart after HoldFrames; when false the text stays decrypted
+	Align                  TextAlign // Horizontal placement of the text (default: center)
+	Margin                 int       // Columns kept clear at the aligned edge
+	Seed                   int64     // Random seed for reproducible output, 0 = seeded from the clock
+	OnComplete             func()    // Called once, the first time the effect finishes
+}
+
+// DecryptModes lists the modes accepted by DecryptConfig.Mode
+var DecryptModes = []string{"scatter", "lines"}
+
+// IsValidDecryptMode reports whether name is one of DecryptModes
+func IsValidDecryptMode(name string) bool {
+	return slices.Contains(DecryptModes, name)
 }
 
+// Scramble symbols each character cycles through in lines mode, and frames
+// each one shows; lines decrypt one at a time, so they resolve much faster
+// than the whole block does in scatter mode
+const (
+	decryptLineSymbols = 12
+	decryptLineFrames  = 3
+)
+
+// Background noise tuning for DecryptConfig.BackgroundNoise
+const (
+	decryptNoisePeriod = 40   // Frames each background glyph shows before changing
+	decryptNoiseDim    = 0.35 // Brightness of background glyphs relative to the ciphertext colors
+)
+
 // NewDecryptEffect creates a new decrypt effect with given configuration
 func NewDecryptEffect(config DecryptConfig) *DecryptEffect {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng, rngSource := newSeekableRNG(config.Seed)
+
+	// Zero typing speed would never reveal a character
+	typingSpeed := config.TypingSpeed
+	if typingSpeed <= 0 {
+		typingSpeed = 1
+	}
+
+	holdFrames := config.HoldFrames
+	if holdFrames <= 0 {
+		holdFrames = 200 // Default ~10 seconds at 20fps
+	}
+
+	mode := config.Mode
+	if !IsValidDecryptMode(mode) {
+		mode = "scatter"
+	}
 
 	effect := &DecryptEffect{
 		width:                  config.Width,
 		height:                 config.Height,
 		text:                   config.Text,
+		align:                  config.Align,
+		margin:                 config.Margin,
 		palette:                config.Palette,
-		typingSpeed:            config.TypingSpeed,
+		typingSpeed:            typingSpeed,
 		ciphertextColors:       config.CiphertextColors,
 		finalGradientStops:     config.FinalGradientStops,
 		finalGradientSteps:     config.FinalGradientSteps,
 		finalGradientDirection: config.FinalGradientDirection,
+		cipherMode:             config.CipherMode,
+		mode:                   mode,
+		holdFrames:             holdFrames,
+		loop:                   config.Loop,
 		phase:                  "typing",
 		rng:                    rng,
+		rngSource:              rngSource,
+		completion:             completion{callback: config.OnComplete},
+	}
+
+	if config.BackgroundNoise > 0 {
+		effect.noise = math.Min(config.BackgroundNoise, 1)
+		effect.noiseSymbols = effect.makeEncryptedSymbols()
+		for _, color := range config.CiphertextColors {
+			effect.noiseColors = append(effect.noiseColors, blendColor("#000000", color, decryptNoiseDim))
+		}
+		if len(effect.noiseColors) == 0 {
+			effect.noiseColors = []string{"#333333"}
+		}
+		effect.noiseSeed = newRNG(config.Seed).Uint64() // Own source, so the message draws stay the same
 	}
 
 	effect.init()
@@ -85,7 +167,7 @@ func NewDecryptEffect(config DecryptConfig) *DecryptEffect {
 func (d *DecryptEffect) init() {
 	lines := strings.Split(d.text, "\n")
 
-	// Calculate centered position for multi-line text
+	// Calculate aligned position for multi-line text, centered vertically
 	startY := (d.height - len(lines)) / 2
 	if startY < 0 {
 		startY = 0
@@ -93,14 +175,17 @@ func (d *DecryptEffect) init() {
 
 	// Create characters from all lines
 	for lineIdx, line := range lines {
-		startX := (d.width - len(line)) / 2
+		// Full-width characters take two columns, everything else one
+		startX := d.align.startX(d.width, displayWidth(line), d.margin)
 		if startX < 0 {
 			startX = 0
 		}
 
-		for charIdx, char := range line {
-			finalX := startX + charIdx
+		x := startX
+		for _, char := range line {
+			finalX := x
 			finalY := startY + lineIdx
+			x += runeWidth(char)
 
 			// Skip characters that would be off-screen
 			if finalX >= d.width || finalY >= d.height {
@@ -112,8 +197,10 @@ func (d *DecryptEffect) init() {
 				current:  char,
 				x:        finalX,
 				y:        finalY,
+				line:     lineIdx,
 				visible:  false,
 			})
+			d.lastLine = lineIdx
 		}
 	}
 
@@ -137,9 +224,12 @@ func (d *DecryptEffect) prepareAnimations() {
 		// Prepare typing animation (block characters)
 		typingAnimation := make([]DecryptAnimationFrame, 0)
 
-		// Add block characters with same color
-		blockChars := []rune{'▉', '▓', '▒', '░'}
-		for _, blockChar := range blockChars {
+		// Add block characters with same color; restricted cipher modes
+		// type with symbols from their own set instead
+		for _, blockChar := range []rune{'▉', '▓', '▒', '░'} {
+			if d.cipherMode == "alnum" || d.cipherMode == "matrix" {
+				blockChar = encryptedSymbols[d.rng.Intn(len(encryptedSymbols))]
+			}
 			typingAnimation = append(typingAnimation, DecryptAnimationFrame{
 				symbol: blockChar,
 				color:  ciphertextColor,
@@ -156,6 +246,19 @@ func (d *DecryptEffect) prepareAnimations() {
 		// Prepare decrypting animations
 		decryptAnimation := make([]DecryptAnimationFrame, 0)
 
+		// Lines mode only briefly scrambles each character
+		if d.mode == "lines" {
+			for j := 0; j < decryptLineSymbols; j++ {
+				decryptAnimation = append(decryptAnimation, DecryptAnimationFrame{
+					symbol: encryptedSymbols[d.rng.Intn(len(encryptedSymbols))],
+					color:  ciphertextColor,
+				})
+			}
+			decryptAnimation = append(decryptAnimation, d.discoveredFrames(char.original, finalColors[i])...)
+			char.animation = append(typingAnimation, decryptAnimation...)
+			continue
+		}
+
 		// Fast decrypt phase (80 frames with short duration = 3)
 		for j := 0; j < 80; j++ {
 			symbol := encryptedSymbols[d.rng.Intn(len(encryptedSymbols))]
@@ -175,31 +278,41 @@ func (d *DecryptEffect) prepareAnimations() {
 			})
 		}
 
-		// Discovered phase - create gradient transition from white to final color
-		discoveredGradient := d.createSimpleGradient("#ffffff", finalColors[i], 15)
-		for _, color := range discoveredGradient {
-			decryptAnimation = append(decryptAnimation, DecryptAnimationFrame{
-				symbol: char.original,
-				color:  color,
-			})
-		}
-
-		// Hold on final decrypted text for extended duration (10 seconds at 50ms/frame = 200 frames)
-		for j := 0; j < 200; j++ {
-			decryptAnimation = append(decryptAnimation, DecryptAnimationFrame{
-				symbol: char.original,
-				color:  finalColors[i],
-			})
-		}
-
+		decryptAnimation = append(decryptAnimation, d.discoveredFrames(char.original, finalColors[i])...)
 		char.animation = append(typingAnimation, decryptAnimation...)
 	}
 }
 
-// Create a list of encrypted symbols
+// discoveredFrames shows a decrypted character fading from white to its final color
+func (d *DecryptEffect) discoveredFrames(symbol rune, finalColor string) []DecryptAnimationFrame {
+	var frames []DecryptAnimationFrame
+	for _, color := range NewGradient([]string{"#ffffff", finalColor}, 14).Colors() {
+		frames = append(frames, DecryptAnimationFrame{
+			symbol: symbol,
+			color:  color,
+		})
+	}
+	return frames
+}
+
+// Create a list of encrypted symbols for the configured cipher mode
 func (d *DecryptEffect) makeEncryptedSymbols() []rune {
 	var symbols []rune
 
+	switch d.cipherMode {
+	case "alnum":
+		for _, r := range "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789" {
+			symbols = append(symbols, r)
+		}
+		return symbols
+	case "matrix":
+		// Katakana (0x30A0-0x30FF)
+		for i := 0x30A0; i <= 0x30FF; i++ {
+			symbols = append(symbols, rune(i))
+		}
+		return symbols
+	}
+
 	// Keyboard characters (33-126)
 	for i := 33; i <= 126; i++ {
 		symbols = append(symbols, rune(i))
@@ -292,70 +405,10 @@ func (d *DecryptEffect) calculateGradientColors() []string {
 	return colors
 }
 
-// Create a simple gradient between two colors with specified steps
-func (d *DecryptEffect) createSimpleGradient(startColor, endColor string, steps int) []string {
-	if steps <= 0 {
-		return []string{endColor}
-	}
-
-	gradient := make([]string, steps)
-
-	// Parse start color
-	startR, startG, startB := d.parseHexColor(startColor)
-
-	// Parse end color
-	endR, endG, endB := d.parseHexColor(endColor)
-
-	// Calculate step increments
-	rStep := float64(endR-startR) / float64(steps-1)
-	gStep := float64(endG-startG) / float64(steps-1)
-	bStep := float64(endB-startB) / float64(steps-1)
-
-	// Generate gradient colors
-	for i := 0; i < steps; i++ {
-		r := int(float64(startR) + float64(i)*rStep)
-		g := int(float64(startG) + float64(i)*gStep)
-		b := int(float64(startB) + float64(i)*bStep)
-
-		// Clamp values to 0-255
-		r = d.clamp(r, 0, 255)
-		g = d.clamp(g, 0, 255)
-		b = d.clamp(b, 0, 255)
-
-		gradient[i] = fmt.Sprintf("#%02x%02x%02x", r, g, b)
-	}
-
-	return gradient
-}
-
-// Parse hex color string to RGB values
-func (d *DecryptEffect) parseHexColor(hex string) (int, int, int) {
-	if len(hex) < 8 || hex[0] != '#' {
-		// Default to white if invalid
-		return 255, 255, 255
-	}
-
-	r, _ := strconv.ParseInt(hex[1:3], 16, 64)
-	g, _ := strconv.ParseInt(hex[3:5], 16, 64)
-	b, _ := strconv.ParseInt(hex[5:7], 16, 64)
-
-	return int(r), int(g), int(b)
-}
-
-// Clamp value between min and max
-func (d *DecryptEffect) clamp(value, min, max int) int {
-	if value < min {
-		return min
-	}
-	if value > max {
-		return max
-	}
-	return value
-}
-
 // Update advances the decrypt animation by one frame
 func (d *DecryptEffect) Update() {
 	d.frameCount++
+	d.tick++
 
 	switch d.phase {
 	case "typing":
@@ -363,16 +416,20 @@ func (d *DecryptEffect) Update() {
 	case "decrypting":
 		d.updateDecryptingPhase()
 	case "complete":
-		// Hold for 60 frames (3 seconds) then auto-reset for looping
-		if d.frameCount >= 60 {
+		// Hold the decrypted text, then restart if looping
+		if d.loop && d.frameCount >= d.holdFrames {
 			d.Reset()
 		}
 		return
 	}
 }
 
-// Update the typing phase of the animation
+// Update the typing phase of the animation. Scatter mode types the whole
+// block, then decrypts it at once; lines mode types only the current line,
+// which decrypts as it is typed, and moves on once the line is readable.
 func (d *DecryptEffect) updateTypingPhase() {
+	lines := d.mode == "lines"
+
 	// Randomly decide whether to type new characters (75% chance)
 	if len(d.getVisibleChars()) < len(d.chars) && d.rng.Intn(100) <= 75 {
 		// Make a few characters visible based on typing speed
@@ -381,7 +438,7 @@ func (d *DecryptEffect) updateTypingPhase() {
 			if visibleCount < len(d.chars) {
 				// Find the next invisible character
 				for j := 0; j < len(d.chars); j++ {
-					if !d.chars[j].visible {
+					if !d.chars[j].visible && (!lines || d.chars[j].line == d.line) {
 						d.chars[j].visible = true
 						d.chars[j].frameIndex = 0
 						d.chars[j].duration = 0
@@ -394,11 +451,16 @@ func (d *DecryptEffect) updateTypingPhase() {
 
 	// Update visible characters
 	for i := range d.chars {
-		if d.chars[i].visible {
+		if d.chars[i].visible && (!lines || d.chars[i].line == d.line) {
 			d.updateCharacter(&d.chars[i])
 		}
 	}
 
+	if lines {
+		d.advanceLine()
+		return
+	}
+
 	// Transition to decrypting phase when typing is complete
 	if len(d.getVisibleChars()) == len(d.chars) && d.allCharsStill() {
 		d.phase = "decrypting"
@@ -414,6 +476,23 @@ func (d *DecryptEffect) updateTypingPhase() {
 	}
 }
 
+// advanceLine moves lines mode on to the next line once every character of
+// the current one is typed and decrypted, completing after the last line
+func (d *DecryptEffect) advanceLine() {
+	for _, char := range d.chars {
+		if char.line == d.line && (!char.visible || char.frameIndex < len(char.animation)) {
+			return
+		}
+	}
+
+	d.line++
+	if d.line > d.lastLine {
+		d.phase = "complete"
+		d.completion.fire()
+		d.frameCount = 0 // Reset frame counter for hold phase
+	}
+}
+
 // Check if all visible characters are still (not animating)
 func (d *DecryptEffect) allCharsStill() bool {
 	for _, char := range d.chars {
@@ -450,6 +529,7 @@ func (d *DecryptEffect) updateDecryptingPhase() {
 	// Move to complete phase when all done
 	if allDone {
 		d.phase = "complete"
+		d.completion.fire()
 		d.frameCount = 0 // Reset frame counter for hold phase
 	}
 }
@@ -466,9 +546,12 @@ func (d *DecryptEffect) updateCharacter(char *DecryptCharacter) {
 	// Determine frame duration based on current animation phase
 	frameDuration := 3 // Default for typing phase (slowed down)
 
-	// Check if we're in the decrypting phase (past the typing frames)
+	// Check if we're in the decrypting phase (past the typing frames);
+	// lines mode keeps a steady pace so each line resolves quickly
 	typingFrames := 5 // 4 block chars + 1 encrypted symbol
-	if char.frameIndex >= typingFrames {
+	if d.mode == "lines" {
+		frameDuration = decryptLineFrames
+	} else if char.frameIndex >= typingFrames {
 		// Decrypting phase - much slower variable durations
 		if d.rng.Intn(100) <= 40 {
 			frameDuration = d.rng.Intn(100) + 80 // Longer duration (80-180)
@@ -503,38 +586,90 @@ func (d *DecryptEffect) getVisibleChars() []DecryptCharacter {
 	return visible
 }
 
+// Stats reports the phase, frame count and how many characters are showing
+func (d *DecryptEffect) Stats() Stats {
+	visible := 0
+	for _, char := range d.chars {
+		if char.visible {
+			visible++
+		}
+	}
+	return Stats{
+		Phase: d.phase,
+		Frame: d.frameCount,
+		Counts: map[string]int{
+			"characters": len(d.chars),
+			"visible":    visible,
+		},
+	}
+}
+
 // Render converts the decrypt effect to colored text output
 func (d *DecryptEffect) Render() string {
-	// Create a buffer to hold the output
-	buffer := make([][]string, d.height)
-	for i := range buffer {
-		buffer[i] = make([]string, d.width)
-		for j := range buffer[i] {
-			buffer[i][j] = " "
-		}
+	return renderCells(d.RenderCells())
+}
+
+// WriteTo streams the decrypt effect to w, see io.WriterTo
+func (d *DecryptEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, d.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (d *DecryptEffect) RenderCells() [][]Cell {
+	d.cells = resetCellGrid(d.cells, d.width, d.height)
+	cells := d.cells
+
+	if d.noise > 0 {
+		d.renderNoise(cells)
 	}
 
 	// Render visible characters
 	for _, char := range d.chars {
 		if char.visible && char.y >= 0 && char.y < d.height && char.x >= 0 && char.x < d.width {
-			style := lipgloss.NewStyle().Foreground(lipgloss.Color(char.color))
-			buffer[char.y][char.x] = style.Render(string(char.current))
+			putCell(cells[char.y], char.x, Cell{Rune: char.current, Color: char.color})
 		}
 	}
 
-	// Convert buffer to string
-	var lines []string
-	for _, line := range buffer {
-		lines = append(lines, strings.Join(line, ""))
+	return cells
+}
+
+// renderNoise fills the grid with faint ciphertext behind the message. Each
+// cell changes every decryptNoisePeriod frames, at its own offset so only a
+// few change at once, and is picked by hashing its position and time rather
+// than drawing from rng, so the message animates the same with or without it.
+func (d *DecryptEffect) renderNoise(cells [][]Cell) {
+	for y, row := range cells {
+		for x := 0; x < len(row); {
+			phase := int(d.noiseHash(x, y, -1) % decryptNoisePeriod)
+			h := d.noiseHash(x, y, (d.tick+phase)/decryptNoisePeriod)
+			if float64(h%1000) >= d.noise*1000 {
+				x++
+				continue
+			}
+			symbol := d.noiseSymbols[(h/1000)%uint64(len(d.noiseSymbols))]
+			color := d.noiseColors[(h/1000/uint64(len(d.noiseSymbols)))%uint64(len(d.noiseColors))]
+			putCell(row, x, Cell{Rune: symbol, Color: color})
+			x += runeWidth(symbol)
+		}
 	}
+}
 
-	return strings.Join(lines, "\n")
+// noiseHash mixes a cell position and time step into a pseudo-random number
+func (d *DecryptEffect) noiseHash(x, y, step int) uint64 {
+	h := d.noiseSeed ^ uint64(x)*0x9e3779b97f4a7c15 ^ uint64(y)*0xc2b2ae3d27d4eb4f ^ uint64(step)*0x165667b19e3779f9
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
 }
 
 // Reset restarts the animation from the beginning
 func (d *DecryptEffect) Reset() {
 	d.phase = "typing"
 	d.frameCount = 0
+	d.line = 0
 
 	// Reset character states
 	for i := range d.chars {
@@ -547,3 +682,56 @@ func (d *DecryptEffect) Reset() {
 	// Reprepare animations
 	d.prepareAnimations()
 }
+
+// DecryptSnapshot is the mutable state of a DecryptEffect, see Snapshot
+type DecryptSnapshot struct {
+	width, height int
+	phase         string
+	frameCount    int
+	line          int
+	tick          int
+	chars         []DecryptCharacter
+	rng           rngState
+}
+
+// Snapshot captures the effect's current state, including its place in the
+// random sequence, so Restore can later seek back to this exact frame
+func (d *DecryptEffect) Snapshot() DecryptSnapshot {
+	return DecryptSnapshot{
+		width:      d.width,
+		height:     d.height,
+		phase:      d.phase,
+		frameCount: d.frameCount,
+		line:       d.line,
+		tick:       d.tick,
+		chars:      append([]DecryptCharacter(nil), d.chars...),
+		rng:        d.rngSource.state(),
+	}
+}
+
+// Restore returns the effect to a state captured by Snapshot, including the
+// size it had then. Restoring an early snapshot of a long run replays the
+// random draws made since the seed. OnComplete is not called again.
+func (d *DecryptEffect) Restore(snap DecryptSnapshot) {
+	d.width = snap.width
+	d.height = snap.height
+	d.phase = snap.phase
+	d.frameCount = snap.frameCount
+	d.line = snap.line
+	d.tick = snap.tick
+	// Character animations are rebuilt rather than changed in place, so
+	// sharing them with the snapshot is safe
+	d.chars = append(d.chars[:0], snap.chars...)
+	d.rngSource.seek(snap.rng)
+}
+
+// Resize reinitializes the decrypt effect with new dimensions
+func (d *DecryptEffect) Resize(width, height int) {
+	d.width = width
+	d.height = height
+	d.phase = "typing"
+	d.frameCount = 0
+	d.line = 0
+	d.chars = nil
+	d.init()
+}
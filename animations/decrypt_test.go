@@ -0,0 +1,132 @@
+package animations
+
+import "testing"
+
+func TestDecryptEffect_CentersMultiByteRunes(t *testing.T) {
+	line := "é│x│é"
+	effect := NewDecryptEffect(DecryptConfig{
+		Width:            11,
+		Height:           1,
+		Text:             line,
+		TypingSpeed:      1,
+		CiphertextColors: []string{"#00ff00"},
+	})
+
+	runes := []rune(line)
+	if len(effect.chars) != len(runes) {
+		t.Fatalf("got %d characters, want %d", len(effect.chars), len(runes))
+	}
+
+	// 5 runes centered in 11 columns start at column 3
+	startX := (11 - len(runes)) / 2
+	for i, char := range effect.chars {
+		if char.original != runes[i] {
+			t.Errorf("char %d: got %q, want %q", i, char.original, runes[i])
+		}
+		if char.x != startX+i {
+			t.Errorf("char %d (%q): got column %d, want %d", i, char.original, char.x, startX+i)
+		}
+	}
+}
+
+func TestDecryptEffect_LinesMode(t *testing.T) {
+	effect := NewDecryptEffect(DecryptConfig{
+		Width:            20,
+		Height:           3,
+		Text:             "first\nsecond",
+		CiphertextColors: []string{"#00ff00"},
+		Mode:             "lines",
+		Seed:             1,
+	})
+
+	for frame := 0; effect.phase != "complete"; frame++ {
+		if frame > 2000 {
+			t.Fatal("lines mode never completed")
+		}
+		effect.Update()
+
+		// The second line stays hidden until the first reads as plain text
+		for _, char := range effect.chars {
+			if char.line == 1 && char.visible && effect.line == 0 {
+				t.Fatalf("frame %d: second line typed while the first is decrypting", frame)
+			}
+		}
+	}
+
+	for _, char := range effect.chars {
+		if !char.visible || char.current != char.original {
+			t.Errorf("char %q shows %q after completing", char.original, char.current)
+		}
+	}
+
+	if scatter := NewDecryptEffect(DecryptConfig{Text: "x", Mode: "bogus"}); scatter.mode != "scatter" {
+		t.Errorf("unknown mode became %q, want scatter", scatter.mode)
+	}
+}
+
+func TestDecryptEffect_BackgroundNoise(t *testing.T) {
+	config := DecryptConfig{
+		Width:            40,
+		Height:           10,
+		Text:             "secret",
+		CiphertextColors: []string{"#00ff00"},
+		Seed:             1,
+	}
+	plain := NewDecryptEffect(config)
+	config.BackgroundNoise = 0.5
+	noisy := NewDecryptEffect(config)
+
+	count := func(cells [][]Cell) int {
+		n := 0
+		for _, row := range cells {
+			for _, cell := range row {
+				if cell.Rune != ' ' {
+					n++
+				}
+			}
+		}
+		return n
+	}
+
+	var prev [][]Cell
+	for i := 0; i < 30; i++ {
+		plain.Update()
+		noisy.Update()
+		cells := noisy.RenderCells()
+
+		// Roughly half the background is filled, and only a few cells change per frame
+		if n := count(cells); n < 120 || n > 280 {
+			t.Fatalf("frame %d: %d of 400 cells filled, want about half", i, n)
+		}
+		if prev != nil {
+			changed := 0
+			for y := range cells {
+				for x := range cells[y] {
+					if cells[y][x] != prev[y][x] {
+						changed++
+					}
+				}
+			}
+			if changed > 40 {
+				t.Errorf("frame %d: %d cells changed, want a slow shimmer", i, changed)
+			}
+		}
+		prev = copyGrid(cells)
+	}
+
+	// The message itself is unaffected by the background
+	for i, char := range noisy.chars {
+		if want := plain.chars[i]; char.current != want.current || char.visible != want.visible {
+			t.Fatalf("char %d differs with background noise", i)
+		}
+	}
+}
+
+// copyGrid returns a copy of a cell grid an effect may reuse
+func copyGrid(cells [][]Cell) [][]Cell {
+	out := make([][]Cell, len(cells))
+	for y, row := range cells {
+		out[y] = append([]Cell(nil), row...)
+	}
+	return out
+}
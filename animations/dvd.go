@@ -0,0 +1,186 @@
+package animations
+
+import (
+	"io"
+	"math"
+	"math/rand"
+	"strings"
+)
+
+// dvdFlashFrames is how long the art flashes after an exact corner hit
+const dvdFlashFrames = 30
+
+// DVDEffect bounces a block of ASCII art around the screen like an idle DVD
+// player logo. The art changes color every time it hits an edge and flashes
+// through all its colors when it lands exactly in a corner.
+type DVDEffect struct {
+	width   int
+	height  int
+	lines   [][]rune
+	artW    int
+	artH    int
+	colors  []string
+	speed   float64
+	x, y    float64
+	dx, dy  float64
+	color   int // Index into colors
+	flash   int // Frames of corner flash left
+	bounces int
+	corners int
+	frame   int
+	rng     *rand.Rand
+	cells   [][]Cell // Frame grid reused between renders
+}
+
+// DVDConfig holds configuration for the DVD bounce effect
+type DVDConfig struct {
+	Width  int
+	Height int
+	Text   string   // ASCII art to bounce
+	Colors []string // Colors cycled on every edge hit (default white)
+	Speed  float64  // Columns moved per frame, rows move at half this (default 0.5)
+	Seed   int64    // Random seed for reproducible output, 0 = seeded from the clock
+}
+
+// NewDVDEffect creates a DVD bounce effect with the given configuration
+func NewDVDEffect(config DVDConfig) *DVDEffect {
+	speed := config.Speed
+	if speed <= 0 {
+		speed = 0.5
+	}
+	colors := config.Colors
+	if len(colors) == 0 {
+		colors = []string{"#ffffff"}
+	}
+
+	d := &DVDEffect{
+		width:  config.Width,
+		height: config.Height,
+		colors: colors,
+		speed:  speed,
+		rng:    newRNG(config.Seed),
+	}
+	for _, line := range strings.Split(strings.TrimRight(config.Text, "\n"), "\n") {
+		runes := []rune(line)
+		d.lines = append(d.lines, runes)
+		d.artW = max(d.artW, len(runes))
+	}
+	d.artH = len(d.lines)
+
+	d.Reset()
+	return d
+}
+
+// Reset starts the art from a random spot heading in a random diagonal
+func (d *DVDEffect) Reset() {
+	d.x = d.rng.Float64() * float64(max(d.width-d.artW, 0))
+	d.y = d.rng.Float64() * float64(max(d.height-d.artH, 0))
+	d.dx, d.dy = d.speed, d.speed/2 // Cells are about twice as tall as wide
+	if d.rng.Intn(2) == 0 {
+		d.dx = -d.dx
+	}
+	if d.rng.Intn(2) == 0 {
+		d.dy = -d.dy
+	}
+	d.color = d.rng.Intn(len(d.colors))
+	d.flash = 0
+	d.bounces = 0
+	d.corners = 0
+	d.frame = 0
+}
+
+// Update moves the art one step, bouncing off the edges
+func (d *DVDEffect) Update() {
+	d.frame++
+	if d.flash > 0 {
+		d.flash--
+	}
+
+	hitX := bounce(&d.x, &d.dx, float64(d.width-d.artW))
+	hitY := bounce(&d.y, &d.dy, float64(d.height-d.artH))
+	if !hitX && !hitY {
+		return
+	}
+
+	d.bounces++
+	d.nextColor()
+	if hitX && hitY {
+		d.corners++
+		d.flash = dvdFlashFrames
+	}
+}
+
+// bounce moves pos by vel within [0, limit], reflecting vel and reporting
+// true when it reaches either end. Art wider than the screen stays put.
+func bounce(pos, vel *float64, limit float64) bool {
+	if limit <= 0 {
+		*pos = 0
+		return false
+	}
+	*pos += *vel
+	switch {
+	case *pos <= 0:
+		*pos = 0
+	case *pos >= limit:
+		*pos = limit
+	default:
+		return false
+	}
+	*vel = -*vel
+	return true
+}
+
+// nextColor switches to a different color from the palette
+func (d *DVDEffect) nextColor() {
+	if len(d.colors) < 2 {
+		return
+	}
+	d.color = (d.color + 1 + d.rng.Intn(len(d.colors)-1)) % len(d.colors)
+}
+
+// Resize adapts to new dimensions, keeping the art on screen
+func (d *DVDEffect) Resize(width, height int) {
+	d.width, d.height = width, height
+	d.x = math.Min(d.x, float64(max(width-d.artW, 0)))
+	d.y = math.Min(d.y, float64(max(height-d.artH, 0)))
+}
+
+// Stats returns the frame counter and how many edge and corner hits there were
+func (d *DVDEffect) Stats() Stats {
+	return Stats{Frame: d.frame, Counts: map[string]int{"bounces": d.bounces, "corners": d.corners}}
+}
+
+// RenderCells draws the art at its current position
+func (d *DVDEffect) RenderCells() [][]Cell {
+	d.cells = resetCellGrid(d.cells, d.width, d.height)
+
+	// A corner hit cycles through every color for a moment
+	color := d.colors[d.color]
+	if d.flash > 0 {
+		color = d.colors[(d.flash/2)%len(d.colors)]
+	}
+
+	ox, oy := int(math.Round(d.x)), int(math.Round(d.y))
+	for row, line := range d.lines {
+		y := oy + row
+		if y < 0 || y >= d.height {
+			continue
+		}
+		for col, char := range line {
+			if x := ox + col; x >= 0 && x < d.width && char != ' ' {
+				d.cells[y][x] = Cell{Rune: char, Color: color, Bold: d.flash > 0}
+			}
+		}
+	}
+	return d.cells
+}
+
+// Render converts the frame to colored text output
+func (d *DVDEffect) Render() string {
+	return renderCellsBatched(d.RenderCells())
+}
+
+// WriteTo streams the frame to w, see io.WriterTo
+func (d *DVDEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCellsBatched(w, d.RenderCells())
+}
@@ -0,0 +1,38 @@
+package animations
+
+import "testing"
+
+func TestDVD_BouncesAndCorners(t *testing.T) {
+	d := NewDVDEffect(DVDConfig{Width: 10, Height: 5, Text: "ab\ncd", Colors: []string{"#ff0000", "#00ff00", "#0000ff"}, Speed: 1, Seed: 1})
+
+	// Head straight for the bottom-right corner
+	d.x, d.y, d.dx, d.dy = 6, 2, 1, 0.5
+	color := d.color
+	for i := 0; i < 2; i++ {
+		d.Update()
+	}
+	if d.x != 8 || d.y != 3 {
+		t.Fatalf("art at %.1f,%.1f, want the corner 8,3", d.x, d.y)
+	}
+	if d.corners != 1 || d.flash == 0 {
+		t.Errorf("corner hit not detected: corners=%d flash=%d", d.corners, d.flash)
+	}
+	if d.color == color {
+		t.Error("color unchanged after hitting an edge")
+	}
+	if d.dx >= 0 || d.dy >= 0 {
+		t.Errorf("velocity %.1f,%.1f not reflected", d.dx, d.dy)
+	}
+
+	cells := d.RenderCells()
+	if cells[3][8].Rune != 'a' || cells[4][9].Rune != 'd' || !cells[3][8].Bold {
+		t.Errorf("art not drawn flashing in the corner")
+	}
+
+	// Art bigger than the screen stays pinned at the origin
+	big := NewDVDEffect(DVDConfig{Width: 1, Height: 1, Text: "wide", Seed: 1})
+	big.Update()
+	if big.x != 0 || big.bounces != 0 {
+		t.Errorf("oversized art moved to %.1f with %d bounces", big.x, big.bounces)
+	}
+}
@@ -0,0 +1,233 @@
+// Package ease provides the Robert Penner easing functions used to pace
+// effect movement. Every function maps progress t in [0, 1] to eased
+// progress, with f(0) == 0 and f(1) == 1. Back and elastic curves overshoot
+// in between.
+package ease
+
+import "math"
+
+// Func maps linear progress in [0, 1] to eased progress
+type Func func(t float64) float64
+
+const (
+	backC1    = 1.70158
+	backC2    = backC1 * 1.525
+	backC3    = backC1 + 1
+	elasticC4 = 2 * math.Pi / 3
+	elasticC5 = 2 * math.Pi / 4.5
+)
+
+// Linear returns t unchanged
+func Linear(t float64) float64 {
+	return t
+}
+
+// InSine starts slowly along a sine curve
+func InSine(t float64) float64 {
+	return 1 - math.Cos(t*math.Pi/2)
+}
+
+// OutSine ends slowly along a sine curve
+func OutSine(t float64) float64 {
+	return math.Sin(t * math.Pi / 2)
+}
+
+// InOutSine starts and ends slowly along a sine curve
+func InOutSine(t float64) float64 {
+	return -(math.Cos(math.Pi*t) - 1) / 2
+}
+
+// InQuad accelerates from zero velocity
+func InQuad(t float64) float64 {
+	return t * t
+}
+
+// OutQuad decelerates to zero velocity
+func OutQuad(t float64) float64 {
+	return t * (2 - t)
+}
+
+// InOutQuad accelerates until halfway, then decelerates
+func InOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+// InCubic accelerates from zero velocity, cubically
+func InCubic(t float64) float64 {
+	return t * t * t
+}
+
+// OutCubic decelerates to zero velocity, cubically
+func OutCubic(t float64) float64 {
+	return 1 - math.Pow(1-t, 3)
+}
+
+// InOutCubic accelerates until halfway, then decelerates, cubically
+func InOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 3)/2
+}
+
+// InQuart accelerates from zero velocity, to the fourth power
+func InQuart(t float64) float64 {
+	return t * t * t * t
+}
+
+// OutQuart decelerates to zero velocity, to the fourth power
+func OutQuart(t float64) float64 {
+	return 1 - math.Pow(1-t, 4)
+}
+
+// InOutQuart accelerates until halfway, then decelerates, to the fourth power
+func InOutQuart(t float64) float64 {
+	if t < 0.5 {
+		return 8 * t * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 4)/2
+}
+
+// InQuint accelerates from zero velocity, to the fifth power
+func InQuint(t float64) float64 {
+	return t * t * t * t * t
+}
+
+// OutQuint decelerates to zero velocity, to the fifth power
+func OutQuint(t float64) float64 {
+	return 1 - math.Pow(1-t, 5)
+}
+
+// InOutQuint accelerates until halfway, then decelerates, to the fifth power
+func InOutQuint(t float64) float64 {
+	if t < 0.5 {
+		return 16 * t * t * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 5)/2
+}
+
+// InExpo accelerates exponentially
+func InExpo(t float64) float64 {
+	if t == 0 {
+		return 0
+	}
+	return math.Pow(2, 10*(t-1))
+}
+
+// OutExpo decelerates exponentially
+func OutExpo(t float64) float64 {
+	if t == 1 {
+		return 1
+	}
+	return 1 - math.Pow(2, -10*t)
+}
+
+// InOutExpo accelerates exponentially until halfway, then decelerates
+func InOutExpo(t float64) float64 {
+	switch {
+	case t == 0 || t == 1:
+		return t
+	case t < 0.5:
+		return math.Pow(2, 20*t-10) / 2
+	default:
+		return (2 - math.Pow(2, -20*t+10)) / 2
+	}
+}
+
+// InCirc accelerates along a quarter circle
+func InCirc(t float64) float64 {
+	return 1 - math.Sqrt(1-t*t)
+}
+
+// OutCirc decelerates along a quarter circle
+func OutCirc(t float64) float64 {
+	return math.Sqrt(1 - (t-1)*(t-1))
+}
+
+// InOutCirc accelerates along a quarter circle until halfway, then decelerates
+func InOutCirc(t float64) float64 {
+	if t < 0.5 {
+		return (1 - math.Sqrt(1-4*t*t)) / 2
+	}
+	return (math.Sqrt(1-math.Pow(-2*t+2, 2)) + 1) / 2
+}
+
+// InBack pulls back slightly before accelerating
+func InBack(t float64) float64 {
+	return backC3*t*t*t - backC1*t*t
+}
+
+// OutBack overshoots the end slightly before settling
+func OutBack(t float64) float64 {
+	return 1 + backC3*math.Pow(t-1, 3) + backC1*math.Pow(t-1, 2)
+}
+
+// InOutBack pulls back at the start and overshoots at the end
+func InOutBack(t float64) float64 {
+	if t < 0.5 {
+		return math.Pow(2*t, 2) * ((backC2+1)*2*t - backC2) / 2
+	}
+	return (math.Pow(2*t-2, 2)*((backC2+1)*(t*2-2)+backC2) + 2) / 2
+}
+
+// InElastic winds up with a growing oscillation
+func InElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return t
+	}
+	return -math.Pow(2, 10*t-10) * math.Sin((t*10-10.75)*elasticC4)
+}
+
+// OutElastic springs past the end and oscillates into place
+func OutElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return t
+	}
+	return math.Pow(2, -10*t)*math.Sin((t*10-0.75)*elasticC4) + 1
+}
+
+// InOutElastic oscillates at both ends
+func InOutElastic(t float64) float64 {
+	switch {
+	case t <= 0 || t >= 1:
+		return t
+	case t < 0.5:
+		return -(math.Pow(2, 20*t-10) * math.Sin((20*t-11.125)*elasticC5)) / 2
+	default:
+		return math.Pow(2, -20*t+10)*math.Sin((20*t-11.125)*elasticC5)/2 + 1
+	}
+}
+
+// InBounce bounces with growing height before taking off
+func InBounce(t float64) float64 {
+	return 1 - OutBounce(1-t)
+}
+
+// OutBounce drops into place and bounces to rest
+func OutBounce(t float64) float64 {
+	const n1, d1 = 7.5625, 2.75
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	default:
+		t -= 2.625 / d1
+		return n1*t*t + 0.984375
+	}
+}
+
+// InOutBounce bounces at both ends
+func InOutBounce(t float64) float64 {
+	if t < 0.5 {
+		return (1 - OutBounce(1-2*t)) / 2
+	}
+	return (1 + OutBounce(2*t-1)) / 2
+}
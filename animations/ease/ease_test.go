@@ -0,0 +1,41 @@
+package ease
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestBoundaries(t *testing.T) {
+	funcs := []struct {
+		name string
+		f    Func
+	}{
+		{"Linear", Linear},
+		{"InSine", InSine}, {"OutSine", OutSine}, {"InOutSine", InOutSine},
+		{"InQuad", InQuad}, {"OutQuad", OutQuad}, {"InOutQuad", InOutQuad},
+		{"InCubic", InCubic}, {"OutCubic", OutCubic}, {"InOutCubic", InOutCubic},
+		{"InQuart", InQuart}, {"OutQuart", OutQuart}, {"InOutQuart", InOutQuart},
+		{"InQuint", InQuint}, {"OutQuint", OutQuint}, {"InOutQuint", InOutQuint},
+		{"InExpo", InExpo}, {"OutExpo", OutExpo}, {"InOutExpo", InOutExpo},
+		{"InCirc", InCirc}, {"OutCirc", OutCirc}, {"InOutCirc", InOutCirc},
+		{"InBack", InBack}, {"OutBack", OutBack}, {"InOutBack", InOutBack},
+		{"InElastic", InElastic}, {"OutElastic", OutElastic}, {"InOutElastic", InOutElastic},
+		{"InBounce", InBounce}, {"OutBounce", OutBounce}, {"InOutBounce", InOutBounce},
+	}
+
+	for _, tt := range funcs {
+		if got := tt.f(0); math.Abs(got) > 1e-9 {
+			t.Errorf("%s(0) = %v, want 0", tt.name, got)
+		}
+		if got := tt.f(1); math.Abs(got-1) > 1e-9 {
+			t.Errorf("%s(1) = %v, want 1", tt.name, got)
+		}
+		// Symmetric in-out curves pass through the midpoint
+		if strings.HasPrefix(tt.name, "InOut") {
+			if got := tt.f(0.5); math.Abs(got-0.5) > 1e-9 {
+				t.Errorf("%s(0.5) = %v, want 0.5", tt.name, got)
+			}
+		}
+	}
+}
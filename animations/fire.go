@@ -2,10 +2,14 @@ package animations
 
 import (
 	"fmt"
+	"io"
+	"math"
 	"math/rand"
-	"strings"
 )
 
+// fireSwayPeriod is the length in frames of one full swing of WindSway
+const fireSwayPeriod = 300
+
 // FireEffect implements PSX DOOM-style fire algorithm with enhanced character gradient
 type FireEffect struct {
 	width   int      // Terminal width
@@ -13,14 +17,48 @@ type FireEffect struct {
 	buffer  []int    // Heat values (0-65), size = width * height
 	palette []string // Hex color codes from theme
 	chars   []rune   // Fire characters for density (8-level gradient)
+	bold    int      // Number of hottest palette colors drawn bold
+	fill    bool     // Draw solid background blocks instead of glyphs
+	wind    float64  // Base sideways drift in cells per row
+	sway    float64  // Amplitude of the slow oscillation added to wind
+	drift   float64  // Sideways drift for the current frame
+	frame   int      // Frames since creation, drives the sway
+	rng     *rand.Rand
+	cells   [][]Cell // Frame grid reused between renders
+}
+
+// FireConfig holds configuration for the fire effect
+type FireConfig struct {
+	Width    int
+	Height   int
+	Palette  []string
+	Bold     int     // Draw the N hottest palette colors bold, 0 = none
+	FillMode bool    // Fill cells with the palette as background color instead of drawing glyphs
+	Wind     float64 // Sideways lean in cells per row, negative = left, positive = right
+	WindSway float64 // Amplitude of a slow sinusoidal swing added to Wind, 0 = steady
+	Seed     int64   // Random seed for reproducible output, 0 = seeded from the clock
 }
 
 // NewFireEffect creates a new fire effect with given dimensions and theme palette
 func NewFireEffect(width, height int, palette []string) *FireEffect {
+	return NewFireEffectWithConfig(FireConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+	})
+}
+
+// NewFireEffectWithConfig creates a new fire effect with given configuration
+func NewFireEffectWithConfig(config FireConfig) *FireEffect {
 	f := &FireEffect{
-		width:   width,
-		height:  height,
-		palette: palette,
+		width:   config.Width,
+		height:  config.Height,
+		palette: config.Palette,
+		bold:    config.Bold,
+		fill:    config.FillMode,
+		wind:    config.Wind,
+		sway:    config.WindSway,
+		rng:     newRNG(config.Seed),
 		// Enhanced 8-character gradient for smoother fire rendering
 		chars: []rune{' ', '░', '░', '▒', '▒', '▓', '▓', '█'},
 	}
@@ -50,11 +88,18 @@ func (f *FireEffect) Resize(width, height int) {
 	f.init()
 }
 
+// Reset clears the flames back to the bare heat source
+func (f *FireEffect) Reset() {
+	f.frame = 0
+	f.drift = 0
+	f.init()
+}
+
 // spreadFire propagates heat upward with random decay (DOOM algorithm)
 func (f *FireEffect) spreadFire(from int) {
 	// Random horizontal offset (0-3) for flickering effect
-	offset := rand.Intn(4)
-	to := from - f.width - offset + 1
+	offset := f.rng.Intn(4)
+	to := from - f.width - offset + 1 + f.windShift()
 
 	// Bounds check
 	if to < 0 || to >= len(f.buffer) {
@@ -62,7 +107,7 @@ func (f *FireEffect) spreadFire(from int) {
 	}
 
 	// Random decay (0-3) for natural fade
-	decay := rand.Intn(4)
+	decay := f.rng.Intn(4)
 
 	newHeat := f.buffer[from] - decay
 	if newHeat < 0 {
@@ -72,8 +117,27 @@ func (f *FireEffect) spreadFire(from int) {
 	f.buffer[to] = newHeat
 }
 
+// windShift returns the whole-cell sideways shift for one spread step,
+// rounding the fractional drift randomly so small winds still lean on average
+func (f *FireEffect) windShift() int {
+	if f.drift == 0 {
+		return 0
+	}
+	shift := math.Floor(f.drift)
+	if f.rng.Float64() < f.drift-shift {
+		shift++
+	}
+	return int(shift)
+}
+
 // Update advances the fire simulation by one frame
 func (f *FireEffect) Update() {
+	f.drift = f.wind
+	if f.sway != 0 {
+		f.drift += f.sway * math.Sin(2*math.Pi*float64(f.frame)/fireSwayPeriod)
+	}
+	f.frame++
+
 	// Process all pixels from bottom to top
 	// (Fire spreads upward, must process bottom row first)
 	for y := f.height - 1; y > 0; y-- {
@@ -99,29 +163,39 @@ func hexToRGB(hex string) (int, int, int) {
 	return r, g, b
 }
 
+// Stats reports the frame count and how many cells are burning
+func (f *FireEffect) Stats() Stats {
+	burning := 0
+	for _, heat := range f.buffer {
+		if heat > 0 {
+			burning++
+		}
+	}
+	return Stats{Frame: f.frame, Counts: map[string]int{"burning": burning}}
+}
+
 // Render converts fire to colored block output with batched raw ANSI codes
 func (f *FireEffect) Render() string {
-	var output strings.Builder
+	return renderCellsBatched(f.RenderCells())
+}
 
+// WriteTo streams the fire to w, see io.WriterTo
+func (f *FireEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCellsBatched(w, f.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (f *FireEffect) RenderCells() [][]Cell {
 	// Always render full viewport height to anchor fire at bottom
 	// This prevents jumping as fire spreads upward
+	f.cells = resetCellGrid(f.cells, f.width, f.height)
+	cells := f.cells
 	for y := 0; y < f.height; y++ {
-		var currentColor string
-		var batchChars strings.Builder
-
 		for x := 0; x < f.width; x++ {
 			heat := f.buffer[y*f.width+x]
 
 			// Skip very low heat (natural fade to background)
 			if heat < 5 {
-				// Flush any pending batch
-				if batchChars.Len() > 0 {
-					r, g, b := hexToRGB(currentColor)
-					fmt.Fprintf(&output, "\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, batchChars.String())
-					batchChars.Reset()
-				}
-				output.WriteString(" ")
-				currentColor = ""
 				continue
 			}
 
@@ -130,43 +204,25 @@ func (f *FireEffect) Render() string {
 			if charIndex >= len(f.chars) {
 				charIndex = len(f.chars) - 1
 			}
-			char := f.chars[charIndex]
 
 			// Map heat to color from palette
 			colorIndex := (heat * (len(f.palette) - 1)) / 65
 			if colorIndex >= len(f.palette) {
 				colorIndex = len(f.palette) - 1
 			}
-			colorHex := f.palette[colorIndex]
-
-			// If color changed, flush previous batch and start new one
-			if colorHex != currentColor {
-				if batchChars.Len() > 0 {
-					r, g, b := hexToRGB(currentColor)
-					fmt.Fprintf(&output, "\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, batchChars.String())
-					batchChars.Reset()
-				}
-				currentColor = colorHex
-			}
 
-			// Add character to batch
-			batchChars.WriteRune(char)
-		}
+			if f.fill {
+				cells[y][x] = Cell{Rune: ' ', Background: f.palette[colorIndex]}
+				continue
+			}
 
-		// Flush any remaining batch at end of line
-		if batchChars.Len() > 0 {
-			r, g, b := hexToRGB(currentColor)
-			fmt.Fprintf(&output, "\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, batchChars.String())
+			cells[y][x] = Cell{
+				Rune:  f.chars[charIndex],
+				Color: f.palette[colorIndex],
+				Bold:  colorIndex >= len(f.palette)-f.bold,
+			}
 		}
-
-		output.WriteString("\n")
-	}
-
-	// Remove trailing newline
-	result := output.String()
-	if len(result) > 0 && result[len(result)-1] == '\n' {
-		result = result[:len(result)-1]
 	}
 
-	return result
+	return cells
 }
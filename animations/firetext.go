@@ -1,7 +1,8 @@
 package animations
 
 import (
-	"fmt"
+	"io"
+	"math"
 	"math/rand"
 	"strings"
 )
@@ -18,19 +19,82 @@ type FireTextEffect struct {
 	// Text masking
 	text      string
 	textMask  [][]bool // [y][x] = true if character exists at this position
+	textCells []fireTextCell
 	centerX   int
 	centerY   int
 	artWidth  int
 	artHeight int
+
+	align      TextAlign
+	margin     int
+	mode       FireTextMode
+	holdFrames int
+	burnFrames int
+
+	frame int // Frames since creation
+	rng   *rand.Rand
+	cells [][]Cell // Frame grid reused between renders
+}
+
+// FireTextMode decides whether the text emerges from or is consumed by the flames
+type FireTextMode int
+
+const (
+	FireTextHold     FireTextMode = iota // Text stays as negative space in the flames
+	FireTextBurnIn                       // Text emerges from the flames top down, then holds
+	FireTextBurnAway                     // Text holds for HoldFrames, then burns away bottom up
+)
+
+// fireTextCell is a character of the art and when it burns in or away
+type fireTextCell struct {
+	x, y   int
+	burnAt float64 // Fraction of the burn at which the cell changes, 0-1
+}
+
+// FireTextConfig holds configuration for the fire-text effect
+type FireTextConfig struct {
+	Width      int
+	Height     int
+	Palette    []string
+	Text       string
+	Align      TextAlign // Horizontal placement of the art block (default: center)
+	Margin     int       // Columns kept clear at the aligned edge
+	Mode       FireTextMode
+	HoldFrames int   // Frames the whole text shows before burning away (default: 40)
+	BurnFrames int   // Frames the text takes to burn in or away (default: 60)
+	Seed       int64 // Random seed for reproducible output, 0 = seeded from the clock
 }
 
 // NewFireTextEffect creates a new fire-text effect with given dimensions, palette, and ASCII art
 func NewFireTextEffect(width, height int, palette []string, text string) *FireTextEffect {
+	return NewFireTextEffectWithConfig(FireTextConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+		Text:    text,
+	})
+}
+
+// NewFireTextEffectWithConfig creates a new fire-text effect with given configuration
+func NewFireTextEffectWithConfig(config FireTextConfig) *FireTextEffect {
+	if config.HoldFrames <= 0 {
+		config.HoldFrames = 40
+	}
+	if config.BurnFrames <= 0 {
+		config.BurnFrames = 60
+	}
+
 	f := &FireTextEffect{
-		width:   width,
-		height:  height,
-		palette: palette,
-		text:    text,
+		width:      config.Width,
+		height:     config.Height,
+		palette:    config.Palette,
+		text:       config.Text,
+		align:      config.Align,
+		margin:     config.Margin,
+		mode:       config.Mode,
+		holdFrames: config.HoldFrames,
+		burnFrames: config.BurnFrames,
+		rng:        newRNG(config.Seed),
 		// Enhanced 8-character gradient for smoother fire rendering
 		chars: []rune{' ', '░', '░', '▒', '▒', '▓', '▓', '█'},
 	}
@@ -52,8 +116,8 @@ func (f *FireTextEffect) parseText() {
 		}
 	}
 
-	// Center the art
-	f.centerX = (f.width - f.artWidth) / 2
+	// Align the art block
+	f.centerX = f.align.startX(f.width, f.artWidth, f.margin)
 	f.centerY = (f.height - f.artHeight) / 2
 
 	// Initialize mask
@@ -62,7 +126,9 @@ func (f *FireTextEffect) parseText() {
 		f.textMask[i] = make([]bool, f.width)
 	}
 
-	// Mark character positions in mask
+	// Collect character positions, each burning at a point set by its row
+	// (flames climb from the bottom) with some jitter for a ragged edge
+	f.textCells = f.textCells[:0]
 	for lineIdx, line := range lines {
 		lineRunes := []rune(line)
 		for charIdx, char := range lineRunes {
@@ -72,11 +138,43 @@ func (f *FireTextEffect) parseText() {
 
 				// Only mark if within bounds
 				if x >= 0 && x < f.width && y >= 0 && y < f.height {
-					f.textMask[y][x] = true
+					row := float64(f.artHeight-1-lineIdx) / float64(max(f.artHeight, 1))
+					burnAt := row*0.7 + f.rng.Float64()*0.3
+					f.textCells = append(f.textCells, fireTextCell{x: x, y: y, burnAt: burnAt})
 				}
 			}
 		}
 	}
+	f.updateMask()
+}
+
+// burnProgress returns how far the text has burned in or away, 0-1
+func (f *FireTextEffect) burnProgress() float64 {
+	elapsed := f.frame
+	if f.mode == FireTextBurnAway {
+		elapsed -= f.holdFrames
+	}
+	return math.Max(0, math.Min(float64(elapsed)/float64(f.burnFrames), 1))
+}
+
+// updateMask marks the characters that are currently shown. Characters
+// burning away ignite, so the flames visibly take their place.
+func (f *FireTextEffect) updateMask() {
+	progress := f.burnProgress()
+	for _, cell := range f.textCells {
+		shown := true
+		switch f.mode {
+		case FireTextBurnIn:
+			shown = 1-cell.burnAt <= progress
+		case FireTextBurnAway:
+			shown = cell.burnAt >= progress
+		}
+
+		if !shown && f.textMask[cell.y][cell.x] && f.mode == FireTextBurnAway {
+			f.buffer[cell.y*f.width+cell.x] = 65
+		}
+		f.textMask[cell.y][cell.x] = shown
+	}
 }
 
 // Initialize fire buffer with fire in all non-masked positions
@@ -95,7 +193,7 @@ func (f *FireTextEffect) init() {
 				baseHeat := int(heatRatio * 65)
 
 				// Add randomness for natural look
-				randomOffset := rand.Intn(20) - 10
+				randomOffset := f.rng.Intn(20) - 10
 				heat := baseHeat + randomOffset
 
 				// Clamp to valid range
@@ -125,6 +223,13 @@ func (f *FireTextEffect) Resize(width, height int) {
 	f.init()
 }
 
+// Reset rekindles the fire and starts the burn over
+func (f *FireTextEffect) Reset() {
+	f.frame = 0
+	f.parseText() // Restore the text mask burned away
+	f.init()
+}
+
 // spreadFire propagates heat upward with random decay, respecting text mask
 func (f *FireTextEffect) spreadFire(from int) {
 	fromY := from / f.width
@@ -137,7 +242,7 @@ func (f *FireTextEffect) spreadFire(from int) {
 	}
 
 	// Random horizontal offset (0-3) for flickering effect
-	offset := rand.Intn(4)
+	offset := f.rng.Intn(4)
 	to := from - f.width - offset + 1
 
 	// Bounds check
@@ -156,7 +261,7 @@ func (f *FireTextEffect) spreadFire(from int) {
 	}
 
 	// Random decay (0-3) for natural fade
-	decay := rand.Intn(4)
+	decay := f.rng.Intn(4)
 
 	newHeat := f.buffer[from] - decay
 	if newHeat < 0 {
@@ -168,6 +273,11 @@ func (f *FireTextEffect) spreadFire(from int) {
 
 // Update advances the fire simulation by one frame
 func (f *FireTextEffect) Update() {
+	f.frame++
+	if f.mode != FireTextHold {
+		f.updateMask()
+	}
+
 	// Maintain constant heat source at bottom of terminal (not text base)
 	// This keeps fire burning continuously from the bottom up
 	for x := 0; x < f.width; x++ {
@@ -196,42 +306,40 @@ func (f *FireTextEffect) Update() {
 	}
 }
 
+// Stats reports the frame count and how many cells are burning
+func (f *FireTextEffect) Stats() Stats {
+	burning := 0
+	for _, heat := range f.buffer {
+		if heat > 0 {
+			burning++
+		}
+	}
+	return Stats{Frame: f.frame, Counts: map[string]int{"burning": burning}}
+}
+
 // Render converts fire to colored block output with batched raw ANSI codes
 // Text areas are rendered as empty space (negative space effect)
 func (f *FireTextEffect) Render() string {
-	var output strings.Builder
+	return renderCellsBatched(f.RenderCells())
+}
+
+// WriteTo streams the fire text to w, see io.WriterTo
+func (f *FireTextEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCellsBatched(w, f.RenderCells())
+}
 
+// RenderCells returns the fire as a grid of runes and colors, leaving the
+// text areas blank
+func (f *FireTextEffect) RenderCells() [][]Cell {
 	// Always render full viewport height to anchor fire at bottom
+	f.cells = resetCellGrid(f.cells, f.width, f.height)
 	for y := 0; y < f.height; y++ {
-		var currentColor string
-		var batchChars strings.Builder
-
 		for x := 0; x < f.width; x++ {
 			heat := f.buffer[y*f.width+x]
 
-			// Text mask areas are always empty (negative space)
-			if f.textMask[y][x] {
-				// Flush any pending batch
-				if batchChars.Len() > 0 {
-					r, g, b := hexToRGB(currentColor)
-					fmt.Fprintf(&output, "\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, batchChars.String())
-					batchChars.Reset()
-				}
-				output.WriteString(" ")
-				currentColor = ""
-				continue
-			}
-
-			// Skip very low heat (natural fade to background)
-			if heat < 5 {
-				// Flush any pending batch
-				if batchChars.Len() > 0 {
-					r, g, b := hexToRGB(currentColor)
-					fmt.Fprintf(&output, "\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, batchChars.String())
-					batchChars.Reset()
-				}
-				output.WriteString(" ")
-				currentColor = ""
+			// Text mask areas are always empty (negative space), and very
+			// low heat fades naturally to the background
+			if f.textMask[y][x] || heat < 5 {
 				continue
 			}
 
@@ -240,43 +348,16 @@ func (f *FireTextEffect) Render() string {
 			if charIndex >= len(f.chars) {
 				charIndex = len(f.chars) - 1
 			}
-			char := f.chars[charIndex]
 
 			// Map heat to color from palette
 			colorIndex := (heat * (len(f.palette) - 1)) / 65
 			if colorIndex >= len(f.palette) {
 				colorIndex = len(f.palette) - 1
 			}
-			colorHex := f.palette[colorIndex]
-
-			// If color changed, flush previous batch and start new one
-			if colorHex != currentColor {
-				if batchChars.Len() > 0 {
-					r, g, b := hexToRGB(currentColor)
-					fmt.Fprintf(&output, "\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, batchChars.String())
-					batchChars.Reset()
-				}
-				currentColor = colorHex
-			}
-
-			// Add character to batch
-			batchChars.WriteRune(char)
-		}
 
-		// Flush any remaining batch at end of line
-		if batchChars.Len() > 0 {
-			r, g, b := hexToRGB(currentColor)
-			fmt.Fprintf(&output, "\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, batchChars.String())
+			f.cells[y][x] = Cell{Rune: f.chars[charIndex], Color: f.palette[colorIndex]}
 		}
-
-		output.WriteString("\n")
-	}
-
-	// Remove trailing newline
-	result := output.String()
-	if len(result) > 0 && result[len(result)-1] == '\n' {
-		result = result[:len(result)-1]
 	}
 
-	return result
+	return f.cells
 }
@@ -0,0 +1,94 @@
+package animations
+
+import "testing"
+
+// maskedCells counts the characters currently cut out of the flames
+func maskedCells(f *FireTextEffect) int {
+	count := 0
+	for _, row := range f.textMask {
+		for _, masked := range row {
+			if masked {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func TestFireText_MultiLineAlignment(t *testing.T) {
+	text := "AB\n C\nDEF"
+	config := FireTextConfig{Width: 20, Height: 9, Palette: []string{"#000000", "#ff0000"}, Text: text, Seed: 1}
+
+	centered := NewFireTextEffectWithConfig(config)
+	// The 3x3 block is centered at (8, 3); line 2 is indented by one
+	for _, pos := range [][2]int{{8, 3}, {9, 3}, {9, 4}, {8, 5}, {10, 5}} {
+		if !centered.textMask[pos[1]][pos[0]] {
+			t.Errorf("centered: no character at x=%d y=%d", pos[0], pos[1])
+		}
+	}
+	if got := maskedCells(centered); got != 6 {
+		t.Errorf("centered: %d masked cells, want 6", got)
+	}
+
+	config.Align = AlignLeft
+	left := NewFireTextEffectWithConfig(config)
+	for _, pos := range [][2]int{{0, 3}, {1, 3}, {1, 4}, {0, 5}, {2, 5}} {
+		if !left.textMask[pos[1]][pos[0]] {
+			t.Errorf("left: no character at x=%d y=%d", pos[0], pos[1])
+		}
+	}
+}
+
+func TestFireText_BurnModes(t *testing.T) {
+	config := FireTextConfig{
+		Width: 20, Height: 9, Palette: []string{"#000000", "#ff0000"},
+		Text: "AB\n C\nDEF", HoldFrames: 5, BurnFrames: 10, Seed: 1,
+	}
+
+	config.Mode = FireTextBurnIn
+	burnIn := NewFireTextEffectWithConfig(config)
+	if got := maskedCells(burnIn); got != 0 {
+		t.Errorf("burn-in starts with %d characters, want 0", got)
+	}
+	for i := 0; i < 10; i++ {
+		burnIn.Update()
+	}
+	if got := maskedCells(burnIn); got != 6 {
+		t.Errorf("burn-in ends with %d characters, want 6", got)
+	}
+
+	config.Mode = FireTextBurnAway
+	burnAway := NewFireTextEffectWithConfig(config)
+	for i := 0; i < 5; i++ {
+		burnAway.Update()
+	}
+	if got := maskedCells(burnAway); got != 6 {
+		t.Errorf("burn-away shows %d characters after the hold, want 6", got)
+	}
+	for i := 0; i < 10; i++ {
+		burnAway.Update()
+	}
+	if got := maskedCells(burnAway); got != 0 {
+		t.Errorf("burn-away ends with %d characters, want 0", got)
+	}
+}
+
+func TestTextAlign_StartX(t *testing.T) {
+	tests := []struct {
+		align  TextAlign
+		margin int
+		want   int
+	}{
+		{AlignCenter, 0, 8},
+		{AlignCenter, 3, 8},
+		{AlignLeft, 0, 0},
+		{AlignLeft, 2, 2},
+		{AlignRight, 0, 16},
+		{AlignRight, 2, 14},
+	}
+	for _, tt := range tests {
+		if got := tt.align.startX(20, 4, tt.margin); got != tt.want {
+			t.Errorf("%s with margin %d: startX = %d, want %d", TextAligns[tt.align], tt.margin, got, tt.want)
+		}
+	}
+}
@@ -1,11 +1,10 @@
 package animations
 
 import (
+	"io"
 	"math"
 	"math/rand"
-	"strings"
 
-	"github.com/charmbracelet/lipgloss/v2"
 	"gonum.org/v1/gonum/spatial/r2"
 )
 
@@ -15,10 +14,9 @@ type Particle struct {
 	p0, p1, p2, p3   r2.Vec  // Bezier control points
 	t                float64 // Progress (0-1)
 	char             rune    // Character to display
-	style            lipgloss.Style
-	phase            int    // 0=launch, 1=explosion, 2=fall
-	color            string // Current color
-	targetX, targetY int    // Final position
+	phase            int     // 0=launch, 1=explosion, 2=fall
+	color            string  // Current color
+	targetX, targetY int     // Final position
 }
 
 // FireworksEffect implements fireworks animation
@@ -30,14 +28,105 @@ type FireworksEffect struct {
 	shells        [][]int // Indices of particles in each shell
 	launchDelay   int
 	activeShells  int
+	bold          int     // Number of brightest palette colors drawn bold
+	shape         string  // Burst shape, one of FireworksShapes
+	gravity       float64 // Fall speed multiplier
+	trail         int     // Cells of fading trail above falling particles
+	launchEvery   int     // Frames between shell launches, 0 = random
+	maxBursts     int     // Most shells in the air at once, 0 = no limit
+	shellSize     int     // Particles per shell
+	rng           *rand.Rand
+	cells         [][]Cell // Frame grid reused between renders
 }
 
+// FireworksConfig holds configuration for the fireworks effect
+type FireworksConfig struct {
+	Width      int
+	Height     int
+	Palette    []string
+	Bold       int    // Draw the N brightest palette colors bold, 0 = none
+	BurstShape string // One of FireworksShapes (default: "circle")
+	// Gravity scales how fast burst particles fall, e.g. 0.5 lingers and
+	// 2 snaps down (default: 1)
+	Gravity float64
+	// TrailLength is how many cells of fading trail follow a falling
+	// particle (default: 3 for willow, none for other shapes)
+	TrailLength    int
+	LaunchInterval int // Frames between shell launches (default: random 15-35)
+	// MaxBursts caps how many shells are in the air at once, up to 50
+	// (default: no cap beyond the launch interval)
+	MaxBursts         int
+	ParticlesPerBurst int   // Particles in each shell, up to 200 (default: 25)
+	Seed              int64 // Random seed for reproducible output, 0 = seeded from the clock
+}
+
+// FireworksShapes lists the burst shapes accepted by FireworksConfig.BurstShape
+var FireworksShapes = []string{"circle", "ring", "heart", "star", "willow"}
+
+// IsValidBurstShape reports whether name is one of FireworksShapes
+func IsValidBurstShape(name string) bool {
+	for _, shape := range FireworksShapes {
+		if shape == name {
+			return true
+		}
+	}
+	return false
+}
+
+// willowTrail is the default trail length of willow bursts
+const willowTrail = 3
+
+// Limits that keep huge terminals or extreme configs from allocating
+// an unbounded number of particles
+const (
+	maxFireworksBursts       = 50
+	maxParticlesPerBurst     = 200
+	maxFireworksParticles    = 5000
+	defaultParticlesPerBurst = 25
+)
+
 // NewFireworksEffect creates a new fireworks effect
 func NewFireworksEffect(width, height int, palette []string) *FireworksEffect {
+	return NewFireworksEffectWithConfig(FireworksConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+	})
+}
+
+// NewFireworksEffectWithConfig creates a new fireworks effect with given configuration
+func NewFireworksEffectWithConfig(config FireworksConfig) *FireworksEffect {
+	shape := config.BurstShape
+	if !IsValidBurstShape(shape) {
+		shape = "circle"
+	}
+	if config.Gravity <= 0 {
+		config.Gravity = 1
+	}
+	if config.TrailLength <= 0 && shape == "willow" {
+		config.TrailLength = willowTrail
+	}
+	if config.LaunchInterval < 0 {
+		config.LaunchInterval = 0
+	}
+	if config.ParticlesPerBurst <= 0 {
+		config.ParticlesPerBurst = defaultParticlesPerBurst
+	}
+	config.ParticlesPerBurst = min(config.ParticlesPerBurst, maxParticlesPerBurst)
+	config.MaxBursts = min(max(config.MaxBursts, 0), maxFireworksBursts)
+
 	fw := &FireworksEffect{
-		width:        width,
-		height:       height,
-		palette:      palette,
+		width:        config.Width,
+		height:       config.Height,
+		palette:      config.Palette,
+		bold:         config.Bold,
+		shape:        shape,
+		gravity:      config.Gravity,
+		trail:        max(config.TrailLength, 0),
+		launchEvery:  config.LaunchInterval,
+		maxBursts:    config.MaxBursts,
+		shellSize:    config.ParticlesPerBurst,
+		rng:          newRNG(config.Seed),
 		frame:        0,
 		launchDelay:  0,
 		activeShells: 0,
@@ -49,8 +138,10 @@ func NewFireworksEffect(width, height int, palette []string) *FireworksEffect {
 // Initialize fireworks with particles
 func (fw *FireworksEffect) init() {
 	// Create particles - for sysc-greet we'll create a fixed number of particles
-	// that continuously animate rather than animating text characters
-	particleCount := fw.width * 2
+	// that continuously animate rather than animating text characters.
+	// A burst cap needs at least that many shells to fill the sky.
+	particleCount := max(fw.width*2, fw.maxBursts*fw.shellSize)
+	particleCount = min(particleCount, maxFireworksParticles)
 	fw.particles = make([]Particle, particleCount)
 
 	// Various characters for firework particles
@@ -58,7 +149,7 @@ func (fw *FireworksEffect) init() {
 
 	for i := 0; i < particleCount; i++ {
 		fw.particles[i] = Particle{
-			char:  chars[rand.Intn(len(chars))],
+			char:  chars[fw.rng.Intn(len(chars))],
 			t:     1, // Set to 1 so particles don't render until launched
 			phase: 0,
 			pos:   r2.Vec{X: -100, Y: -100}, // Off-screen initially
@@ -66,7 +157,7 @@ func (fw *FireworksEffect) init() {
 	}
 
 	// Create shells (groups of particles that explode together)
-	shellSize := 25
+	shellSize := fw.shellSize
 	fw.shells = nil // Clear existing shells
 	for i := 0; i < len(fw.particles); i += shellSize {
 		end := i + shellSize
@@ -107,6 +198,14 @@ func (fw *FireworksEffect) Resize(width, height int) {
 	}
 }
 
+// Reset clears the sky of shells and starts launching again
+func (fw *FireworksEffect) Reset() {
+	fw.frame = 0
+	fw.activeShells = 0
+	fw.launchDelay = 0
+	fw.init()
+}
+
 // evaluateBezier evaluates a cubic bezier curve at parameter t
 func evaluateBezier(p0, p1, p2, p3 r2.Vec, t float64) r2.Vec {
 	it := 1 - t
@@ -123,9 +222,11 @@ func (fw *FireworksEffect) launchShell(shellIndex int) {
 	}
 
 	indices := fw.shells[shellIndex]
-	centerX := float64(rand.Intn(fw.width-20) + 10)           // Keep away from edges
-	centerY := float64(fw.height - 1)                         // Start from bottom
-	explodeY := float64(rand.Intn(fw.height/3) + fw.height/5) // Explosion in upper third
+	// Keep away from the edges, narrowing the margin on small screens
+	margin := min(10, fw.width/4)
+	centerX := float64(fw.rng.Intn(max(fw.width-2*margin, 1)) + margin)
+	centerY := float64(fw.height - 1)                                   // Start from bottom
+	explodeY := float64(fw.rng.Intn(max(fw.height/3, 1)) + fw.height/5) // Explosion in upper third
 
 	for _, idx := range indices {
 		p := &fw.particles[idx]
@@ -135,8 +236,8 @@ func (fw *FireworksEffect) launchShell(shellIndex int) {
 
 		// Launch path - straight up with slight curve
 		p.p0 = r2.Vec{X: centerX, Y: centerY}
-		p.p1 = r2.Vec{X: centerX + (rand.Float64()-0.5)*2, Y: centerY - (centerY-explodeY)*0.3}
-		p.p2 = r2.Vec{X: centerX + (rand.Float64()-0.5)*2, Y: explodeY + 5}
+		p.p1 = r2.Vec{X: centerX + (fw.rng.Float64()-0.5)*2, Y: centerY - (centerY-explodeY)*0.3}
+		p.p2 = r2.Vec{X: centerX + (fw.rng.Float64()-0.5)*2, Y: explodeY + 5}
 		p.p3 = r2.Vec{X: centerX, Y: explodeY}
 
 		// Set initial color
@@ -145,7 +246,6 @@ func (fw *FireworksEffect) launchShell(shellIndex int) {
 		} else {
 			p.color = "#FFFFFF"
 		}
-		p.style = lipgloss.NewStyle().Foreground(lipgloss.Color(p.color))
 	}
 }
 
@@ -163,17 +263,17 @@ func (fw *FireworksEffect) explodeShell(shellIndex int) {
 	// Use position of first particle as explosion center
 	centerX := fw.particles[indices[0]].pos.X
 	centerY := fw.particles[indices[0]].pos.Y
-	explodeRadius := float64(20 + rand.Intn(25)) // Larger explosion radius
+	explodeRadius := float64(20 + fw.rng.Intn(25)) // Larger explosion radius
+	rotation := fw.rng.Float64() * 2 * math.Pi     // Spin of evenly spaced shapes
 
-	for _, idx := range indices {
+	for j, idx := range indices {
 		p := &fw.particles[idx]
 		p.t = 0
 		p.phase = 1
 
-		// Random angle for explosion direction
-		angle := rand.Float64() * 2 * math.Pi
-		targetX := centerX + explodeRadius*math.Cos(angle)
-		targetY := centerY + explodeRadius*math.Sin(angle)*0.6 // Slightly elliptical
+		dx, dy := fw.burstOffset(j, len(indices), rotation)
+		targetX := centerX + explodeRadius*dx
+		targetY := centerY + explodeRadius*dy*0.6 // Slightly elliptical
 
 		// Bezier path for explosion - arc upward then fall
 		p.p0 = r2.Vec{X: centerX, Y: centerY}
@@ -183,12 +283,41 @@ func (fw *FireworksEffect) explodeShell(shellIndex int) {
 
 		// Assign a color for this explosion
 		if len(fw.palette) > 0 {
-			p.color = fw.palette[rand.Intn(len(fw.palette))]
-			p.style = lipgloss.NewStyle().Foreground(lipgloss.Color(p.color))
+			p.color = fw.palette[fw.rng.Intn(len(fw.palette))]
 		}
 	}
 }
 
+// burstOffset returns where particle i of n lands relative to the burst
+// center, scaled so the shape spans roughly the unit circle
+func (fw *FireworksEffect) burstOffset(i, n int, rotation float64) (float64, float64) {
+	// Evenly spaced position along the outline
+	t := 2 * math.Pi * float64(i) / float64(n)
+
+	switch fw.shape {
+	case "ring":
+		return math.Cos(t + rotation), math.Sin(t + rotation)
+	case "heart":
+		// Classic heart curve, flipped because screen y grows downward
+		x := 16 * math.Pow(math.Sin(t), 3)
+		y := 13*math.Cos(t) - 5*math.Cos(2*t) - 2*math.Cos(3*t) - math.Cos(4*t)
+		return x / 16, -y / 16
+	case "star":
+		// Radius dips between five points
+		r := 0.4 + 0.6*math.Abs(math.Cos(2.5*t))
+		return r * math.Cos(t+rotation), r * math.Sin(t+rotation)
+	case "willow":
+		// A smaller, denser burst that droops into long trails
+		angle := fw.rng.Float64() * 2 * math.Pi
+		r := 0.5 * math.Sqrt(fw.rng.Float64())
+		return r * math.Cos(angle), r * math.Sin(angle)
+	}
+
+	// Circle: random direction at full radius
+	angle := fw.rng.Float64() * 2 * math.Pi
+	return math.Cos(angle), math.Sin(angle)
+}
+
 // fallParticles makes particles fall to bottom of screen
 func (fw *FireworksEffect) fallParticles(shellIndex int) {
 	if shellIndex >= len(fw.shells) {
@@ -207,7 +336,10 @@ func (fw *FireworksEffect) fallParticles(shellIndex int) {
 
 		startX := p.pos.X
 		startY := p.pos.Y
-		endX := startX + (rand.Float64()-0.5)*10 // Slight horizontal drift
+		endX := startX + (fw.rng.Float64()-0.5)*10 // Slight horizontal drift
+		if fw.shape == "willow" {
+			endX = startX + (fw.rng.Float64()-0.5)*3 // Willows hang nearly straight down
+		}
 		endY := float64(fw.height - 1)
 
 		// Bezier path for falling - slight curve
@@ -218,14 +350,32 @@ func (fw *FireworksEffect) fallParticles(shellIndex int) {
 	}
 }
 
+// burstsInFlight counts launched shells that still have a visible particle
+func (fw *FireworksEffect) burstsInFlight() int {
+	count := 0
+	for _, indices := range fw.shells[:fw.activeShells] {
+		for _, idx := range indices {
+			if p := fw.particles[idx]; p.phase != 0 || p.t < 1 {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
 // Update advances the fireworks simulation
 func (fw *FireworksEffect) Update() {
 	fw.frame++
 
-	// Launch new shell if delay is over
-	if fw.launchDelay <= 0 && fw.activeShells < len(fw.shells) {
+	// Launch new shell if delay is over and the burst cap allows it
+	if fw.launchDelay <= 0 && fw.activeShells < len(fw.shells) && (fw.maxBursts == 0 || fw.burstsInFlight() < fw.maxBursts) {
 		fw.launchShell(fw.activeShells)
-		fw.launchDelay = 15 + rand.Intn(20) // 15-35 frames between shells (faster)
+		if fw.launchEvery > 0 {
+			fw.launchDelay = fw.launchEvery
+		} else {
+			fw.launchDelay = 15 + fw.rng.Intn(20) // 15-35 frames between shells (faster)
+		}
 		fw.activeShells++
 	}
 	fw.launchDelay--
@@ -252,6 +402,10 @@ func (fw *FireworksEffect) Update() {
 			speed = 0.03
 		case 2: // Fall - faster
 			speed = 0.04
+			if fw.shape == "willow" {
+				speed = 0.015 // Willows drift down slowly
+			}
+			speed *= fw.gravity
 		}
 
 		p.t += speed
@@ -290,8 +444,8 @@ func (fw *FireworksEffect) Update() {
 			case 0: // Launch - bright color
 				p.color = fw.palette[len(fw.palette)-1] // Brightest
 			case 1: // Explosion - random color
-				if p.t < 0.1 || rand.Float64() < 0.05 { // Change color occasionally
-					p.color = fw.palette[rand.Intn(len(fw.palette))]
+				if p.t < 0.1 || fw.rng.Float64() < 0.05 { // Change color occasionally
+					p.color = fw.palette[fw.rng.Intn(len(fw.palette))]
 				}
 			case 2: // Fall - fade to darker colors
 				fadeIdx := int(p.t * float64(len(fw.palette)-1))
@@ -300,7 +454,6 @@ func (fw *FireworksEffect) Update() {
 				}
 				p.color = fw.palette[fadeIdx]
 			}
-			p.style = lipgloss.NewStyle().Foreground(lipgloss.Color(p.color))
 		}
 	}
 
@@ -328,16 +481,57 @@ func (fw *FireworksEffect) Update() {
 	}
 }
 
+// Stats reports the frame count, launched shells and live particles
+func (fw *FireworksEffect) Stats() Stats {
+	return Stats{
+		Frame: fw.frame,
+		Counts: map[string]int{
+			"particles": len(fw.particles),
+			"shells":    fw.activeShells,
+		},
+	}
+}
+
 // Render converts the fireworks to colored text output
 func (fw *FireworksEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, fw.height)
-	styles := make([][]lipgloss.Style, fw.height)
-	for i := range canvas {
-		canvas[i] = make([]rune, fw.width)
-		styles[i] = make([]lipgloss.Style, fw.width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
+	return renderCells(fw.RenderCells())
+}
+
+// WriteTo streams the fireworks to w, see io.WriterTo
+func (fw *FireworksEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, fw.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (fw *FireworksEffect) RenderCells() [][]Cell {
+	fw.cells = resetCellGrid(fw.cells, fw.width, fw.height)
+	cells := fw.cells
+
+	// The brightest colors sit at the end of the palette
+	bold := make(map[string]bool)
+	for i := len(fw.palette) - fw.bold; i < len(fw.palette); i++ {
+		if i >= 0 {
+			bold[fw.palette[i]] = true
+		}
+	}
+
+	// Falling particles leave a fading trail above them
+	if fw.trail > 0 && len(fw.palette) > 0 {
+		for _, p := range fw.particles {
+			if p.t >= 1 || p.phase != 2 {
+				continue
+			}
+			x, y := int(p.pos.X), int(p.pos.Y)
+			// Same fade as the particle, dimming further along the trail
+			base := p.t * float64(len(fw.palette)-1)
+			for k := 1; k <= fw.trail; k++ {
+				ty := y - k
+				if x < 0 || x >= fw.width || ty < 0 || ty >= fw.height {
+					continue
+				}
+				fade := 1 - float64(k)/float64(fw.trail+1)
+				cells[ty][x] = Cell{Rune: '·', Color: fw.palette[int(base*fade)]}
+			}
 		}
 	}
 
@@ -349,25 +543,9 @@ func (fw *FireworksEffect) Render() string {
 		}
 		x, y := int(p.pos.X), int(p.pos.Y)
 		if x >= 0 && x < fw.width && y >= 0 && y < fw.height {
-			canvas[y][x] = p.char
-			styles[y][x] = p.style
-		}
-	}
-
-	// Convert to colored string
-	var lines []string
-	for y := 0; y < fw.height; y++ {
-		var line strings.Builder
-		for x := 0; x < fw.width; x++ {
-			char := canvas[y][x]
-			if char != ' ' {
-				line.WriteString(styles[y][x].Render(string(char)))
-			} else {
-				line.WriteRune(char)
-			}
+			cells[y][x] = Cell{Rune: p.char, Color: p.color, Bold: bold[p.color]}
 		}
-		lines = append(lines, line.String())
 	}
 
-	return strings.Join(lines, "\n")
+	return cells
 }
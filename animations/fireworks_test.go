@@ -0,0 +1,80 @@
+package animations
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFireworks_BurstShapes(t *testing.T) {
+	for _, shape := range FireworksShapes {
+		fw := NewFireworksEffectWithConfig(FireworksConfig{Width: 80, Height: 24, Palette: []string{"#ffffff"}, BurstShape: shape, Seed: 1})
+		for i := 0; i < 25; i++ {
+			dx, dy := fw.burstOffset(i, 25, 0.3)
+			r := math.Hypot(dx, dy)
+			if r > 1.3 {
+				t.Errorf("%s: particle %d lands %.2f from the center, want at most 1.3", shape, i, r)
+			}
+			if shape == "ring" && math.Abs(r-1) > 1e-9 {
+				t.Errorf("ring: particle %d lands %.2f from the center, want 1", i, r)
+			}
+		}
+	}
+
+	fw := NewFireworksEffectWithConfig(FireworksConfig{Width: 80, Height: 24, BurstShape: "spiral", Seed: 1})
+	if fw.shape != "circle" {
+		t.Errorf("unknown shape falls back to %q, want circle", fw.shape)
+	}
+}
+
+func TestFireworks_WillowTrails(t *testing.T) {
+	palette := []string{"#111111", "#555555", "#999999", "#ffffff"}
+	fw := NewFireworksEffectWithConfig(FireworksConfig{Width: 80, Height: 40, Palette: palette, BurstShape: "willow", Seed: 1})
+
+	// A particle late in its fall draws dimmer trail cells above it
+	fw.particles[0] = Particle{char: '*', phase: 2, t: 0.9}
+	fw.particles[0].pos.X, fw.particles[0].pos.Y = 10, 20
+	cells := fw.RenderCells()
+	if cells[20][10].Rune != '*' {
+		t.Fatalf("particle not drawn, got %q", cells[20][10].Rune)
+	}
+	for k := 1; k <= willowTrail; k++ {
+		if cells[20-k][10].Rune != '·' {
+			t.Errorf("no trail %d cells above the particle", k)
+		}
+	}
+}
+
+func TestFireworks_GravityAndLaunchInterval(t *testing.T) {
+	fw := NewFireworksEffectWithConfig(FireworksConfig{Width: 80, Height: 40, Palette: []string{"#ffffff"}, Gravity: 2, LaunchInterval: 7, Seed: 1})
+
+	fw.Update()
+	if fw.launchDelay != 6 {
+		t.Errorf("launch delay after the first shell = %d, want 6", fw.launchDelay)
+	}
+
+	// Falling particles move at twice the default fall speed
+	p := &fw.particles[len(fw.particles)-1]
+	*p = Particle{phase: 2}
+	fw.Update()
+	if math.Abs(p.t-0.08) > 1e-9 {
+		t.Errorf("fall progress after one frame = %.3f, want 0.08", p.t)
+	}
+}
+
+func TestFireworks_BurstLimits(t *testing.T) {
+	fw := NewFireworksEffectWithConfig(FireworksConfig{Width: 80, Height: 40, Palette: []string{"#ffffff"}, MaxBursts: 2, ParticlesPerBurst: 10, LaunchInterval: 1, Seed: 1})
+	if n := len(fw.shells[0]); n != 10 {
+		t.Errorf("shell has %d particles, want 10", n)
+	}
+	for i := 0; i < 200; i++ {
+		fw.Update()
+		if n := fw.burstsInFlight(); n > 2 {
+			t.Fatalf("frame %d: %d bursts in flight, want at most 2", i, n)
+		}
+	}
+
+	huge := NewFireworksEffectWithConfig(FireworksConfig{Width: 100000, Height: 40, MaxBursts: 1000, ParticlesPerBurst: 1000, Seed: 1})
+	if len(huge.particles) > maxFireworksParticles || len(huge.shells[0]) > maxParticlesPerBurst {
+		t.Errorf("limits not applied: %d particles, %d per shell", len(huge.particles), len(huge.shells[0]))
+	}
+}
@@ -0,0 +1,221 @@
+package animations
+
+import "math"
+
+// ColorSpace selects how a Gradient blends between neighboring stops
+type ColorSpace int
+
+const (
+	// Oklab blends in a perceptual color space, so midtones keep their
+	// brightness and saturation instead of turning muddy (default)
+	Oklab ColorSpace = iota
+	// HSL blends hue, saturation and lightness, taking the short way around the hue wheel
+	HSL
+	// LinearRGB blends the sRGB channels directly, as the effects did originally
+	LinearRGB
+)
+
+// Gradient is a precomputed run of hex colors through a list of stops
+type Gradient struct {
+	colors []string
+}
+
+// NewGradient creates a gradient through stops interpolated in Oklab.
+// Each pair of neighboring stops gets steps/(len(stops)-1) colors and the
+// last stop is appended, so the gradient always starts and ends on a stop.
+func NewGradient(stops []string, steps int) *Gradient {
+	return NewGradientInSpace(stops, steps, Oklab)
+}
+
+// NewGradientInSpace creates a gradient through stops interpolated in space
+func NewGradientInSpace(stops []string, steps int, space ColorSpace) *Gradient {
+	if len(stops) == 0 {
+		return &Gradient{colors: []string{"#ffffff"}}
+	}
+	if len(stops) == 1 {
+		return &Gradient{colors: []string{stops[0]}}
+	}
+
+	stepsPerSegment := steps / (len(stops) - 1)
+	if stepsPerSegment < 1 {
+		stepsPerSegment = 1
+	}
+
+	colors := make([]string, 0, stepsPerSegment*(len(stops)-1)+1)
+	for i := 0; i < len(stops)-1; i++ {
+		from := parseHexColor(stops[i])
+		to := parseHexColor(stops[i+1])
+		for j := 0; j < stepsPerSegment; j++ {
+			t := float64(j) / float64(stepsPerSegment)
+			colors = append(colors, formatHexColor(mixRGB(from, to, t, space)))
+		}
+	}
+	colors = append(colors, stops[len(stops)-1])
+
+	return &Gradient{colors: colors}
+}
+
+// At returns the gradient color nearest to t, where 0 is the first stop and 1 the last
+func (g *Gradient) At(t float64) string {
+	t = math.Max(0, math.Min(1, t))
+	return g.colors[int(math.Round(t*float64(len(g.colors)-1)))]
+}
+
+// Colors returns every color of the gradient in order
+func (g *Gradient) Colors() []string {
+	return g.colors
+}
+
+// Len returns the number of colors in the gradient
+func (g *Gradient) Len() int {
+	return len(g.colors)
+}
+
+// mixRGB blends two sRGB colors in space, t=0 returns from and t=1 returns to
+func mixRGB(from, to [3]uint8, t float64, space ColorSpace) [3]uint8 {
+	switch space {
+	case HSL:
+		return hslToRGB(mixHSL(rgbToHSL(from), rgbToHSL(to), t))
+	case LinearRGB:
+		var mixed [3]uint8
+		for i := range mixed {
+			mixed[i] = uint8(float64(from[i])*(1-t) + float64(to[i])*t)
+		}
+		return mixed
+	default:
+		a, b := rgbToOklab(from), rgbToOklab(to)
+		var mixed [3]float64
+		for i := range mixed {
+			mixed[i] = a[i]*(1-t) + b[i]*t
+		}
+		return oklabToRGB(mixed)
+	}
+}
+
+// srgbToLinear converts an sRGB channel to linear light in 0-1
+func srgbToLinear(c uint8) float64 {
+	v := float64(c) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB converts a linear light value back to a clamped sRGB channel
+func linearToSRGB(v float64) uint8 {
+	if v <= 0.0031308 {
+		v *= 12.92
+	} else {
+		v = 1.055*math.Pow(v, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
+
+// rgbToOklab converts an sRGB color to Oklab L, a, b
+func rgbToOklab(c [3]uint8) [3]float64 {
+	r, g, b := srgbToLinear(c[0]), srgbToLinear(c[1]), srgbToLinear(c[2])
+
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	return [3]float64{
+		0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+// oklabToRGB converts Oklab L, a, b to sRGB, clamping colors outside the gamut
+func oklabToRGB(lab [3]float64) [3]uint8 {
+	l := lab[0] + 0.3963377774*lab[1] + 0.2158037573*lab[2]
+	m := lab[0] - 0.1055613458*lab[1] - 0.0638541728*lab[2]
+	s := lab[0] - 0.0894841775*lab[1] - 1.2914855480*lab[2]
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	return [3]uint8{
+		linearToSRGB(4.0767416621*l - 3.3077115913*m + 0.2309699292*s),
+		linearToSRGB(-1.2684380046*l + 2.6097574011*m - 0.3413193965*s),
+		linearToSRGB(-0.0041960863*l - 0.7034186147*m + 1.7076147010*s),
+	}
+}
+
+// rgbToHSL converts an sRGB color to hue in degrees and saturation, lightness in 0-1
+func rgbToHSL(c [3]uint8) [3]float64 {
+	r, g, b := float64(c[0])/255, float64(c[1])/255, float64(c[2])/255
+	maxC := math.Max(r, math.Max(g, b))
+	minC := math.Min(r, math.Min(g, b))
+	l := (maxC + minC) / 2
+
+	if maxC == minC {
+		return [3]float64{0, 0, l}
+	}
+
+	d := maxC - minC
+	s := d / (1 - math.Abs(2*l-1))
+
+	var h float64
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return [3]float64{h, s, l}
+}
+
+// hslToRGB converts hue in degrees and saturation, lightness in 0-1 to sRGB
+func hslToRGB(hsl [3]float64) [3]uint8 {
+	h, s, l := hsl[0], hsl[1], hsl[2]
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	channel := func(v float64) uint8 {
+		return uint8(math.Round(math.Max(0, math.Min(1, v+m)) * 255))
+	}
+	return [3]uint8{channel(r), channel(g), channel(b)}
+}
+
+// mixHSL blends two HSL colors, turning the shorter way around the hue wheel.
+// A gray stop has no hue, so it borrows the other stop's hue instead of fading through red.
+func mixHSL(a, b [3]float64, t float64) [3]float64 {
+	if a[1] == 0 {
+		a[0] = b[0]
+	}
+	if b[1] == 0 {
+		b[0] = a[0]
+	}
+
+	dh := b[0] - a[0]
+	if dh > 180 {
+		dh -= 360
+	} else if dh < -180 {
+		dh += 360
+	}
+	h := math.Mod(a[0]+dh*t+360, 360)
+
+	return [3]float64{h, a[1] + (b[1]-a[1])*t, a[2] + (b[2]-a[2])*t}
+}
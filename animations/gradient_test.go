@@ -0,0 +1,35 @@
+package animations
+
+import "testing"
+
+func TestGradient_EndsOnStops(t *testing.T) {
+	stops := []string{"#ff0000", "#00ff00", "#0000ff"}
+	for _, space := range []ColorSpace{Oklab, HSL, LinearRGB} {
+		g := NewGradientInSpace(stops, 10, space)
+		if g.Len() != 11 {
+			t.Errorf("space %d: got %d colors, want 11", space, g.Len())
+		}
+		if got := g.At(0); got != "#ff0000" {
+			t.Errorf("space %d: At(0) = %s, want #ff0000", space, got)
+		}
+		if got := g.Colors()[5]; got != "#00ff00" {
+			t.Errorf("space %d: middle color = %s, want #00ff00", space, got)
+		}
+		if got := g.At(1); got != "#0000ff" {
+			t.Errorf("space %d: At(1) = %s, want #0000ff", space, got)
+		}
+	}
+}
+
+func TestGradient_OklabMidpointIsNotGray(t *testing.T) {
+	stops := []string{"#0000ff", "#ffff00"}
+	if got := NewGradientInSpace(stops, 4, LinearRGB).At(0.5); got != "#7f7f7f" {
+		t.Errorf("LinearRGB midpoint = %s, want #7f7f7f", got)
+	}
+
+	mid := parseHexColor(NewGradient(stops, 4).At(0.5))
+	hsl := rgbToHSL(mid)
+	if hsl[1] < 0.2 {
+		t.Errorf("Oklab midpoint %v has saturation %.2f, want a visible color", mid, hsl[1])
+	}
+}
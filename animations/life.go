@@ -0,0 +1,236 @@
+package animations
+
+import (
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"strings"
+	"unicode"
+)
+
+const (
+	lifeGlyph       = '█'
+	lifeNoise       = 0.3 // Share of cells alive when seeded from noise
+	lifeMaxAge      = 24  // Generations a cell takes to reach the last gradient stop
+	lifeLoopHistory = 16  // Generations remembered when looking for a repeating board
+)
+
+// LifeEffect runs Conway's Game of Life, seeded from text (every non-space
+// glyph is a live cell) or random noise. Cells are colored by how many
+// generations they have survived, and the effect is done once the board dies
+// out, settles or starts repeating.
+type LifeEffect struct {
+	width     int
+	height    int
+	text      string
+	stepEvery int
+	gradient  []string
+	ages      []int // Generations each cell has been alive, 0 = dead
+	next      []int
+	history   []uint64 // Hashes of recent boards, newest last
+	done      bool
+	frame     int
+	gen       int
+	rng       *rand.Rand
+	cells     [][]Cell // Frame grid reused between renders
+}
+
+// LifeConfig holds configuration for the Game of Life effect
+type LifeConfig struct {
+	Width         int
+	Height        int
+	GradientStops []string // Colors from newborn to old cells (default white to gray)
+	Text          string   // Seed pattern; empty seeds the board with random noise
+	StepEvery     int      // Frames per generation (default 2)
+	Seed          int64    // Random seed for reproducible output, 0 = seeded from the clock
+}
+
+// NewLifeEffect creates a Game of Life effect with the given configuration
+func NewLifeEffect(config LifeConfig) *LifeEffect {
+	stepEvery := config.StepEvery
+	if stepEvery <= 0 {
+		stepEvery = 2
+	}
+
+	stops := config.GradientStops
+	if len(stops) == 0 {
+		stops = []string{"#ffffff", "#555555"}
+	}
+
+	l := &LifeEffect{
+		width:     config.Width,
+		height:    config.Height,
+		text:      config.Text,
+		stepEvery: stepEvery,
+		gradient:  NewGradient(stops, lifeMaxAge).Colors(),
+		rng:       newRNG(config.Seed),
+	}
+	l.Reset()
+	return l
+}
+
+// Reset reseeds the board from the text or fresh noise
+func (l *LifeEffect) Reset() {
+	size := max(l.width, 0) * max(l.height, 0)
+	l.ages = make([]int, size)
+	l.next = make([]int, size)
+	l.history = l.history[:0]
+	l.done = false
+	l.frame = 0
+	l.gen = 0
+
+	if strings.TrimSpace(l.text) == "" {
+		for i := range l.ages {
+			if l.rng.Float64() < lifeNoise {
+				l.ages[i] = 1
+			}
+		}
+	} else {
+		l.seedText()
+	}
+	l.remember()
+}
+
+// seedText places the text centered on the board, alive wherever it has a glyph
+func (l *LifeEffect) seedText() {
+	lines := strings.Split(strings.TrimRight(l.text, "\n"), "\n")
+	widest := 0
+	for _, line := range lines {
+		widest = max(widest, len([]rune(line)))
+	}
+
+	startY := (l.height - len(lines)) / 2
+	startX := (l.width - widest) / 2
+	for row, line := range lines {
+		y := startY + row
+		if y < 0 || y >= l.height {
+			continue
+		}
+		for col, char := range []rune(line) {
+			if x := startX + col; x >= 0 && x < l.width && !unicode.IsSpace(char) {
+				l.ages[y*l.width+x] = 1
+			}
+		}
+	}
+}
+
+// Update advances one frame, stepping a generation every StepEvery frames
+func (l *LifeEffect) Update() {
+	if l.done {
+		return
+	}
+	l.frame++
+	if l.frame%l.stepEvery == 0 {
+		l.step()
+	}
+}
+
+// step computes the next generation on a board that wraps at the edges
+func (l *LifeEffect) step() {
+	for y := 0; y < l.height; y++ {
+		for x := 0; x < l.width; x++ {
+			neighbors := 0
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					if dx == 0 && dy == 0 {
+						continue
+					}
+					ny := (y + dy + l.height) % l.height
+					nx := (x + dx + l.width) % l.width
+					if l.ages[ny*l.width+nx] > 0 {
+						neighbors++
+					}
+				}
+			}
+
+			i := y*l.width + x
+			switch {
+			case l.ages[i] > 0 && (neighbors == 2 || neighbors == 3):
+				l.next[i] = l.ages[i] + 1
+			case l.ages[i] == 0 && neighbors == 3:
+				l.next[i] = 1
+			default:
+				l.next[i] = 0
+			}
+		}
+	}
+	l.ages, l.next = l.next, l.ages
+	l.gen++
+	l.done = l.remember()
+}
+
+// remember records the current board and reports whether it is empty or
+// matches one of the last lifeLoopHistory boards
+func (l *LifeEffect) remember() bool {
+	h := fnv.New64a()
+	alive := false
+	buf := make([]byte, 0, len(l.ages))
+	for _, age := range l.ages {
+		if age > 0 {
+			alive = true
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+	}
+	h.Write(buf)
+	sum := h.Sum64()
+
+	repeated := false
+	for _, old := range l.history {
+		if old == sum {
+			repeated = true
+			break
+		}
+	}
+	if len(l.history) == lifeLoopHistory {
+		l.history = append(l.history[:0], l.history[1:]...)
+	}
+	l.history = append(l.history, sum)
+	return !alive || repeated
+}
+
+// Done reports whether the board has died out, settled or started looping
+func (l *LifeEffect) Done() bool {
+	return l.done
+}
+
+// Resize reseeds the board at the new size
+func (l *LifeEffect) Resize(width, height int) {
+	l.width, l.height = width, height
+	l.Reset()
+}
+
+// Stats returns the generation and live cell count
+func (l *LifeEffect) Stats() Stats {
+	alive := 0
+	for _, age := range l.ages {
+		if age > 0 {
+			alive++
+		}
+	}
+	return Stats{Frame: l.frame, Counts: map[string]int{"generation": l.gen, "alive": alive}}
+}
+
+// RenderCells returns live cells colored by age
+func (l *LifeEffect) RenderCells() [][]Cell {
+	l.cells = resetCellGrid(l.cells, l.width, l.height)
+	for y, row := range l.cells {
+		for x := range row {
+			if age := l.ages[y*l.width+x]; age > 0 {
+				row[x] = Cell{Rune: lifeGlyph, Color: l.gradient[min(age, len(l.gradient))-1]}
+			}
+		}
+	}
+	return l.cells
+}
+
+// Render converts the board to colored text output
+func (l *LifeEffect) Render() string {
+	return renderCellsBatched(l.RenderCells())
+}
+
+// WriteTo streams the board to w, see io.WriterTo
+func (l *LifeEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCellsBatched(w, l.RenderCells())
+}
@@ -0,0 +1,54 @@
+package animations
+
+import "testing"
+
+func TestLife_TextSeedAndStillLife(t *testing.T) {
+	// A 2x2 block is a still life, so the board settles after one generation
+	life := NewLifeEffect(LifeConfig{Width: 6, Height: 4, Text: "##\n##", StepEvery: 1})
+	cells := life.RenderCells()
+	if cells[1][2].Rune != lifeGlyph || cells[2][3].Rune != lifeGlyph || cells[0][0].Rune != ' ' {
+		t.Fatalf("block not seeded centered:\n%v", cells)
+	}
+
+	life.Update()
+	if !life.Done() {
+		t.Error("still life not detected")
+	}
+	if got := life.Stats().Counts["alive"]; got != 4 {
+		t.Errorf("block has %d live cells, want 4", got)
+	}
+}
+
+func TestLife_Loops(t *testing.T) {
+	// A blinker flips between two boards forever
+	life := NewLifeEffect(LifeConfig{Width: 5, Height: 5, Text: "###", StepEvery: 1})
+	life.Update()
+	if life.Done() {
+		t.Fatal("done after one generation, the blinker hasn't repeated yet")
+	}
+	life.Update()
+	if !life.Done() {
+		t.Error("blinker loop not detected")
+	}
+
+	// Older cells move down the gradient
+	life = NewLifeEffect(LifeConfig{Width: 5, Height: 5, Text: "###", StepEvery: 1, GradientStops: []string{"#ffffff", "#000000"}})
+	life.Update()
+	life.Update()
+	if center := life.RenderCells()[2][2]; center.Color == "#ffffff" {
+		t.Errorf("blinker center still has the newborn color after surviving two generations")
+	}
+}
+
+func TestLife_NoiseSettles(t *testing.T) {
+	life := NewLifeEffect(LifeConfig{Width: 40, Height: 20, Seed: 1})
+	if life.Stats().Counts["alive"] == 0 {
+		t.Fatal("noise seeded an empty board")
+	}
+	for i := 0; i < 5000 && !life.Done(); i++ {
+		life.Update()
+	}
+	if !life.Done() {
+		t.Error("board neither settled nor looped within 5000 frames")
+	}
+}
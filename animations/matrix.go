@@ -1,22 +1,94 @@
 package animations
 
 import (
+	"io"
+	"math"
 	"math/rand"
-	"strings"
-
-	"github.com/charmbracelet/lipgloss/v2"
 )
 
 // MatrixEffect implements Matrix digital rain animation using particle-based streaks
 type MatrixEffect struct {
-	width   int      // Terminal width
-	height  int      // Terminal height
-	palette []string // Theme color palette
-	chars   []rune   // Matrix characters
+	width     int      // Terminal width
+	height    int      // Terminal height
+	palette   []string // Theme color palette
+	headColor string   // Brightest palette color pushed toward white
+	chars     []rune   // Matrix characters
+	columnW   int      // Cells per streak column: 2 when any glyph is full-width, else 1
 
 	// Particle-based implementation - individual streaks that move down screen
 	streaks []MatrixStreak // Active streaks
 	frame   int            // Animation frame counter
+
+	density    float64    // Average fraction of columns with a streak
+	speedRange [2]float64 // Streak speed range in cells per frame, zero for the default
+	maxStreaks int
+
+	// Afterglow - cells left behind by streak heads fade out over time
+	glow        bool
+	trailLength int
+	glowLife    [][]int  // Frames left before each cell goes dark
+	glowMax     [][]int  // Frames each cell started with
+	glowChars   [][]rune // Character shown in each glowing cell
+
+	rng *rand.Rand
+	buf frameBuffer // Canvas reused between frames
+}
+
+// MatrixConfig holds configuration for the Matrix effect
+type MatrixConfig struct {
+	Width       int
+	Height      int
+	Palette     []string
+	Glow        bool       // Leave a fading afterglow behind each streak head
+	TrailLength int        // Afterglow length in cells (default 12)
+	Density     float64    // Average fraction of columns with a streak (default 0.1)
+	SpeedRange  [2]float64 // Min and max streak speed in cells per frame (default 1/3 to 1)
+	GlyphSet    string     // One of MatrixGlyphSets (default: "mixed")
+	Seed        int64      // Random seed for reproducible output, 0 = seeded from the clock
+}
+
+// MatrixGlyphSets lists the glyph sets accepted by MatrixConfig.GlyphSet
+var MatrixGlyphSets = []string{"mixed", "ascii", "katakana", "binary"}
+
+// IsValidGlyphSet reports whether name is one of MatrixGlyphSets
+func IsValidGlyphSet(name string) bool {
+	for _, set := range MatrixGlyphSets {
+		if set == name {
+			return true
+		}
+	}
+	return false
+}
+
+// matrixGlyphs returns the characters of a glyph set, the mixed set for unknown names
+func matrixGlyphs(set string) []rune {
+	switch set {
+	case "ascii":
+		return []rune("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz!#$%&*+-<=>?@^~")
+	case "katakana":
+		// Half-width katakana ｦ to ﾝ, one cell wide like the film's glyphs, plus digits
+		glyphs := []rune("0123456789")
+		for r := '\uff66'; r <= '\uff9d'; r++ {
+			glyphs = append(glyphs, r)
+		}
+		return glyphs
+	case "binary":
+		return []rune("01")
+	}
+
+	// Use a mix of Latin, Greek, and Japanese characters like the original Matrix effect
+	return []rune{
+		'0', '1', '2', '3', '4', '5', '6', '7', '8', '9',
+		'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M',
+		'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z',
+		'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm',
+		'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z',
+		'α', 'β', 'γ', 'δ', 'ε', 'ζ', 'η', 'θ', 'ι', 'κ', 'λ', 'μ',
+		'ν', 'ξ', 'ο', 'π', 'ρ', 'σ', 'τ', 'υ', 'φ', 'χ', 'ψ', 'ω',
+		'А', 'Б', 'В', 'Г', 'Д', 'Е', 'Ж', 'З', 'И', 'Й', 'К', 'Л', 'М',
+		'Н', 'О', 'П', 'Р', 'С', 'Т', 'У', 'Ф', 'Х', 'Ц', 'Ч', 'Ш', 'Щ',
+		'░', '▒', '▓', '█', '▀', '▄', '▌', '▐', '■', '□', '▪', '▫',
+	}
 }
 
 // MatrixStreak represents a single vertical streak falling down the screen
@@ -27,6 +99,10 @@ type MatrixStreak struct {
 	Speed   int  // Movement speed (frames per pixel)
 	Counter int  // Frame counter for movement
 	Active  bool // Whether streak is active
+
+	velocity float64 // Cells per frame
+	offset   float64 // Progress toward the next cell
+	prevY    int     // Head position before the last update
 }
 
 // MatrixChar represents a single character in a streak
@@ -37,57 +113,120 @@ type MatrixChar struct {
 
 // NewMatrixEffect creates a new Matrix effect with given dimensions and theme palette
 func NewMatrixEffect(width, height int, palette []string) *MatrixEffect {
+	return NewMatrixEffectWithConfig(MatrixConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+	})
+}
+
+// NewMatrixEffectWithConfig creates a new Matrix effect with given configuration
+func NewMatrixEffectWithConfig(config MatrixConfig) *MatrixEffect {
+	trailLength := config.TrailLength
+	if trailLength <= 0 {
+		trailLength = 12
+	}
+
+	density := config.Density
+	if density <= 0 {
+		density = 0.1
+	}
+
 	m := &MatrixEffect{
-		width:   width,
-		height:  height,
-		palette: palette,
-		// Use a mix of Latin, Greek, and Japanese characters like the original Matrix effect
-		chars: []rune{
-			'0', '1', '2', '3', '4', '5', '6', '7', '8', '9',
-			'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M',
-			'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z',
-			'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm',
-			'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z',
-			'α', 'β', 'γ', 'δ', 'ε', 'ζ', 'η', 'θ', 'ι', 'κ', 'λ', 'μ',
-			'ν', 'ξ', 'ο', 'π', 'ρ', 'σ', 'τ', 'υ', 'φ', 'χ', 'ψ', 'ω',
-			'А', 'Б', 'В', 'Г', 'Д', 'Е', 'Ж', 'З', 'И', 'Й', 'К', 'Л', 'М',
-			'Н', 'О', 'П', 'Р', 'С', 'Т', 'У', 'Ф', 'Х', 'Ц', 'Ч', 'Ш', 'Щ',
-			'░', '▒', '▓', '█', '▀', '▄', '▌', '▐', '■', '□', '▪', '▫',
-		},
-		streaks: make([]MatrixStreak, 0, 100), // Pre-allocate capacity
-		frame:   0,
+		width:       config.Width,
+		height:      config.Height,
+		palette:     config.Palette,
+		density:     density,
+		speedRange:  validSpeedRange(config.SpeedRange),
+		maxStreaks:  int(1500 * density), // 150 at the default density
+		glow:        config.Glow,
+		trailLength: trailLength,
+		rng:         newRNG(config.Seed),
+		streaks:     make([]MatrixStreak, 0, 100), // Pre-allocate capacity
+		frame:       0,
 	}
+	m.UpdatePalette(config.Palette)
+	m.setGlyphs(matrixGlyphs(config.GlyphSet))
+	m.initGlow()
 	m.init()
 	return m
 }
 
+// setGlyphs switches the glyph set. If any glyph is full-width, like CJK
+// characters, streaks only fall in every other column so each glyph has the
+// two cells it takes to draw and neighboring streaks never overlap.
+func (m *MatrixEffect) setGlyphs(chars []rune) {
+	m.chars = chars
+	m.columnW = 1
+	for _, char := range chars {
+		m.columnW = max(m.columnW, runeWidth(char))
+	}
+}
+
+// initGlow allocates the afterglow grids for the current dimensions
+func (m *MatrixEffect) initGlow() {
+	if !m.glow {
+		return
+	}
+	m.glowLife = make([][]int, m.height)
+	m.glowMax = make([][]int, m.height)
+	m.glowChars = make([][]rune, m.height)
+	for y := range m.glowLife {
+		m.glowLife[y] = make([]int, m.width)
+		m.glowMax[y] = make([]int, m.width)
+		m.glowChars[y] = make([]rune, m.width)
+	}
+}
+
 // Initialize Matrix effect with some initial streaks
 func (m *MatrixEffect) init() {
 	// Create initial streaks across width
-	for i := 0; i < m.width; i++ {
-		if rand.Float64() < 0.1 { // 10% chance of initial streak
-			streak := MatrixStreak{
-				X:       i,
-				Y:       -rand.Intn(m.height), // Start above screen
-				Length:  rand.Intn(15) + 5,    // Length 5-20
-				Speed:   rand.Intn(3) + 1,     // Speed 1-3
-				Counter: 0,
-				Active:  true,
-			}
-			m.streaks = append(m.streaks, streak)
+	for i := 0; i+m.columnW <= m.width; i += m.columnW {
+		if m.rng.Float64() < m.density { // Chance of initial streak
+			m.streaks = append(m.streaks, m.newStreak(i, -m.rng.Intn(m.height))) // Start above screen
 		}
 	}
 }
 
+// newStreak creates a streak in column x with a random length and speed
+func (m *MatrixEffect) newStreak(x, y int) MatrixStreak {
+	streak := MatrixStreak{
+		X:       x,
+		Y:       y,
+		Length:  m.rng.Intn(15) + 5, // Length 5-20
+		Counter: 0,
+		Active:  true,
+	}
+
+	if m.speedRange == [2]float64{} {
+		streak.Speed = m.rng.Intn(3) + 1 // Speed 1-3 frames per cell
+		streak.velocity = 1 / float64(streak.Speed)
+	} else {
+		streak.velocity = randomInRange(m.rng, m.speedRange)
+		streak.Speed = max(1, int(math.Round(1/streak.velocity)))
+	}
+
+	return streak
+}
+
 // UpdatePalette changes the Matrix color palette (for theme switching)
 func (m *MatrixEffect) UpdatePalette(palette []string) {
 	m.palette = palette
+	m.headColor = "#ffffff" // Default white if no palette
+	if len(palette) > 0 {
+		m.headColor = blendColor(palette[len(palette)-1], "#ffffff", matrixHeadWhiteness)
+	}
 }
 
+// matrixHeadWhiteness is how far streak heads are pushed from the brightest
+// palette color toward white, so they lead the trail on any theme
+const matrixHeadWhiteness = 0.7
+
 // Resize reinitializes the Matrix effect with new dimensions
 func (m *MatrixEffect) Resize(width, height int) {
 	m.width = width
 	m.height = height
+	m.initGlow()
 	m.init()
 }
 
@@ -96,19 +235,12 @@ func (m *MatrixEffect) getRandomColor() string {
 	if len(m.palette) == 0 {
 		return "#00ff00" // Default green if no palette
 	}
-	return m.palette[rand.Intn(len(m.palette))]
+	return m.palette[m.rng.Intn(len(m.palette))]
 }
 
 // getHeadColor returns the bright color for the head of the streak
 func (m *MatrixEffect) getHeadColor() string {
-	if len(m.palette) == 0 {
-		return "#ffffff" // Default white if no palette
-	}
-	// Use the brightest color from the palette for heads
-	if len(m.palette) > 0 {
-		return m.palette[len(m.palette)-1]
-	}
-	return m.palette[0]
+	return m.headColor
 }
 
 // getTrailColor returns a dimmer color for trail positions
@@ -150,13 +282,13 @@ func (m *MatrixEffect) Update() {
 			continue
 		}
 
-		// Update streak movement counter
-		streak.Counter++
-
-		// Move streak when counter reaches speed threshold
-		if streak.Counter >= streak.Speed {
-			streak.Y++
-			streak.Counter = 0
+		// Move streak by whole cells as its velocity accumulates
+		streak.prevY = streak.Y
+		streak.offset += streak.velocity
+		moved := int(streak.offset)
+		if moved > 0 {
+			streak.Y += moved
+			streak.offset -= float64(moved)
 
 			// Deactivate streak when it moves completely off screen
 			if streak.Y-streak.Length > m.height {
@@ -172,35 +304,89 @@ func (m *MatrixEffect) Update() {
 	// Replace streak list with active streaks
 	m.streaks = activeStreaks
 
+	if m.glow {
+		m.updateGlow()
+	}
+
 	// Add new streaks randomly
-	for i := 0; i < m.width; i++ {
+	for i := 0; i+m.columnW <= m.width; i += m.columnW {
 		// Low probability to create new streaks
-		if rand.Float64() < 0.02 && len(m.streaks) < 150 { // Limit total streaks
-			streak := MatrixStreak{
-				X:       i,
-				Y:       -rand.Intn(5),     // Start just above screen
-				Length:  rand.Intn(15) + 5, // Length 5-20
-				Speed:   rand.Intn(3) + 1,  // Speed 1-3
-				Counter: 0,
-				Active:  true,
+		if m.rng.Float64() < m.density*0.2 && len(m.streaks) < m.maxStreaks { // Limit total streaks
+			m.streaks = append(m.streaks, m.newStreak(i, -m.rng.Intn(5))) // Start just above screen
+		}
+	}
+}
+
+// updateGlow fades every glowing cell and lights the cells under streak heads.
+// A cell glows for TrailLength moves of the streak that lit it, so the tail is
+// TrailLength cells long whatever the streak's speed.
+func (m *MatrixEffect) updateGlow() {
+	for y := range m.glowLife {
+		for x := range m.glowLife[y] {
+			if m.glowLife[y][x] > 0 {
+				m.glowLife[y][x]--
+			}
+		}
+	}
+
+	for _, streak := range m.streaks {
+		if streak.X < 0 || streak.X >= m.width {
+			continue
+		}
+		maxLife := int(math.Ceil(float64(m.trailLength) / streak.velocity))
+
+		// Light every cell the head passed this frame so fast streaks leave no gaps
+		for y := min(streak.prevY+1, streak.Y); y <= streak.Y; y++ {
+			if y < 0 || y >= m.height {
+				continue
+			}
+			life := maxLife - int(float64(streak.Y-y)/streak.velocity)
+			if m.glowLife[y][streak.X] < life {
+				if m.glowLife[y][streak.X] == 0 {
+					m.glowChars[y][streak.X] = m.chars[m.rng.Intn(len(m.chars))]
+				}
+				m.glowLife[y][streak.X] = life
+				m.glowMax[y][streak.X] = maxLife
 			}
-			m.streaks = append(m.streaks, streak)
 		}
 	}
 }
 
+// getGlowColor maps the remaining life of a glowing cell to a palette color,
+// from the brightest when freshly lit down to the darkest
+func (m *MatrixEffect) getGlowColor(life, max int) string {
+	if len(m.palette) == 0 {
+		return "#00ff00" // Default green if no palette
+	}
+	idx := (life*len(m.palette) - 1) / max
+	if idx >= len(m.palette) {
+		idx = len(m.palette) - 1
+	}
+	return m.palette[idx]
+}
+
+// Stats reports the frame count and how many streaks are falling
+func (m *MatrixEffect) Stats() Stats {
+	return Stats{Frame: m.frame, Counts: map[string]int{"streaks": len(m.streaks)}}
+}
+
 // Render converts the Matrix streaks to colored text output
 func (m *MatrixEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, m.height)
-	colors := make([][]string, m.height)
-	for i := range canvas {
-		canvas[i] = make([]rune, m.width)
-		colors[i] = make([]string, m.width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
-			colors[i][j] = ""
-		}
+	return renderCells(m.RenderCells())
+}
+
+// WriteTo streams the Matrix streaks to w, see io.WriterTo
+func (m *MatrixEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, m.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (m *MatrixEffect) RenderCells() [][]Cell {
+	canvas, colors := m.buf.clear(m.width, m.height)
+
+	if m.glow {
+		m.renderGlow(canvas, colors)
+		return m.buf.toCells()
 	}
 
 	// Render each active streak
@@ -214,7 +400,7 @@ func (m *MatrixEffect) Render() string {
 			yPos := streak.Y + i // Head at streak.Y, trail going down
 			if yPos >= 0 && yPos < m.height && streak.X >= 0 && streak.X < m.width {
 				// Get character
-				char := m.chars[rand.Intn(len(m.chars))]
+				char := m.chars[m.rng.Intn(len(m.chars))]
 
 				// Get color based on position in streak
 				var color string
@@ -227,37 +413,53 @@ func (m *MatrixEffect) Render() string {
 				}
 
 				// Place character on canvas
-				canvas[yPos][streak.X] = char
-				colors[yPos][streak.X] = color
+				m.put(canvas, colors, streak.X, yPos, char, color)
 			}
 		}
 	}
 
-	// Convert to colored string
-	var lines []string
-	for y := 0; y < m.height; y++ {
-		var line strings.Builder
-		for x := 0; x < m.width; x++ {
-			char := canvas[y][x]
-			if char != ' ' && colors[y][x] != "" {
-				// Render colored character
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(colors[y][x])).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
+	return m.buf.toCells()
+}
+
+// renderGlow draws the fading afterglow with the streak heads on top
+func (m *MatrixEffect) renderGlow(canvas [][]rune, colors [][]string) {
+	for y := range m.glowLife {
+		for x, life := range m.glowLife[y] {
+			if life > 0 {
+				m.put(canvas, colors, x, y, m.glowChars[y][x], m.getGlowColor(life, m.glowMax[y][x]))
 			}
 		}
-		lines = append(lines, line.String())
 	}
 
-	return strings.Join(lines, "\n")
+	for _, streak := range m.streaks {
+		if streak.Y >= 0 && streak.Y < m.height && streak.X >= 0 && streak.X < m.width {
+			m.put(canvas, colors, streak.X, streak.Y, m.chars[m.rng.Intn(len(m.chars))], m.getHeadColor())
+		}
+	}
+}
+
+// put draws a glyph at x, y. With full-width glyphs in the set every glyph
+// owns the cell to its right too: a wide glyph covers it and a narrow one
+// blanks it, so no half of an earlier wide glyph is left behind.
+func (m *MatrixEffect) put(canvas [][]rune, colors [][]string, x, y int, char rune, color string) {
+	if m.columnW > 1 {
+		if x+1 >= m.width {
+			return
+		}
+		canvas[y][x+1] = ' '
+		if runeWidth(char) == 2 {
+			canvas[y][x+1] = WideFill
+		}
+		colors[y][x+1] = ""
+	}
+	canvas[y][x] = char
+	colors[y][x] = color
 }
 
 // Reset restarts the animation from the beginning
 func (m *MatrixEffect) Reset() {
 	m.frame = 0
 	m.streaks = m.streaks[:0]
+	m.initGlow()
 	m.init()
 }
@@ -0,0 +1,80 @@
+package animations
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatrix_GlyphSets(t *testing.T) {
+	for _, set := range MatrixGlyphSets {
+		m := NewMatrixEffectWithConfig(MatrixConfig{Width: 40, Height: 20, Palette: []string{"#003300", "#00ff00"}, GlyphSet: set, Density: 1, Seed: 1})
+		glyphs := matrixGlyphs(set)
+		for i := 0; i < 30; i++ {
+			m.Update()
+		}
+		for _, row := range m.RenderCells() {
+			for _, cell := range row {
+				if cell.Rune != ' ' && !slices.Contains(glyphs, cell.Rune) {
+					t.Fatalf("%s: drew %q, which is not in the glyph set", set, cell.Rune)
+				}
+			}
+		}
+	}
+
+	if !slices.Contains(matrixGlyphs("katakana"), 'ｱ') {
+		t.Error("katakana set has no half-width katakana")
+	}
+}
+
+func TestMatrix_HeadBrighterThanTrail(t *testing.T) {
+	// The head leads even when the palette's brightest color is dim
+	palette := []string{"#001100", "#004400", "#007700"}
+	m := NewMatrixEffectWithConfig(MatrixConfig{Width: 10, Height: 10, Palette: palette, Seed: 1})
+
+	brightness := func(hex string) int {
+		rgb := parseHexColor(hex)
+		return int(rgb[0]) + int(rgb[1]) + int(rgb[2])
+	}
+	if head, trail := brightness(m.getHeadColor()), brightness(palette[2]); head <= trail {
+		t.Errorf("head color %s is not brighter than the trail %s", m.getHeadColor(), palette[2])
+	}
+}
+
+func TestMatrix_FullWidthGlyphs(t *testing.T) {
+	for _, glow := range []bool{false, true} {
+		m := NewMatrixEffectWithConfig(MatrixConfig{Width: 21, Height: 12, Palette: []string{"#003300", "#00ff00"}, Glow: glow, Density: 1, Seed: 1})
+		m.setGlyphs([]rune("日本語A"))
+		m.Reset()
+		for i := 0; i < 30; i++ {
+			m.Update()
+		}
+
+		drawn := 0
+		for y, row := range m.RenderCells() {
+			// Columns the row takes on screen, which must match the canvas width
+			columns := 0
+			for x, cell := range row {
+				switch {
+				case cell.Rune == WideFill:
+					if x == 0 || runeWidth(row[x-1].Rune) != 2 {
+						t.Fatalf("glow=%v: row %d has a wide fill at %d without a wide glyph before it", glow, y, x)
+					}
+				case runeWidth(cell.Rune) == 2:
+					if x+1 >= len(row) || row[x+1].Rune != WideFill {
+						t.Fatalf("glow=%v: wide glyph %q at %d,%d overlaps the next cell", glow, cell.Rune, x, y)
+					}
+					columns += 2
+					drawn++
+				default:
+					columns++
+				}
+			}
+			if columns != len(row) {
+				t.Fatalf("glow=%v: row %d takes %d columns, want %d", glow, y, columns, len(row))
+			}
+		}
+		if drawn == 0 {
+			t.Errorf("glow=%v: no wide glyphs drawn", glow)
+		}
+	}
+}
@@ -1,11 +1,9 @@
 package animations
 
 import (
+	"io"
 	"math/rand"
 	"strings"
-	"time"
-
-	"github.com/charmbracelet/lipgloss/v2"
 )
 
 // MatrixArtEffect implements Matrix rain that crystallizes into ASCII art
@@ -29,6 +27,8 @@ type MatrixArtEffect struct {
 	artHeight    int
 	rng          *rand.Rand
 	freezeChance float64 // Probability a character freezes
+	reveal       artReveal
+	buf          frameBuffer // Canvas reused between frames
 }
 
 // FrozenMatrixChar represents a matrix character that has frozen to form the art
@@ -37,8 +37,36 @@ type FrozenMatrixChar struct {
 	color string
 }
 
+// MatrixArtConfig holds configuration for the matrix-art effect
+type MatrixArtConfig struct {
+	Width   int
+	Height  int
+	Palette []string
+	Text    string
+	Seed    int64 // Random seed for reproducible output, 0 = seeded from the clock
+
+	// RevealFrames ends the rain: after this many frames the whole art
+	// appears, the streaks drain away and the art fades into
+	// FinalGradientStops (top to bottom), holding for HoldFrames before Done
+	// reports true. 0 loops forever.
+	RevealFrames       int
+	HoldFrames         int
+	FinalGradientStops []string
+}
+
 // NewMatrixArtEffect creates a new matrix-art effect
 func NewMatrixArtEffect(width, height int, palette []string, text string) *MatrixArtEffect {
+	return NewMatrixArtEffectWithConfig(MatrixArtConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+		Text:    text,
+	})
+}
+
+// NewMatrixArtEffectWithConfig creates a new matrix-art effect with given configuration
+func NewMatrixArtEffectWithConfig(config MatrixArtConfig) *MatrixArtEffect {
+	width, height, palette, text := config.Width, config.Height, config.Palette, config.Text
 	m := &MatrixArtEffect{
 		width:   width,
 		height:  height,
@@ -60,8 +88,9 @@ func NewMatrixArtEffect(width, height int, palette []string, text string) *Matri
 		text:         text,
 		artPositions: make(map[int]map[int]rune),
 		frozenChars:  make(map[int]map[int]*FrozenMatrixChar),
-		rng:          rand.New(rand.NewSource(time.Now().UnixNano())),
+		rng:          newRNG(config.Seed),
 		freezeChance: 0.99, // 99% chance to freeze when passing through art position (extremely fast crystallization)
+		reveal:       newArtReveal(config.RevealFrames, config.HoldFrames, config.FinalGradientStops),
 	}
 
 	m.parseArt()
@@ -167,6 +196,10 @@ func (m *MatrixArtEffect) getTrailColor(position, length int) string {
 // Update advances the simulation by one frame
 func (m *MatrixArtEffect) Update() {
 	m.frame++
+	settling := m.reveal.settling(m.frame)
+	if settling {
+		m.freezeAll()
+	}
 
 	// Update existing streaks
 	activeStreaks := m.streaks[:0]
@@ -203,8 +236,12 @@ func (m *MatrixArtEffect) Update() {
 			}
 		}
 
-		// Recycle streak when it goes off screen (like rain-art)
+		// Recycle streak when it goes off screen (like rain-art),
+		// or let it drain away once the art is settling
 		if streak.Y-streak.Length > m.height {
+			if settling {
+				continue
+			}
 			// Reset streak to top with new random properties
 			streak.Y = -m.rng.Intn(m.height)
 			streak.X = m.rng.Intn(m.width)
@@ -218,19 +255,43 @@ func (m *MatrixArtEffect) Update() {
 	m.streaks = activeStreaks
 }
 
-// Render converts the matrix and frozen art to colored output
-func (m *MatrixArtEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, m.height)
-	colors := make([][]string, m.height)
-	for i := range canvas {
-		canvas[i] = make([]rune, m.width)
-		colors[i] = make([]string, m.width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
-			colors[i][j] = ""
+// freezeAll freezes every art character that hasn't crystallized yet
+func (m *MatrixArtEffect) freezeAll() {
+	for y, row := range m.artPositions {
+		for x, char := range row {
+			if m.frozenChars[y] == nil {
+				m.frozenChars[y] = make(map[int]*FrozenMatrixChar)
+			}
+			if m.frozenChars[y][x] == nil {
+				m.frozenChars[y][x] = &FrozenMatrixChar{char: char, color: m.getHeadColor()}
+			}
 		}
 	}
+}
+
+// Done reports whether the art has been revealed and held, always false without RevealFrames
+func (m *MatrixArtEffect) Done() bool {
+	return m.reveal.done(m.frame)
+}
+
+// Stats reports the frame count, falling streaks and crystallized art characters
+func (m *MatrixArtEffect) Stats() Stats {
+	frozen := 0
+	for _, row := range m.frozenChars {
+		frozen += len(row)
+	}
+	return Stats{
+		Frame: m.frame,
+		Counts: map[string]int{
+			"streaks": len(m.streaks),
+			"frozen":  frozen,
+		},
+	}
+}
+
+// RenderCells draws the matrix and frozen art as a grid of runes and colors
+func (m *MatrixArtEffect) RenderCells() [][]Cell {
+	canvas, colors := m.buf.clear(m.width, m.height)
 
 	// Render matrix streaks
 	for _, streak := range m.streaks {
@@ -264,33 +325,42 @@ func (m *MatrixArtEffect) Render() string {
 		for x, frozen := range row {
 			if y >= 0 && y < m.height && x >= 0 && x < m.width {
 				canvas[y][x] = frozen.char
-				colors[y][x] = frozen.color
+				colors[y][x] = m.reveal.color(frozen.color, m.frame, y-m.centerY, m.artHeight)
 			}
 		}
 	}
 
-	// Convert to colored string
-	var lines []string
-	for y := 0; y < m.height; y++ {
-		var line strings.Builder
-		for x := 0; x < m.width; x++ {
-			char := canvas[y][x]
-			if char != ' ' && colors[y][x] != "" {
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(colors[y][x])).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
-			}
-		}
-		lines = append(lines, line.String())
-	}
+	return m.buf.toCells()
+}
 
-	return strings.Join(lines, "\n")
+// Render converts the matrix and frozen art to colored output
+func (m *MatrixArtEffect) Render() string {
+	return renderCells(m.RenderCells())
+}
+
+// WriteTo streams the matrix and frozen art to w, see io.WriterTo
+func (m *MatrixArtEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, m.RenderCells())
 }
 
 // Reset clears frozen characters to restart the formation
 func (m *MatrixArtEffect) Reset() {
+	if m.reveal.settling(m.frame) {
+		// The reveal drained the streaks, so start a fresh set
+		m.streaks = m.streaks[:0]
+		m.init()
+	}
+	m.frame = 0
+	m.frozenChars = make(map[int]map[int]*FrozenMatrixChar)
+}
+
+// Resize re-centers the art and restarts the formation with new dimensions
+func (m *MatrixArtEffect) Resize(width, height int) {
+	m.width = width
+	m.height = height
+	m.streaks = m.streaks[:0]
+	m.artPositions = make(map[int]map[int]rune)
 	m.frozenChars = make(map[int]map[int]*FrozenMatrixChar)
+	m.parseArt()
+	m.init()
 }
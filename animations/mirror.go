@@ -0,0 +1,72 @@
+package animations
+
+import "strings"
+
+// mirroredGlyphs maps directional characters to their horizontal mirror image
+var mirroredGlyphs = map[rune]rune{
+	'<': '>', '>': '<',
+	'(': ')', ')': '(',
+	'/': '\\', '\\': '/',
+	'[': ']', ']': '[',
+	'{': '}', '}': '{',
+	'`': '\'', '\'': '`',
+	'd': 'b', 'b': 'd',
+	'p': 'q', 'q': 'p',
+	'«': '»', '»': '«',
+	'┌': '┐', '┐': '┌',
+	'└': '┘', '┘': '└',
+	'├': '┤', '┤': '├',
+	'╭': '╮', '╮': '╭',
+	'╰': '╯', '╯': '╰',
+	'┏': '┓', '┓': '┏',
+	'┗': '┛', '┛': '┗',
+	'┣': '┫', '┫': '┣',
+	'╔': '╗', '╗': '╔',
+	'╚': '╝', '╝': '╚',
+	'╠': '╣', '╣': '╠',
+	'╱': '╲', '╲': '╱',
+	'▌': '▐', '▐': '▌',
+	'▖': '▗', '▗': '▖',
+	'▘': '▝', '▝': '▘',
+	'▙': '▟', '▟': '▙',
+	'▛': '▜', '▜': '▛',
+	'◀': '▶', '▶': '◀',
+}
+
+// mirrorPattern flips multi-line ASCII art horizontally. Lines are padded to
+// the widest line first so the art keeps its shape, and directional glyphs
+// are swapped with their mirror image.
+func mirrorPattern(pattern []string) []string {
+	width := 0
+	for _, line := range pattern {
+		width = max(width, displayWidth(line))
+	}
+
+	mirrored := make([]string, len(pattern))
+	for i, line := range pattern {
+		runes := []rune(line)
+		var flipped strings.Builder
+		flipped.WriteString(strings.Repeat(" ", width-displayWidth(line)))
+		for j := len(runes) - 1; j >= 0; j-- {
+			r := runes[j]
+			if m, ok := mirroredGlyphs[r]; ok {
+				r = m
+			}
+			flipped.WriteRune(r)
+		}
+		mirrored[i] = flipped.String()
+	}
+	return mirrored
+}
+
+// MirrorText flips text art horizontally, e.g. to make a facing pair of the
+// same art. Lines keep their place relative to the widest one, directional
+// glyphs like / ( < [ and box corners are swapped with their mirror image,
+// and the trailing spaces left by the flip are trimmed.
+func MirrorText(text string) string {
+	lines := mirrorPattern(strings.Split(text, "\n"))
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
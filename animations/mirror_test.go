@@ -0,0 +1,23 @@
+package animations
+
+import "testing"
+
+func TestMirrorText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"ab", "da"},
+		{"(>_<)", "(>_<)"},
+		{" /\\\n/  \\", " /\\\n/  \\"},
+		{"<-[x\nlong line", "     x]->\nenil gnol"},
+		{"┌─┐\n└─┘", "┌─┐\n└─┘"},
+		{"├ 日", "日 ┤"},
+		{"art\n", "tra\n"},
+	}
+	for _, tt := range tests {
+		if got := MirrorText(tt.text); got != tt.want {
+			t.Errorf("MirrorText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package animations
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPalette_Helpers(t *testing.T) {
+	p := Palette{"#000000", "#550000", "#aa0000", "#ffffff"}
+
+	if got, want := p.Reverse(), (Palette{"#ffffff", "#aa0000", "#550000", "#000000"}); !slices.Equal(got, want) {
+		t.Errorf("Reverse = %v, want %v", got, want)
+	}
+	if got, want := p.Rotate(1), (Palette{"#ffffff", "#000000", "#550000", "#aa0000"}); !slices.Equal(got, want) {
+		t.Errorf("Rotate(1) = %v, want %v", got, want)
+	}
+	if got, want := p.Rotate(-5), (Palette{"#550000", "#aa0000", "#ffffff", "#000000"}); !slices.Equal(got, want) {
+		t.Errorf("Rotate(-5) = %v, want %v", got, want)
+	}
+	if got, want := p.Subset(1, 3), (Palette{"#550000", "#aa0000"}); !slices.Equal(got, want) {
+		t.Errorf("Subset(1, 3) = %v, want %v", got, want)
+	}
+	if got := p.Subset(3, 10); !slices.Equal(got, Palette{"#ffffff"}) {
+		t.Errorf("Subset(3, 10) = %v, want the last color", got)
+	}
+
+	// Helpers return copies and assign straight to []string fields
+	var config FireConfig
+	config.Palette = p.Reverse()
+	config.Palette[0] = "#123456"
+	if p[3] != "#ffffff" {
+		t.Error("Reverse shares its colors with the original palette")
+	}
+	if len(Palette{}.Rotate(3)) != 0 {
+		t.Error("Rotate of an empty palette isn't empty")
+	}
+}
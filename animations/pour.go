@@ -1,13 +1,13 @@
 package animations
 
 import (
-	"fmt"
+	"io"
 	"math"
+	"math/rand"
 	"sort"
-	"strconv"
 	"strings"
 
-	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/Nomadcxx/sysc-Go/animations/ease"
 )
 
 // PourEffect implements a character pouring animation from different directions
@@ -15,6 +15,8 @@ type PourEffect struct {
 	width                  int
 	height                 int
 	text                   string
+	align                  TextAlign
+	margin                 int
 	pourDirection          string
 	pourSpeed              int
 	movementSpeed          float64
@@ -27,10 +29,11 @@ type PourEffect struct {
 	finalGradientDirection string
 	phase                  string
 	frameCount             int
-	holdFrameCount         int  // Frames to hold after completion before looping
-	auto                   bool // Auto-size canvas to fit text
-	display                bool // Display mode: complete once and hold
-	holdFrames             int  // Configurable hold frames
+	holdFrameCount         int     // Frames to hold after completion before looping
+	auto                   bool    // Auto-size canvas to fit text
+	display                bool    // Display mode: complete once and hold
+	holdFrames             int     // Configurable hold frames
+	jitter                 float64 // Max sideways wobble in cells while falling
 
 	chars          []PourCharacter
 	groups         [][]int // Indices of characters grouped by row/column
@@ -40,10 +43,12 @@ type PourEffect struct {
 	alternateDir   bool // Alternate pouring direction
 
 	// Pre-allocated buffer for performance
-	buffer [][]string
+	buffer [][]Cell
 	// Cached RGB values for color interpolation (performance)
-	startColorRGB [3]int
-	colorCache    map[string][3]int
+
+	rng        *rand.Rand
+	rngSource  *seekableSource // Behind rng, for snapshots
+	completion completion
 }
 
 // PourCharacter represents a single character in the pour animation
@@ -61,6 +66,7 @@ type PourCharacter struct {
 	progress        float64
 	gradientStep    int
 	gradientCounter int
+	jitterPhase     float64 // Phase offset of the lateral wobble
 }
 
 // PourConfig holds configuration for the pour effect
@@ -68,19 +74,50 @@ type PourConfig struct {
 	Width                  int
 	Height                 int
 	Text                   string
-	PourDirection          string
+	PourDirection          string // One of PourDirections (default: "down")
 	PourSpeed              int
 	MovementSpeed          float64
-	EasingFunction         string // "easeIn", "easeOut", "easeInOut" (default: "easeIn")
+	EasingFunction         string // One of PourEasings (default: "easeIn")
 	Gap                    int
 	StartingColor          string
 	FinalGradientStops     []string
 	FinalGradientSteps     int
 	FinalGradientFrames    int
 	FinalGradientDirection string
-	Auto                   bool // Auto-size canvas to fit text dimensions
-	Display                bool // Display mode: complete once and hold (true) or loop (false)
-	HoldFrames             int  // Frames to hold completed state before looping (default 100)
+	Auto                   bool      // Auto-size canvas to fit text dimensions
+	Display                bool      // Display mode: complete once and hold (true) or loop (false)
+	HoldFrames             int       // Frames to hold completed state before looping (default 100)
+	Jitter                 float64   // Max sideways wobble in cells while falling (0 = straight line)
+	Align                  TextAlign // Horizontal placement of the text (default: center)
+	Margin                 int       // Columns kept clear at the aligned edge
+	Seed                   int64     // Random seed for reproducible output, 0 = seeded from the clock
+	OnComplete             func()    // Called once, the first time the effect finishes
+}
+
+// PourDirections lists the directions accepted by PourConfig.PourDirection
+var PourDirections = []string{"down", "up", "left", "right", "diagonal-tl", "diagonal-tr", "diagonal-bl", "diagonal-br"}
+
+// PourEasings lists the easing functions accepted by PourConfig.EasingFunction
+var PourEasings = []string{"easeIn", "easeOut", "easeInOut", "easeOutBounce", "easeOutElastic"}
+
+// IsValidPourDirection reports whether name is one of PourDirections
+func IsValidPourDirection(name string) bool {
+	for _, direction := range PourDirections {
+		if direction == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidEasing reports whether name is one of PourEasings
+func IsValidEasing(name string) bool {
+	for _, easing := range PourEasings {
+		if easing == name {
+			return true
+		}
+	}
+	return false
 }
 
 // NewPourEffect creates a new pour effect with given configuration
@@ -93,6 +130,11 @@ func NewPourEffect(config PourConfig) *PourEffect {
 	}
 
 	// Set defaults
+	pourDirection := config.PourDirection
+	if pourDirection == "" {
+		pourDirection = "down"
+	}
+
 	easingFunction := config.EasingFunction
 	if easingFunction == "" {
 		easingFunction = "easeIn" // Default easing
@@ -104,16 +146,15 @@ func NewPourEffect(config PourConfig) *PourEffect {
 	}
 
 	// Pre-allocate buffer for performance
-	buffer := make([][]string, height)
-	for i := range buffer {
-		buffer[i] = make([]string, width)
-	}
+	buffer := newCellGrid(width, height)
 
 	effect := &PourEffect{
 		width:                  width,
 		height:                 height,
 		text:                   config.Text,
-		pourDirection:          config.PourDirection,
+		align:                  config.Align,
+		margin:                 config.Margin,
+		pourDirection:          pourDirection,
 		pourSpeed:              config.PourSpeed,
 		movementSpeed:          config.MovementSpeed,
 		easingFunction:         easingFunction,
@@ -133,11 +174,11 @@ func NewPourEffect(config PourConfig) *PourEffect {
 		display:                config.Display,
 		holdFrames:             holdFrames,
 		buffer:                 buffer,
-		colorCache:             make(map[string][3]int),
+		jitter:                 config.Jitter,
+		completion:             completion{callback: config.OnComplete},
 	}
 
-	// Cache starting color RGB
-	effect.startColorRGB = effect.parseAndCacheColor(config.StartingColor)
+	effect.rng, effect.rngSource = newSeekableRNG(config.Seed)
 
 	effect.init()
 	return effect
@@ -148,10 +189,7 @@ func calculatePourTextDimensions(text string) (int, int) {
 	lines := strings.Split(text, "\n")
 	maxWidth := 0
 	for _, line := range lines {
-		runes := []rune(line)
-		if len(runes) > maxWidth {
-			maxWidth = len(runes)
-		}
+		maxWidth = max(maxWidth, displayWidth(line))
 	}
 	return maxWidth, len(lines)
 }
@@ -169,14 +207,14 @@ func (p *PourEffect) init() {
 	// Find maximum line width for proper ASCII art alignment
 	maxLineWidth := 0
 	for _, line := range lines {
-		lineLen := len([]rune(line))
+		lineLen := displayWidth(line)
 		if lineLen > maxLineWidth {
 			maxLineWidth = lineLen
 		}
 	}
 
-	// Calculate starting X position based on max line width (centers the entire block)
-	baseStartX := (p.width - maxLineWidth) / 2
+	// Calculate starting X position based on max line width (aligns the entire block)
+	baseStartX := p.align.startX(p.width, maxLineWidth, p.margin)
 	if baseStartX < 0 {
 		baseStartX = 0
 	}
@@ -184,17 +222,16 @@ func (p *PourEffect) init() {
 	// Map text to terminal coordinates
 	for lineIdx, line := range lines {
 		// All lines start at the same X position for proper ASCII art alignment
-		startX := baseStartX
+		x := baseStartX
 
-		// Convert to runes to get proper character indices (not byte indices)
-		runes := []rune(line)
-		for charIdx := 0; charIdx < len(runes); charIdx++ {
-			char := runes[charIdx]
+		// Full-width characters take two columns, everything else one
+		for _, char := range line {
 			// Don't skip spaces - they're part of ASCII art structure!
 			// Spaces create the negative space that defines the art
 
-			finalX := startX + charIdx
+			finalX := x
 			finalY := startY + lineIdx
+			x += runeWidth(char)
 
 			// Skip characters that would be off-screen
 			if finalX >= p.width || finalY >= p.height {
@@ -221,6 +258,7 @@ func (p *PourEffect) init() {
 				progress:        0.0,
 				gradientStep:    0,
 				gradientCounter: 0,
+				jitterPhase:     p.rng.Float64() * 2 * math.Pi,
 			})
 		}
 	}
@@ -240,6 +278,14 @@ func (p *PourEffect) getStartPosition(finalX, finalY int) (int, int) {
 		return p.width - 1, finalY
 	case "right":
 		return 0, finalY
+	case "diagonal-tl":
+		return 0, 0
+	case "diagonal-tr":
+		return p.width - 1, 0
+	case "diagonal-bl":
+		return 0, p.height - 1
+	case "diagonal-br":
+		return p.width - 1, p.height - 1
 	default:
 		return finalX, 0
 	}
@@ -247,9 +293,12 @@ func (p *PourEffect) getStartPosition(finalX, finalY int) (int, int) {
 
 // Create groups of characters by row or column
 func (p *PourEffect) createGroups() {
-	if p.pourDirection == "up" || p.pourDirection == "down" {
+	switch p.pourDirection {
+	case "up", "down":
 		p.groupByRows()
-	} else {
+	case "diagonal-tl", "diagonal-tr", "diagonal-bl", "diagonal-br":
+		p.groupByDiagonals()
+	default:
 		p.groupByColumns()
 	}
 }
@@ -316,6 +365,38 @@ func (p *PourEffect) groupByColumns() {
 	}
 }
 
+// Group characters by diagonals (for corner pouring), nearest to the corner first
+func (p *PourEffect) groupByDiagonals() {
+	// Create map of diagonal distance from the starting corner to character indices
+	diagMap := make(map[int][]int)
+	for i, char := range p.chars {
+		var diag int
+		switch p.pourDirection {
+		case "diagonal-tr":
+			diag = (p.width - 1 - char.finalX) + char.finalY
+		case "diagonal-bl":
+			diag = char.finalX + (p.height - 1 - char.finalY)
+		case "diagonal-br":
+			diag = (p.width - 1 - char.finalX) + (p.height - 1 - char.finalY)
+		default: // "diagonal-tl"
+			diag = char.finalX + char.finalY
+		}
+		diagMap[diag] = append(diagMap[diag], i)
+	}
+
+	// Sort by diagonal index and create groups
+	keys := make([]int, 0, len(diagMap))
+	for k := range diagMap {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	p.groups = make([][]int, 0, len(keys))
+	for _, k := range keys {
+		p.groups = append(p.groups, diagMap[k])
+	}
+}
+
 // Calculate gradient color for a specific coordinate
 func (p *PourEffect) getGradientColorForCoord(x, y int) string {
 	if len(p.finalGradientStops) == 0 {
@@ -351,31 +432,19 @@ func (p *PourEffect) getGradientColorForCoord(x, y int) string {
 	return p.finalGradientStops[step]
 }
 
-// Easing functions for smooth movement
-func (p *PourEffect) easeInQuad(t float64) float64 {
-	return t * t
-}
-
-func (p *PourEffect) easeOutQuad(t float64) float64 {
-	return t * (2 - t)
-}
-
-func (p *PourEffect) easeInOutQuad(t float64) float64 {
-	if t < 0.5 {
-		return 2 * t * t
-	}
-	return -1 + (4-2*t)*t
-}
-
 // applyEasing applies the configured easing function
 func (p *PourEffect) applyEasing(t float64) float64 {
 	switch p.easingFunction {
 	case "easeOut":
-		return p.easeOutQuad(t)
+		return ease.OutQuad(t)
 	case "easeInOut":
-		return p.easeInOutQuad(t)
+		return ease.InOutQuad(t)
+	case "easeOutBounce":
+		return ease.OutBounce(t)
+	case "easeOutElastic":
+		return ease.OutElastic(t)
 	default: // "easeIn"
-		return p.easeInQuad(t)
+		return ease.InQuad(t)
 	}
 }
 
@@ -415,6 +484,7 @@ func (p *PourEffect) updatePouringPhase() {
 	// Check if all groups are complete
 	if p.currentGroup >= len(p.groups) {
 		p.phase = "complete"
+		p.completion.fire()
 		p.updateCharacterMovement()
 		p.updateCharacterGradients()
 		return
@@ -466,6 +536,13 @@ func (p *PourEffect) updateCharacterMovement() {
 		char.currentX = float64(char.startX) + (float64(char.finalX)-float64(char.startX))*easedProgress
 		char.currentY = float64(char.startY) + (float64(char.finalY)-float64(char.startY))*easedProgress
 
+		// Wobble sideways, decaying to zero so the final position stays exact
+		if p.jitter > 0 {
+			offsetX, offsetY := p.jitterOffset(char)
+			char.currentX += offsetX
+			char.currentY += offsetY
+		}
+
 		// Snap to final position when complete
 		if char.progress >= 1.0 {
 			char.currentX = float64(char.finalX)
@@ -474,6 +551,21 @@ func (p *PourEffect) updateCharacterMovement() {
 	}
 }
 
+// jitterOffset returns the lateral wobble of a character at its current progress,
+// perpendicular to its path
+func (p *PourEffect) jitterOffset(char *PourCharacter) (float64, float64) {
+	dx := float64(char.finalX - char.startX)
+	dy := float64(char.finalY - char.startY)
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return 0, 0
+	}
+
+	// Three wobbles over the flight, fading out as the character settles
+	amount := p.jitter * math.Sin(char.progress*6*math.Pi+char.jitterPhase) * (1 - char.progress)
+	return -dy / length * amount, dx / length * amount
+}
+
 // Update character gradient animation
 func (p *PourEffect) updateCharacterGradients() {
 	for i := range p.chars {
@@ -496,7 +588,7 @@ func (p *PourEffect) updateCharacterGradients() {
 				if ratio > 1.0 {
 					ratio = 1.0
 				}
-				char.color = p.interpolateColor(p.startingColor, char.finalColor, ratio)
+				char.color = blendColor(p.startingColor, char.finalColor, ratio)
 			} else {
 				char.color = char.finalColor
 			}
@@ -504,49 +596,41 @@ func (p *PourEffect) updateCharacterGradients() {
 	}
 }
 
-// parseAndCacheColor parses and caches RGB values for performance
-func (p *PourEffect) parseAndCacheColor(hex string) [3]int {
-	if rgb, ok := p.colorCache[hex]; ok {
-		return rgb
+// Stats reports the phase, frame count and how many characters have landed
+func (p *PourEffect) Stats() Stats {
+	landed := 0
+	for _, char := range p.chars {
+		if char.visible && char.progress >= 1 {
+			landed++
+		}
 	}
-
-	if len(hex) < 7 || hex[0] != '#' {
-		rgb := [3]int{255, 255, 255}
-		p.colorCache[hex] = rgb
-		return rgb
+	return Stats{
+		Phase: p.phase,
+		Frame: p.frameCount,
+		Counts: map[string]int{
+			"characters": len(p.chars),
+			"landed":     landed,
+		},
 	}
-
-	r, _ := strconv.ParseInt(hex[1:3], 16, 64)
-	g, _ := strconv.ParseInt(hex[3:5], 16, 64)
-	b, _ := strconv.ParseInt(hex[5:7], 16, 64)
-
-	rgb := [3]int{int(r), int(g), int(b)}
-	p.colorCache[hex] = rgb
-	return rgb
 }
 
-// Interpolate between two colors using cached RGB values
-func (p *PourEffect) interpolateColor(startColor, endColor string, ratio float64) string {
-	startRGB := p.parseAndCacheColor(startColor)
-	endRGB := p.parseAndCacheColor(endColor)
-
-	r := int(float64(startRGB[0]) + float64(endRGB[0]-startRGB[0])*ratio)
-	g := int(float64(startRGB[1]) + float64(endRGB[1]-startRGB[1])*ratio)
-	b := int(float64(startRGB[2]) + float64(endRGB[2]-startRGB[2])*ratio)
-
-	r = int(math.Max(0, math.Min(255, float64(r))))
-	g = int(math.Max(0, math.Min(255, float64(g))))
-	b = int(math.Max(0, math.Min(255, float64(b))))
+// Render converts the pour effect to colored text output
+func (p *PourEffect) Render() string {
+	return renderCells(p.RenderCells())
+}
 
-	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+// WriteTo streams the pour effect to w, see io.WriterTo
+func (p *PourEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, p.RenderCells())
 }
 
-// Render converts the pour effect to colored text output
-func (p *PourEffect) Render() string {
+// RenderCells returns the current frame as a grid of runes and colors.
+// The grid is the effect's pre-allocated buffer and is overwritten each frame.
+func (p *PourEffect) RenderCells() [][]Cell {
 	// Clear pre-allocated buffer
 	for i := range p.buffer {
 		for j := range p.buffer[i] {
-			p.buffer[i][j] = " "
+			p.buffer[i][j] = Cell{Rune: ' '}
 		}
 	}
 
@@ -557,19 +641,12 @@ func (p *PourEffect) Render() string {
 			y := int(math.Round(char.currentY))
 
 			if y >= 0 && y < p.height && x >= 0 && x < p.width {
-				style := lipgloss.NewStyle().Foreground(lipgloss.Color(char.color))
-				p.buffer[y][x] = style.Render(string(char.original))
+				putCell(p.buffer[y], x, Cell{Rune: char.original, Color: char.color})
 			}
 		}
 	}
 
-	// Convert buffer to string
-	var lines []string
-	for _, line := range p.buffer {
-		lines = append(lines, strings.Join(line, ""))
-	}
-
-	return strings.Join(lines, "\n")
+	return p.buffer
 }
 
 // Resize updates the effect dimensions and reinitializes
@@ -578,10 +655,7 @@ func (p *PourEffect) Resize(width, height int) {
 	p.height = height
 
 	// Re-allocate buffer for new dimensions
-	p.buffer = make([][]string, height)
-	for i := range p.buffer {
-		p.buffer[i] = make([]string, width)
-	}
+	p.buffer = newCellGrid(width, height)
 
 	// Reinitialize with new dimensions
 	p.chars = nil
@@ -596,6 +670,61 @@ func (p *PourEffect) Resize(width, height int) {
 	p.init()
 }
 
+// PourSnapshot is the mutable state of a PourEffect, see Snapshot
+type PourSnapshot struct {
+	width, height  int
+	phase          string
+	frameCount     int
+	holdFrameCount int
+	chars          []PourCharacter
+	groups         [][]int
+	currentGroup   int
+	currentInGroup int
+	gapCounter     int
+	alternateDir   bool
+	rng            rngState
+}
+
+// Snapshot captures the effect's current state, including its place in the
+// random sequence, so Restore can later seek back to this exact frame
+func (p *PourEffect) Snapshot() PourSnapshot {
+	return PourSnapshot{
+		width:          p.width,
+		height:         p.height,
+		phase:          p.phase,
+		frameCount:     p.frameCount,
+		holdFrameCount: p.holdFrameCount,
+		chars:          append([]PourCharacter(nil), p.chars...),
+		groups:         p.groups, // Only replaced by init, never changed in place
+		currentGroup:   p.currentGroup,
+		currentInGroup: p.currentInGroup,
+		gapCounter:     p.gapCounter,
+		alternateDir:   p.alternateDir,
+		rng:            p.rngSource.state(),
+	}
+}
+
+// Restore returns the effect to a state captured by Snapshot, including the
+// size it had then. Restoring an early snapshot of a long run replays the
+// random draws made since the seed. OnComplete is not called again.
+func (p *PourEffect) Restore(snap PourSnapshot) {
+	if snap.width != p.width || snap.height != p.height {
+		p.buffer = newCellGrid(snap.width, snap.height)
+	}
+	p.width = snap.width
+	p.height = snap.height
+	p.phase = snap.phase
+	p.frameCount = snap.frameCount
+	p.holdFrameCount = snap.holdFrameCount
+	p.chars = append(p.chars[:0], snap.chars...)
+	p.groups = snap.groups
+	p.currentGroup = snap.currentGroup
+	p.currentInGroup = snap.currentInGroup
+	p.gapCounter = snap.gapCounter
+	p.alternateDir = snap.alternateDir
+	p.rngSource.seek(snap.rng)
+}
+
 // Reset restarts the animation from the beginning
 func (p *PourEffect) Reset() {
 	p.phase = "pouring"
@@ -1,9 +1,9 @@
 package animations
 
 import (
+	"io"
+	"math/rand"
 	"strings"
-
-	"github.com/charmbracelet/lipgloss/v2"
 )
 
 // PrintEffect creates a typewriter/printer effect for text
@@ -12,24 +12,32 @@ type PrintEffect struct {
 	height          int
 	text            string
 	lines           []string
-	currentLine     int
-	currentCol      int
-	revealed        []string
-	frameCounter    int // Frame-based timing instead of time.Duration
-	framesPerChar   int // Frames to wait before printing next character
+	order           []int // Line indices in the order they are printed
+	currentLine     int   // Position in order of the line being printed
+	currentCol      int   // Characters of the current line revealed so far
+	frameCounter    int   // Frame-based timing instead of time.Duration
+	frameCount      int   // Frames since the last reset
+	framesPerChar   int   // Frames to wait before printing next character
 	printSpeed      int
 	printHeadSymbol string
+	cursorBlink     bool // Blank the print head on alternate beats
+	blinkFrames     int  // Frames per blink beat
 	trailSymbols    []string
 	gradientStops   []string
-	phase           string // "printing", "complete", "holding"
+	phase           string // "printing", "complete", "holding", "erasing"
 	holdFrameCount  int
 	maxLineWidth    int
 	auto            bool // Auto-size canvas to fit text
 	display         bool // Display mode: complete once and hold
 	holdFrames      int  // Frames to hold before looping
+	reverse         bool // Erase the text backward after holding
+	lineOrder       string
+	charDirection   string
+
+	rng        *rand.Rand
+	completion completion
 
-	// Pre-allocated buffer for performance
-	buffer [][]string
+	cells [][]Cell // Frame grid reused between renders
 }
 
 // PrintConfig holds configuration for the print effect
@@ -40,11 +48,18 @@ type PrintConfig struct {
 	FramesPerChar   int // Frames to wait before printing next character (replaces CharDelay)
 	PrintSpeed      int // Characters to print per update cycle
 	PrintHeadSymbol string
+	CursorBlink     bool // Blink the print head like a live typing cursor
+	BlinkFrames     int  // Frames the print head stays shown, then hidden, while blinking (default 8)
 	TrailSymbols    []string
 	GradientStops   []string
-	Auto            bool // Auto-size canvas to fit text dimensions
-	Display         bool // Display mode: complete once and hold (true) or loop (false)
-	HoldFrames      int  // Frames to hold completed state before looping (default 100)
+	Auto            bool   // Auto-size canvas to fit text dimensions
+	Display         bool   // Display mode: complete once and hold (true) or loop (false)
+	HoldFrames      int    // Frames to hold completed state before looping (default 100)
+	Reverse         bool   // After holding, run the print head backward erasing the text before looping
+	LineOrder       string // "top", "bottom" or "random" (default: "top")
+	CharDirection   string // "ltr" or "rtl" (default: "ltr")
+	Seed            int64  // Random seed for reproducible output, 0 = seeded from the clock
+	OnComplete      func() // Called once, the first time the effect finishes
 }
 
 // calculatePrintTextDimensions calculates the dimensions needed to display text
@@ -90,20 +105,32 @@ func NewPrintEffect(config PrintConfig) *PrintEffect {
 	}
 
 	printHeadSymbol := config.PrintHeadSymbol
-	if printHeadSymbol == "" {
+	if !validSymbol(printHeadSymbol) {
 		printHeadSymbol = "█"
 	}
 
-	trailSymbols := config.TrailSymbols
-	if len(trailSymbols) == 0 {
-		trailSymbols = []string{"░", "▒", "▓"}
+	blinkFrames := config.BlinkFrames
+	if blinkFrames <= 0 {
+		blinkFrames = 8
 	}
 
+	trailSymbols := validSymbols(config.TrailSymbols, []string{"░", "▒", "▓"})
+
 	gradientStops := config.GradientStops
 	if len(gradientStops) == 0 {
 		gradientStops = []string{"#ffffff"}
 	}
 
+	lineOrder := config.LineOrder
+	if lineOrder == "" {
+		lineOrder = "top"
+	}
+
+	charDirection := config.CharDirection
+	if charDirection == "" {
+		charDirection = "ltr"
+	}
+
 	// Calculate max line width for proper ASCII art alignment
 	maxLineWidth := 0
 	for _, line := range lines {
@@ -113,12 +140,6 @@ func NewPrintEffect(config PrintConfig) *PrintEffect {
 		}
 	}
 
-	// Pre-allocate buffer for performance
-	buffer := make([][]string, height)
-	for i := range buffer {
-		buffer[i] = make([]string, width)
-	}
-
 	effect := &PrintEffect{
 		width:           width,
 		height:          height,
@@ -126,11 +147,12 @@ func NewPrintEffect(config PrintConfig) *PrintEffect {
 		lines:           lines,
 		currentLine:     0,
 		currentCol:      0,
-		revealed:        []string{},
 		frameCounter:    0,
 		framesPerChar:   framesPerChar,
 		printSpeed:      printSpeed,
 		printHeadSymbol: printHeadSymbol,
+		cursorBlink:     config.CursorBlink,
+		blinkFrames:     blinkFrames,
 		trailSymbols:    trailSymbols,
 		gradientStops:   gradientStops,
 		phase:           "printing",
@@ -139,14 +161,20 @@ func NewPrintEffect(config PrintConfig) *PrintEffect {
 		auto:            config.Auto,
 		display:         config.Display,
 		holdFrames:      holdFrames,
-		buffer:          buffer,
+		reverse:         config.Reverse,
+		lineOrder:       lineOrder,
+		charDirection:   charDirection,
+		rng:             newRNG(config.Seed),
+		completion:      completion{callback: config.OnComplete},
 	}
 
+	effect.order = effect.makeLineOrder()
 	return effect
 }
 
 // Update advances the print effect animation
 func (p *PrintEffect) Update() {
+	p.frameCount++
 	p.frameCounter++
 
 	switch p.phase {
@@ -156,22 +184,24 @@ func (p *PrintEffect) Update() {
 		p.updateCompletePhase()
 	case "holding":
 		p.updateHoldingPhase()
+	case "erasing":
+		p.updateErasingPhase()
 	}
 }
 
 // updatePrintingPhase handles the main printing animation
 func (p *PrintEffect) updatePrintingPhase() {
 	// Check if animation is complete
-	if p.currentLine >= len(p.lines) {
+	if p.currentLine >= len(p.order) {
 		p.phase = "complete"
+		p.completion.fire()
 		p.frameCounter = 0
 		return
 	}
 
 	// Check if enough frames have passed to print next character(s)
 	if p.frameCounter >= p.framesPerChar {
-		currentLineText := p.lines[p.currentLine]
-		runes := []rune(currentLineText)
+		runes := []rune(p.lines[p.order[p.currentLine]])
 
 		// Print multiple characters based on printSpeed
 		for i := 0; i < p.printSpeed && p.currentCol < len(runes); i++ {
@@ -180,7 +210,6 @@ func (p *PrintEffect) updatePrintingPhase() {
 
 		// Check if line is complete
 		if p.currentCol >= len(runes) {
-			p.revealed = append(p.revealed, currentLineText)
 			p.currentLine++
 			p.currentCol = 0
 		}
@@ -205,21 +234,66 @@ func (p *PrintEffect) updateHoldingPhase() {
 		return
 	}
 
-	// In loop mode, reset after hold period
+	// In loop mode, erase or reset after hold period
 	if p.holdFrameCount >= p.holdFrames {
+		if p.reverse {
+			p.startErasing()
+			return
+		}
 		p.Reset()
 	}
 }
 
-// Render converts the print effect to text output
-// Render returns the current state of the print effect with colors
-func (p *PrintEffect) Render() string {
-	// Clear pre-allocated buffer
-	for i := range p.buffer {
-		for j := range p.buffer[i] {
-			p.buffer[i][j] = " "
+// startErasing puts the print head at the end of the last printed line
+func (p *PrintEffect) startErasing() {
+	p.phase = "erasing"
+	p.currentLine = len(p.order) - 1
+	p.currentCol = len([]rune(p.lines[p.order[p.currentLine]]))
+	p.frameCounter = 0
+}
+
+// updateErasingPhase runs the print head backward, removing characters
+func (p *PrintEffect) updateErasingPhase() {
+	// Check if everything has been erased
+	if p.currentLine < 0 {
+		p.Reset()
+		return
+	}
+
+	if p.frameCounter >= p.framesPerChar {
+		// Erase multiple characters based on printSpeed
+		for i := 0; i < p.printSpeed && p.currentCol > 0; i++ {
+			p.currentCol--
+		}
+
+		// Move up to the end of the previous line
+		if p.currentCol == 0 {
+			p.currentLine--
+			if p.currentLine >= 0 {
+				p.currentCol = len([]rune(p.lines[p.order[p.currentLine]]))
+			}
 		}
+
+		p.frameCounter = 0
 	}
+}
+
+// Stats reports the phase, frame count and which line is being printed
+func (p *PrintEffect) Stats() Stats {
+	return Stats{
+		Phase: p.phase,
+		Frame: p.frameCount,
+		Counts: map[string]int{
+			"lines":       len(p.lines),
+			"currentLine": p.currentLine,
+		},
+	}
+}
+
+// RenderCells returns the current state of the print effect as a grid of
+// runes and colors
+func (p *PrintEffect) RenderCells() [][]Cell {
+	p.cells = resetCellGrid(p.cells, p.width, p.height)
 
 	// Calculate centered starting position
 	startY := (p.height - len(p.lines)) / 2
@@ -233,91 +307,120 @@ func (p *PrintEffect) Render() string {
 		baseStartX = 0
 	}
 
-	// Render revealed lines and current line being printed
-	for lineIdx := 0; lineIdx < len(p.revealed); lineIdx++ {
+	for step, lineIdx := range p.order {
 		y := startY + lineIdx
 		if y >= p.height {
-			break
+			continue
 		}
 
-		line := p.revealed[lineIdx]
-		// All lines start at the same X position for proper ASCII art alignment
-		startX := baseStartX
+		runes := []rune(p.lines[lineIdx])
 
-		// Convert to runes to get proper character indices (not byte indices)
-		runes := []rune(line)
-		for charIdx := 0; charIdx < len(runes); charIdx++ {
-			x := startX + charIdx
+		// Lines before the current one are fully printed, later ones not yet
+		count := 0
+		if step < p.currentLine {
+			count = len(runes)
+		} else if step == p.currentLine {
+			count = min(p.currentCol, len(runes))
+		}
+
+		// Right-to-left lines reveal their last characters first
+		first := 0
+		if p.charDirection == "rtl" {
+			first = len(runes) - count
+		}
+
+		// All lines start at the same X position for proper ASCII art alignment
+		for charIdx := first; charIdx < first+count; charIdx++ {
+			x := baseStartX + charIdx
 			if x >= p.width {
 				break
 			}
 
 			// Calculate gradient color
 			color := p.getGradientColor(float64(charIdx) / float64(len(runes)))
-			style := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
-			p.buffer[y][x] = style.Render(string(runes[charIdx]))
+			p.cells[y][x] = Cell{Rune: runes[charIdx], Color: color}
+		}
+
+		if step == p.currentLine && (p.phase == "printing" || p.phase == "erasing") {
+			p.renderHead(y, baseStartX, first, count)
 		}
 	}
 
-	// Render current line being printed
-	if p.currentLine < len(p.lines) {
-		y := startY + len(p.revealed)
-		if y < p.height {
-			currentLineText := p.lines[p.currentLine]
-			runes := []rune(currentLineText)
-
-			// All lines start at the same X position for proper ASCII art alignment
-			startX := baseStartX
-
-			// Render revealed portion of current line
-			if p.currentCol > 0 {
-				revealedRunes := runes[:min(p.currentCol, len(runes))]
-				for charIdx := 0; charIdx < len(revealedRunes); charIdx++ {
-					x := startX + charIdx
-					if x >= p.width {
-						break
-					}
-
-					color := p.getGradientColor(float64(charIdx) / float64(len(runes)))
-					style := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
-					p.buffer[y][x] = style.Render(string(revealedRunes[charIdx]))
-				}
-
-				// Add trail effect
-				trailX := startX + p.currentCol
-				for i, trailSymbol := range p.trailSymbols {
-					x := trailX + i
-					if x >= p.width {
-						break
-					}
-					p.buffer[y][x] = trailSymbol
-				}
-
-				// Add print head
-				headX := trailX + len(p.trailSymbols)
-				if headX < p.width {
-					p.buffer[y][headX] = p.printHeadSymbol
-				}
-			} else {
-				// Just starting - show trail and head at beginning
-				x := startX
-				if x < p.width && len(p.trailSymbols) > 0 {
-					p.buffer[y][x] = p.trailSymbols[0]
-					if x+1 < p.width {
-						p.buffer[y][x+1] = p.printHeadSymbol
-					}
-				}
-			}
+	return p.cells
+}
+
+// Render converts the print effect to text output with colors
+func (p *PrintEffect) Render() string {
+	return renderCells(p.RenderCells())
+}
+
+// WriteTo streams the print effect to w, see io.WriterTo
+func (p *PrintEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, p.RenderCells())
+}
+
+// renderHead draws the print head and trail next to the visible part of a line.
+// The head leads in the direction of travel: ahead of the trail while
+// printing, and right against the text while erasing.
+func (p *PrintEffect) renderHead(y, startX, first, count int) {
+	// edge is the first cell outside the text on the side being printed,
+	// side is the direction away from the text
+	edge, side := startX+first+count, 1
+	if p.charDirection == "rtl" {
+		edge, side = startX+first-1, -1
+	}
+
+	// Symbols take one cell, so only their first character is drawn
+	put := func(x int, symbol string) {
+		if x >= 0 && x < p.width && symbol != "" {
+			p.cells[y][x] = Cell{Rune: []rune(symbol)[0]}
 		}
 	}
 
-	// Convert buffer to string
-	var lines []string
-	for _, line := range p.buffer {
-		lines = append(lines, strings.Join(line, ""))
+	head := p.printHeadSymbol
+	if p.cursorBlink && (p.frameCount/p.blinkFrames)%2 == 1 {
+		head = " " // Off beat of the blinking cursor
 	}
 
-	return strings.Join(lines, "\n")
+	trailLen := len(p.trailSymbols)
+	if p.phase == "erasing" {
+		put(edge, head)
+		for i := 0; i < trailLen; i++ {
+			put(edge+side*(i+1), p.trailSymbols[trailLen-1-i])
+		}
+		return
+	}
+
+	// Just starting a line - only the first trail symbol fits behind the head
+	if count == 0 && trailLen > 0 {
+		trailLen = 1
+	}
+
+	for i := 0; i < trailLen; i++ {
+		put(edge+side*i, p.trailSymbols[i])
+	}
+	put(edge+side*trailLen, head)
+}
+
+// makeLineOrder returns the line indices in the configured print order
+func (p *PrintEffect) makeLineOrder() []int {
+	order := make([]int, len(p.lines))
+	for i := range order {
+		order[i] = i
+	}
+
+	switch p.lineOrder {
+	case "bottom":
+		for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+			order[i], order[j] = order[j], order[i]
+		}
+	case "random":
+		p.rng.Shuffle(len(order), func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+	}
+
+	return order
 }
 
 // Helper to get gradient color for position
@@ -354,10 +457,11 @@ func (p *PrintEffect) Reset() {
 	// Don't remove empty lines - they might be part of ASCII art structure
 
 	p.lines = lines
+	p.order = p.makeLineOrder()
 	p.currentLine = 0
 	p.currentCol = 0
-	p.revealed = []string{}
 	p.frameCounter = 0
+	p.frameCount = 0
 	p.phase = "printing"
 	p.holdFrameCount = 0
 }
@@ -367,12 +471,6 @@ func (p *PrintEffect) Resize(width, height int) {
 	p.width = width
 	p.height = height
 
-	// Re-allocate buffer for new dimensions
-	p.buffer = make([][]string, height)
-	for i := range p.buffer {
-		p.buffer[i] = make([]string, width)
-	}
-
 	// Recalculate max line width for centering
 	maxLineWidth := 0
 	for _, line := range p.lines {
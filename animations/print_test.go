@@ -0,0 +1,22 @@
+package animations
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrintEffect_CursorBlink(t *testing.T) {
+	p := NewPrintEffect(PrintConfig{
+		Width: 30, Height: 3, Text: "HELLO", FramesPerChar: 100,
+		PrintHeadSymbol: "█", TrailSymbols: []string{"░"}, CursorBlink: true, BlinkFrames: 2, Seed: 1,
+	})
+
+	// Frames 1 and 4-5 show the head, frames 2-3 hide it
+	want := []bool{true, false, false, true, true, false}
+	for i, shown := range want {
+		p.Update()
+		if got := strings.Contains(p.Render(), "█"); got != shown {
+			t.Errorf("frame %d: head shown = %v, want %v", i+1, got, shown)
+		}
+	}
+}
@@ -1,12 +1,21 @@
 package animations
 
 import (
+	"io"
+	"math"
 	"math/rand"
-	"strings"
+)
 
-	"github.com/charmbracelet/lipgloss/v2"
+// Splash and puddle tuning for RainConfig.Splash
+const (
+	rainSplashFrames = 3     // Frames a splash lasts, one glyph each
+	rainPuddleFill   = 0.25  // Puddle depth added by each drop landing in a column
+	rainPuddleDry    = 0.004 // Puddle depth lost per frame
 )
 
+// rainSplashGlyphs are drawn over a splash's lifetime, from impact to the last droplets
+var rainSplashGlyphs = [rainSplashFrames]rune{'v', '^', '.'}
+
 // RainEffect implements ASCII character rain animation
 type RainEffect struct {
 	width    int      // Terminal width
@@ -15,6 +24,17 @@ type RainEffect struct {
 	chars    []rune   // Raindrop characters
 	drops    []RainDrop
 	maxDrops int // Maximum number of simultaneous drops
+
+	density    float64    // Fraction of columns with a drop at start
+	speedRange [2]float64 // Drop speed range in cells per frame, zero for the default
+
+	splash   bool         // Drops splash on the bottom row and fill a puddle
+	splashes []rainSplash // Splashes still showing
+	puddle   []float64    // Puddle depth per column, 0 (dry) to 1
+
+	frame int // Frames since the last reset
+	rng   *rand.Rand
+	buf   frameBuffer // Canvas reused between frames
 }
 
 // RainDrop represents a single falling character
@@ -24,34 +44,96 @@ type RainDrop struct {
 	Speed int    // Falling speed
 	Char  rune   // Character to display
 	Color string // Color hex code
+
+	velocity float64 // Cells per frame
+	offset   float64 // Progress toward the next cell
+}
+
+// rainSplash is a short-lived splash where a drop hit the bottom row
+type rainSplash struct {
+	x     int
+	age   int // Frames since impact
+	color string
+}
+
+// RainConfig holds configuration for the rain effect
+type RainConfig struct {
+	Width      int
+	Height     int
+	Palette    []string
+	Density    float64    // Fraction of columns with a drop at start, max drops scale with it (default 1/3)
+	SpeedRange [2]float64 // Min and max drop speed in cells per frame (default 1 to 3)
+	Seed       int64      // Random seed for reproducible output, 0 = seeded from the clock
+	Splash     bool       // Drops splash when they land and collect in a shimmering puddle on the bottom row
 }
 
 // NewRainEffect creates a new rain effect with given dimensions and theme palette
 func NewRainEffect(width, height int, palette []string) *RainEffect {
+	return NewRainEffectWithConfig(RainConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+	})
+}
+
+// NewRainEffectWithConfig creates a new rain effect with given configuration
+func NewRainEffectWithConfig(config RainConfig) *RainEffect {
+	density := config.Density
+	if density <= 0 {
+		density = 1.0 / 3
+	}
+
 	r := &RainEffect{
-		width:    width,
-		height:   height,
-		palette:  palette,
-		chars:    []rune{'|', '⋮', '║', '¦', '┆', '┊', '╎', '╏', '▏', '▎', '▍', '▌', '▋', '▊', '▉'},
-		drops:    make([]RainDrop, 0, 200),
-		maxDrops: width * 2, // More drops for wider terminals
+		width:      config.Width,
+		height:     config.Height,
+		palette:    config.Palette,
+		chars:      []rune{'|', '⋮', '║', '¦', '┆', '┊', '╎', '╏', '▏', '▎', '▍', '▌', '▋', '▊', '▉'},
+		drops:      make([]RainDrop, 0, 200),
+		density:    density,
+		speedRange: validSpeedRange(config.SpeedRange),
+		splash:     config.Splash,
+		rng:        newRNG(config.Seed),
 	}
+	r.maxDrops = r.calculateMaxDrops()
+	r.puddle = make([]float64, max(r.width, 0))
 	r.init()
 	return r
 }
 
+// calculateMaxDrops scales the drop limit with width and density,
+// width*2 at the default density
+func (r *RainEffect) calculateMaxDrops() int {
+	return int(float64(r.width) * r.density * 6)
+}
+
+// newDrop creates a drop at a random column with a random speed
+func (r *RainEffect) newDrop(y int) RainDrop {
+	drop := RainDrop{
+		X:     r.rng.Intn(r.width),
+		Y:     y,
+		Char:  r.chars[r.rng.Intn(len(r.chars))],
+		Color: r.getRandomColor(),
+	}
+	r.setSpeed(&drop)
+	return drop
+}
+
+// setSpeed picks a new random speed for a drop
+func (r *RainEffect) setSpeed(drop *RainDrop) {
+	if r.speedRange == [2]float64{} {
+		drop.Speed = r.rng.Intn(3) + 1 // Speed 1-3
+		drop.velocity = float64(drop.Speed)
+		return
+	}
+	drop.velocity = randomInRange(r.rng, r.speedRange)
+	drop.Speed = int(math.Round(drop.velocity))
+}
+
 // Initialize rain effect with some initial drops
 func (r *RainEffect) init() {
 	// Create initial drops scattered across width
-	for i := 0; i < r.width/3; i++ {
-		drop := RainDrop{
-			X:     rand.Intn(r.width),
-			Y:     -rand.Intn(r.height), // Start above screen
-			Speed: rand.Intn(3) + 1,     // Speed 1-3
-			Char:  r.chars[rand.Intn(len(r.chars))],
-			Color: r.getRandomColor(),
-		}
-		r.drops = append(r.drops, drop)
+	for i := 0; i < int(float64(r.width)*r.density); i++ {
+		r.drops = append(r.drops, r.newDrop(-r.rng.Intn(r.height))) // Start above screen
 	}
 }
 
@@ -64,7 +146,9 @@ func (r *RainEffect) UpdatePalette(palette []string) {
 func (r *RainEffect) Resize(width, height int) {
 	r.width = width
 	r.height = height
-	r.maxDrops = width * 2
+	r.maxDrops = r.calculateMaxDrops()
+	r.puddle = make([]float64, max(width, 0))
+	r.splashes = r.splashes[:0]
 	r.init()
 }
 
@@ -73,24 +157,32 @@ func (r *RainEffect) getRandomColor() string {
 	if len(r.palette) == 0 {
 		return "#00aaff" // Default blue if no palette
 	}
-	return r.palette[rand.Intn(len(r.palette))]
+	return r.palette[r.rng.Intn(len(r.palette))]
 }
 
 // Update advances the rain simulation by one frame
 func (r *RainEffect) Update() {
+	r.frame++
+	if r.splash {
+		r.updateSplashes()
+	}
+
 	// Update existing drops
 	activeDrops := r.drops[:0] // Reuse slice for efficiency
 	for _, drop := range r.drops {
-		// Move drop downward
-		drop.Y += drop.Speed
-
-		// Reset drop when it reaches bottom
-		if drop.Y >= r.height {
-			drop.Y = -rand.Intn(10) // Start above screen
-			drop.X = rand.Intn(r.width)
-			drop.Speed = rand.Intn(3) + 1 // Speed 1-3
-			drop.Char = r.chars[rand.Intn(len(r.chars))]
-			drop.Color = r.getRandomColor()
+		// Move drop downward by whole cells as its velocity accumulates
+		drop.offset += drop.velocity
+		moved := int(drop.offset)
+		drop.Y += moved
+		drop.offset -= float64(moved)
+
+		// Reset drop when it reaches bottom; splashing drops land on the
+		// bottom row, which the puddle occupies
+		if r.splash && drop.Y >= r.height-1 {
+			r.land(drop)
+			drop = r.newDrop(-r.rng.Intn(10)) // Start above screen
+		} else if drop.Y >= r.height {
+			drop = r.newDrop(-r.rng.Intn(10)) // Start above screen
 		}
 
 		activeDrops = append(activeDrops, drop)
@@ -98,29 +190,104 @@ func (r *RainEffect) Update() {
 	r.drops = activeDrops
 
 	// Add new drops randomly
-	for len(r.drops) < r.maxDrops && rand.Float64() < 0.3 {
-		drop := RainDrop{
-			X:     rand.Intn(r.width),
-			Y:     -rand.Intn(10),   // Start above screen
-			Speed: rand.Intn(3) + 1, // Speed 1-3
-			Char:  r.chars[rand.Intn(len(r.chars))],
-			Color: r.getRandomColor(),
+	for len(r.drops) < r.maxDrops && r.rng.Float64() < 0.3 {
+		r.drops = append(r.drops, r.newDrop(-r.rng.Intn(10))) // Start above screen
+	}
+}
+
+// land starts a splash where a drop hit the bottom row and deepens the puddle there
+func (r *RainEffect) land(drop RainDrop) {
+	if drop.X < 0 || drop.X >= len(r.puddle) {
+		return
+	}
+	r.splashes = append(r.splashes, rainSplash{x: drop.X, color: drop.Color})
+	r.puddle[drop.X] = math.Min(1, r.puddle[drop.X]+rainPuddleFill)
+}
+
+// updateSplashes ages splashes, dropping finished ones, and slowly dries the puddle
+func (r *RainEffect) updateSplashes() {
+	active := r.splashes[:0]
+	for _, splash := range r.splashes {
+		splash.age++
+		if splash.age < rainSplashFrames {
+			active = append(active, splash)
 		}
-		r.drops = append(r.drops, drop)
+	}
+	r.splashes = active
+
+	for x := range r.puddle {
+		r.puddle[x] = math.Max(0, r.puddle[x]-rainPuddleDry)
 	}
 }
 
-// Render converts the rain drops to colored text output
-func (r *RainEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, r.height)
-	colors := make([][]string, r.height)
-	for i := range canvas {
-		canvas[i] = make([]rune, r.width)
-		colors[i] = make([]string, r.width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
-			colors[i][j] = ""
+// puddleCell returns the glyph and color of the puddle in a column, or false
+// where it is dry. Ripples drift along the surface so the puddle shimmers.
+func (r *RainEffect) puddleCell(x int) (rune, string, bool) {
+	depth := r.puddle[x]
+	if depth <= 0 {
+		return 0, "", false
+	}
+
+	ripple := math.Sin(float64(x)*0.8 + float64(r.frame)*0.35)
+	char := '_'
+	if depth > 0.3 {
+		char = '-'
+		if ripple > 0.3 {
+			char = '~'
+		}
+	}
+	color := blendColor("#000000", r.getPuddleColor(x), 0.35+0.4*depth+0.25*ripple*depth)
+	return char, color, true
+}
+
+// getPuddleColor picks a palette color for a column of the puddle, slowly
+// shifting along it over time
+func (r *RainEffect) getPuddleColor(x int) string {
+	if len(r.palette) == 0 {
+		return "#00aaff"
+	}
+	return r.palette[(x+r.frame/6)%len(r.palette)]
+}
+
+// Stats reports the frame count and how many drops are falling
+func (r *RainEffect) Stats() Stats {
+	counts := map[string]int{"drops": len(r.drops)}
+	if r.splash {
+		counts["splashes"] = len(r.splashes)
+	}
+	return Stats{Frame: r.frame, Counts: counts}
+}
+
+// RenderCells draws the rain drops as a grid of runes and colors
+func (r *RainEffect) RenderCells() [][]Cell {
+	canvas, colors := r.buf.clear(r.width, r.height)
+
+	// Puddle on the bottom row, with splashes just above it
+	if r.splash && r.height > 0 {
+		bottom := r.height - 1
+		for x := range r.puddle {
+			if x < r.width {
+				if char, color, ok := r.puddleCell(x); ok {
+					canvas[bottom][x] = char
+					colors[bottom][x] = color
+				}
+			}
+		}
+
+		row := max(bottom-1, 0)
+		for _, splash := range r.splashes {
+			char := rainSplashGlyphs[splash.age]
+			// The last droplets scatter to either side of the impact
+			xs := []int{splash.x}
+			if splash.age == rainSplashFrames-1 {
+				xs = []int{splash.x - 1, splash.x + 1}
+			}
+			for _, x := range xs {
+				if x >= 0 && x < r.width {
+					canvas[row][x] = char
+					colors[row][x] = splash.color
+				}
+			}
 		}
 	}
 
@@ -132,30 +299,24 @@ func (r *RainEffect) Render() string {
 		}
 	}
 
-	// Convert to colored string
-	var lines []string
-	for y := 0; y < r.height; y++ {
-		var line strings.Builder
-		for x := 0; x < r.width; x++ {
-			char := canvas[y][x]
-			if char != ' ' && colors[y][x] != "" {
-				// Render colored character
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(colors[y][x])).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
-			}
-		}
-		lines = append(lines, line.String())
-	}
+	return r.buf.toCells()
+}
+
+// Render converts the rain drops to colored text output
+func (r *RainEffect) Render() string {
+	return renderCells(r.RenderCells())
+}
 
-	return strings.Join(lines, "\n")
+// WriteTo streams the rain drops to w, see io.WriterTo
+func (r *RainEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, r.RenderCells())
 }
 
 // Reset restarts the animation from the beginning
 func (r *RainEffect) Reset() {
+	r.frame = 0
 	r.drops = r.drops[:0]
+	r.splashes = r.splashes[:0]
+	clear(r.puddle)
 	r.init()
 }
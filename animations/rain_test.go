@@ -0,0 +1,43 @@
+package animations
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// sgrCodes matches the color escapes in a rendered frame
+var sgrCodes = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+func TestRain_SplashAndPuddle(t *testing.T) {
+	r := NewRainEffectWithConfig(RainConfig{Width: 10, Height: 5, Palette: []string{"#00aaff"}, Splash: true, Seed: 1})
+
+	// Drop one straight onto the bottom row
+	r.drops = []RainDrop{{X: 4, Y: 3, Speed: 1, Char: '|', Color: "#00aaff", velocity: 1}}
+	r.maxDrops = 1
+	r.Update()
+	if len(r.splashes) != 1 || r.splashes[0].x != 4 {
+		t.Fatalf("splashes = %+v, want one at column 4", r.splashes)
+	}
+	if r.puddle[4] != rainPuddleFill {
+		t.Errorf("puddle depth %.2f, want %.2f", r.puddle[4], rainPuddleFill)
+	}
+
+	lines := strings.Split(sgrCodes.ReplaceAllString(r.Render(), ""), "\n")
+	if got := []rune(lines[3])[4]; got != 'v' {
+		t.Errorf("splash glyph %q, want 'v'", got)
+	}
+	if got := []rune(lines[4])[4]; got != '_' {
+		t.Errorf("puddle glyph %q, want '_'", got)
+	}
+
+	// Splashes fade after a few frames and the puddle dries up
+	r.drops = nil
+	r.maxDrops = 0
+	for i := 0; i < 100; i++ {
+		r.Update()
+	}
+	if len(r.splashes) != 0 || r.puddle[4] != 0 {
+		t.Errorf("splashes=%d puddle=%.3f after 100 frames, want none and dry", len(r.splashes), r.puddle[4])
+	}
+}
@@ -1,11 +1,9 @@
 package animations
 
 import (
+	"io"
 	"math/rand"
 	"strings"
-	"time"
-
-	"github.com/charmbracelet/lipgloss/v2"
 )
 
 // RainArtEffect implements rain animation that gradually forms ASCII art
@@ -27,6 +25,9 @@ type RainArtEffect struct {
 	artHeight    int
 	rng          *rand.Rand
 	freezeChance float64 // Probability a drop freezes when passing art position
+	frame        int     // Frames since the last reset
+	reveal       artReveal
+	buf          frameBuffer // Canvas reused between frames
 }
 
 // FrozenChar represents a rain character that has frozen to form the art
@@ -35,8 +36,36 @@ type FrozenChar struct {
 	color string
 }
 
+// RainArtConfig holds configuration for the rain-art effect
+type RainArtConfig struct {
+	Width   int
+	Height  int
+	Palette []string
+	Text    string
+	Seed    int64 // Random seed for reproducible output, 0 = seeded from the clock
+
+	// RevealFrames ends the rain: after this many frames the whole art
+	// appears, the drops drain away and the art fades into
+	// FinalGradientStops (top to bottom), holding for HoldFrames before Done
+	// reports true. 0 loops forever.
+	RevealFrames       int
+	HoldFrames         int
+	FinalGradientStops []string
+}
+
 // NewRainArtEffect creates a new rain-art effect
 func NewRainArtEffect(width, height int, palette []string, text string) *RainArtEffect {
+	return NewRainArtEffectWithConfig(RainArtConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+		Text:    text,
+	})
+}
+
+// NewRainArtEffectWithConfig creates a new rain-art effect with given configuration
+func NewRainArtEffectWithConfig(config RainArtConfig) *RainArtEffect {
+	width, height, palette, text := config.Width, config.Height, config.Palette, config.Text
 	r := &RainArtEffect{
 		width:        width,
 		height:       height,
@@ -47,8 +76,9 @@ func NewRainArtEffect(width, height int, palette []string, text string) *RainArt
 		text:         text,
 		artPositions: make(map[int]map[int]rune),
 		frozenChars:  make(map[int]map[int]*FrozenChar),
-		rng:          rand.New(rand.NewSource(time.Now().UnixNano())),
+		rng:          newRNG(config.Seed),
 		freezeChance: 0.90, // 90% chance to freeze when passing through art position (very fast crystallization)
+		reveal:       newArtReveal(config.RevealFrames, config.HoldFrames, config.FinalGradientStops),
 	}
 
 	r.parseArt()
@@ -118,6 +148,12 @@ func (r *RainArtEffect) getRandomColor() string {
 
 // Update advances the simulation by one frame
 func (r *RainArtEffect) Update() {
+	r.frame++
+	settling := r.reveal.settling(r.frame)
+	if settling {
+		r.freezeAll()
+	}
+
 	// Update existing drops
 	activeDrops := r.drops[:0]
 	for _, drop := range r.drops {
@@ -146,8 +182,12 @@ func (r *RainArtEffect) Update() {
 		// Move drop downward
 		drop.Y += drop.Speed
 
-		// Reset drop when it reaches bottom
+		// Reset drop when it reaches bottom, or let it drain away once
+		// the art is settling
 		if drop.Y >= r.height {
+			if settling {
+				continue
+			}
 			drop.Y = -r.rng.Intn(10)
 			drop.X = r.rng.Intn(r.width)
 			drop.Speed = r.rng.Intn(3) + 1
@@ -160,7 +200,7 @@ func (r *RainArtEffect) Update() {
 	r.drops = activeDrops
 
 	// Aggressively spawn new drops to maintain high density
-	for len(r.drops) < r.maxDrops && r.rng.Float64() < 0.5 {
+	for !settling && len(r.drops) < r.maxDrops && r.rng.Float64() < 0.5 {
 		drop := RainDrop{
 			X:     r.rng.Intn(r.width),
 			Y:     -r.rng.Intn(10),
@@ -172,19 +212,43 @@ func (r *RainArtEffect) Update() {
 	}
 }
 
-// Render converts the rain and frozen art to colored output
-func (r *RainArtEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, r.height)
-	colors := make([][]string, r.height)
-	for i := range canvas {
-		canvas[i] = make([]rune, r.width)
-		colors[i] = make([]string, r.width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
-			colors[i][j] = ""
+// freezeAll freezes every art character that hasn't crystallized yet
+func (r *RainArtEffect) freezeAll() {
+	for y, row := range r.artPositions {
+		for x, char := range row {
+			if r.frozenChars[y] == nil {
+				r.frozenChars[y] = make(map[int]*FrozenChar)
+			}
+			if r.frozenChars[y][x] == nil {
+				r.frozenChars[y][x] = &FrozenChar{char: char, color: r.getRandomColor()}
+			}
 		}
 	}
+}
+
+// Done reports whether the art has been revealed and held, always false without RevealFrames
+func (r *RainArtEffect) Done() bool {
+	return r.reveal.done(r.frame)
+}
+
+// Stats reports the frame count, falling drops and crystallized art characters
+func (r *RainArtEffect) Stats() Stats {
+	frozen := 0
+	for _, row := range r.frozenChars {
+		frozen += len(row)
+	}
+	return Stats{
+		Frame: r.frame,
+		Counts: map[string]int{
+			"drops":  len(r.drops),
+			"frozen": frozen,
+		},
+	}
+}
+
+// RenderCells draws the rain and frozen art as a grid of runes and colors
+func (r *RainArtEffect) RenderCells() [][]Cell {
+	canvas, colors := r.buf.clear(r.width, r.height)
 
 	// Place active rain drops on canvas
 	for _, drop := range r.drops {
@@ -199,33 +263,42 @@ func (r *RainArtEffect) Render() string {
 		for x, frozen := range row {
 			if y >= 0 && y < r.height && x >= 0 && x < r.width {
 				canvas[y][x] = frozen.char
-				colors[y][x] = frozen.color
+				colors[y][x] = r.reveal.color(frozen.color, r.frame, y-r.centerY, r.artHeight)
 			}
 		}
 	}
 
-	// Convert to colored string
-	var lines []string
-	for y := 0; y < r.height; y++ {
-		var line strings.Builder
-		for x := 0; x < r.width; x++ {
-			char := canvas[y][x]
-			if char != ' ' && colors[y][x] != "" {
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(colors[y][x])).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
-			}
-		}
-		lines = append(lines, line.String())
-	}
+	return r.buf.toCells()
+}
 
-	return strings.Join(lines, "\n")
+// Render converts the rain and frozen art to colored output
+func (r *RainArtEffect) Render() string {
+	return renderCells(r.RenderCells())
+}
+
+// WriteTo streams the rain and frozen art to w, see io.WriterTo
+func (r *RainArtEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, r.RenderCells())
 }
 
 // Reset clears frozen characters to restart the formation
 func (r *RainArtEffect) Reset() {
+	if r.reveal.settling(r.frame) {
+		// The reveal drained the drops, so start a fresh set
+		r.drops = r.drops[:0]
+		r.init()
+	}
+	r.frame = 0
+	r.frozenChars = make(map[int]map[int]*FrozenChar)
+}
+
+// Resize re-centers the art and restarts the formation with new dimensions
+func (r *RainArtEffect) Resize(width, height int) {
+	r.width = width
+	r.height = height
+	r.drops = r.drops[:0]
+	r.artPositions = make(map[int]map[int]rune)
 	r.frozenChars = make(map[int]map[int]*FrozenChar)
+	r.parseArt()
+	r.init()
 }
@@ -10,119 +10,175 @@ const (
 
 // EffectMetadata describes an animation effect
 type EffectMetadata struct {
-	Name         string // Effect name (e.g., "fire", "matrix")
-	RequiresText bool   // Whether effect requires text input
-	Description  string // Brief description
-	VersionAdded string // Version when effect was added
-	Category     string // Effect category (e.g., "particle", "text", "abstract")
+	Name            string // Effect name (e.g., "fire", "matrix")
+	RequiresText    bool   // Whether effect requires text input
+	Description     string // Brief description
+	VersionAdded    string // Version when effect was added
+	Category        string // Effect category (e.g., "particle", "text", "abstract")
+	DefaultDuration int    // Seconds the CLI runs the effect without -duration, 0 = loop until stopped
 }
 
 // EffectRegistry contains metadata for all available effects
 var EffectRegistry = []EffectMetadata{
 	{
-		Name:         "matrix",
-		RequiresText: false,
-		Description:  "Classic Matrix digital rain effect",
-		VersionAdded: "1.0.0",
-		Category:     "particle",
+		Name:            "matrix",
+		RequiresText:    false,
+		Description:     "Classic Matrix digital rain effect",
+		VersionAdded:    "1.0.0",
+		Category:        "particle",
+		DefaultDuration: 0,
 	},
 	{
-		Name:         "matrix-art",
-		RequiresText: true,
-		Description:  "Matrix rain revealing ASCII art",
-		VersionAdded: "1.0.0",
-		Category:     "text",
+		Name:            "matrix-art",
+		RequiresText:    true,
+		Description:     "Matrix rain revealing ASCII art",
+		VersionAdded:    "1.0.0",
+		Category:        "text",
+		DefaultDuration: 20,
 	},
 	{
-		Name:         "fire",
-		RequiresText: false,
-		Description:  "Doom-style fire effect",
-		VersionAdded: "1.0.0",
-		Category:     "particle",
+		Name:            "fire",
+		RequiresText:    false,
+		Description:     "Doom-style fire effect",
+		VersionAdded:    "1.0.0",
+		Category:        "particle",
+		DefaultDuration: 0,
 	},
 	{
-		Name:         "fire-text",
-		RequiresText: true,
-		Description:  "Fire effect with text as negative space",
-		VersionAdded: "1.0.1",
-		Category:     "text",
+		Name:            "fire-text",
+		RequiresText:    true,
+		Description:     "Fire effect with text as negative space",
+		VersionAdded:    "1.0.1",
+		Category:        "text",
+		DefaultDuration: 0,
 	},
 	{
-		Name:         "fireworks",
-		RequiresText: false,
-		Description:  "Animated fireworks display",
-		VersionAdded: "1.0.0",
-		Category:     "particle",
+		Name:            "fireworks",
+		RequiresText:    false,
+		Description:     "Animated fireworks display",
+		VersionAdded:    "1.0.0",
+		Category:        "particle",
+		DefaultDuration: 0,
 	},
 	{
-		Name:         "rain",
-		RequiresText: false,
-		Description:  "Falling rain droplets",
-		VersionAdded: "1.0.0",
-		Category:     "particle",
+		Name:            "rain",
+		RequiresText:    false,
+		Description:     "Falling rain droplets",
+		VersionAdded:    "1.0.0",
+		Category:        "particle",
+		DefaultDuration: 0,
 	},
 	{
-		Name:         "rain-art",
-		RequiresText: true,
-		Description:  "Rain revealing ASCII art",
-		VersionAdded: "1.0.0",
-		Category:     "text",
+		Name:            "rain-art",
+		RequiresText:    true,
+		Description:     "Rain revealing ASCII art",
+		VersionAdded:    "1.0.0",
+		Category:        "text",
+		DefaultDuration: 15,
 	},
 	{
-		Name:         "beams",
-		RequiresText: false,
-		Description:  "Light beams crossing the screen",
-		VersionAdded: "1.0.0",
-		Category:     "abstract",
+		Name:            "beams",
+		RequiresText:    false,
+		Description:     "Light beams crossing the screen",
+		VersionAdded:    "1.0.0",
+		Category:        "abstract",
+		DefaultDuration: 0,
 	},
 	{
-		Name:         "beam-text",
-		RequiresText: true,
-		Description:  "Light beams revealing ASCII art",
-		VersionAdded: "1.0.0",
-		Category:     "text",
+		Name:            "beam-text",
+		RequiresText:    true,
+		Description:     "Light beams revealing ASCII art",
+		VersionAdded:    "1.0.0",
+		Category:        "text",
+		DefaultDuration: 10,
 	},
 	{
-		Name:         "ring-text",
-		RequiresText: true,
-		Description:  "ASCII art with rotating colored rings",
-		VersionAdded: "1.0.0",
-		Category:     "text",
+		Name:            "ring-text",
+		RequiresText:    true,
+		Description:     "ASCII art with rotating colored rings",
+		VersionAdded:    "1.0.0",
+		Category:        "text",
+		DefaultDuration: 15,
 	},
 	{
-		Name:         "blackhole",
-		RequiresText: true,
-		Description:  "Text consumed by an animated blackhole",
-		VersionAdded: "1.0.0",
-		Category:     "text",
+		Name:            "blackhole",
+		RequiresText:    true,
+		Description:     "Text consumed by an animated blackhole",
+		VersionAdded:    "1.0.0",
+		Category:        "text",
+		DefaultDuration: 15,
 	},
 	{
-		Name:         "aquarium",
-		RequiresText: false,
-		Description:  "Animated underwater scene with fish",
-		VersionAdded: "1.0.0",
-		Category:     "scene",
+		Name:            "aquarium",
+		RequiresText:    false,
+		Description:     "Animated underwater scene with fish",
+		VersionAdded:    "1.0.0",
+		Category:        "scene",
+		DefaultDuration: 0,
 	},
 	{
-		Name:         "pour",
-		RequiresText: true,
-		Description:  "Text pouring onto screen with color transition",
-		VersionAdded: "1.0.0",
-		Category:     "text",
+		Name:            "pour",
+		RequiresText:    true,
+		Description:     "Text pouring onto screen with color transition",
+		VersionAdded:    "1.0.0",
+		Category:        "text",
+		DefaultDuration: 10,
 	},
 	{
-		Name:         "print",
-		RequiresText: true,
-		Description:  "Typewriter-style text printing effect",
-		VersionAdded: "1.0.0",
-		Category:     "text",
+		Name:            "print",
+		RequiresText:    true,
+		Description:     "Typewriter-style text printing effect",
+		VersionAdded:    "1.0.0",
+		Category:        "text",
+		DefaultDuration: 15,
 	},
 	{
-		Name:         "decrypt",
-		RequiresText: true,
-		Description:  "Text decryption/reveal effect",
-		VersionAdded: "1.0.0",
-		Category:     "text",
+		Name:            "decrypt",
+		RequiresText:    true,
+		Description:     "Text decryption/reveal effect",
+		VersionAdded:    "1.0.0",
+		Category:        "text",
+		DefaultDuration: 15,
+	},
+	{
+		Name:            "life",
+		RequiresText:    false,
+		Description:     "Conway's Game of Life seeded from text or noise",
+		VersionAdded:    "1.0.2",
+		Category:        "abstract",
+		DefaultDuration: 0,
+	},
+	{
+		Name:            "starfield",
+		RequiresText:    false,
+		Description:     "Warp-speed flight through streaking stars",
+		VersionAdded:    "1.0.2",
+		Category:        "particle",
+		DefaultDuration: 0,
+	},
+	{
+		Name:            "dvd",
+		RequiresText:    true,
+		Description:     "ASCII art bouncing around like an idle DVD logo",
+		VersionAdded:    "1.0.2",
+		Category:        "text",
+		DefaultDuration: 0,
+	},
+	{
+		Name:            "boot",
+		RequiresText:    true,
+		Description:     "Text typed out like a scrolling system boot log",
+		VersionAdded:    "1.0.2",
+		Category:        "text",
+		DefaultDuration: 15,
+	},
+	{
+		Name:            "command",
+		RequiresText:    false,
+		Description:     "Output of a shell command scrolling by like a live status ticker",
+		VersionAdded:    "1.0.2",
+		Category:        "text",
+		DefaultDuration: 0,
 	},
 }
 
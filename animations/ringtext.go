@@ -1,12 +1,12 @@
 package animations
 
 import (
+	"io"
 	"math"
 	"math/rand"
 	"strings"
-	"time"
 
-	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/Nomadcxx/sysc-Go/animations/ease"
 )
 
 // GradientDirection specifies the direction of gradient application
@@ -31,11 +31,16 @@ type RingTextConfig struct {
 	DisperseDuration    int               // Frames to stay in dispersed state
 	SpinDisperseCycles  int               // Number of spin/disperse cycles before returning
 	TransitionFrames    int               // Frames for transitions between states
-	StaticFrames        int               // Frames to display static text initially
+	StaticFrames        int               // Frames to display static text initially (default 100, negative skips the intro)
+	IntroOnce           bool              // Show the static intro on the first loop only; later loops start moving at once
 	FinalGradientStops  []string          // Gradient for final text state
 	FinalGradientSteps  int               // Number of gradient steps
 	StaticGradientStops []string          // Gradient for static ASCII presentation
 	StaticGradientDir   GradientDirection // Direction of static gradient
+	Align               TextAlign         // Horizontal placement of the text (default: center)
+	Margin              int               // Columns kept clear at the aligned edge
+	Seed                int64             // Random seed for reproducible output, 0 = seeded from the clock
+	OnComplete          func()            // Called once, the first time the effect finishes
 }
 
 // RingTextEffect represents the multi-phase ring text animation
@@ -43,6 +48,8 @@ type RingTextEffect struct {
 	width  int
 	height int
 	text   string
+	align  TextAlign
+	margin int
 
 	// Ring configuration
 	ringColors         []string
@@ -53,6 +60,8 @@ type RingTextEffect struct {
 	spinDisperseCycles int
 	transitionFrames   int
 	staticFrames       int
+	introOnce          bool
+	looped             bool // Set once the animation has started over after holding
 
 	// Gradient configuration
 	finalGradientStops  []string
@@ -69,11 +78,14 @@ type RingTextEffect struct {
 	centerX    float64
 	centerY    float64
 	rng        *rand.Rand
+	completion completion
 	frameCount int
 
 	// Animation state
 	phase        string // "static", "transition_to_disperse", "disperse", "transition_to_spin", "spin", "return_to_text", "hold"
 	currentCycle int    // Current spin/disperse cycle
+
+	buf frameBuffer // Canvas reused between frames
 }
 
 // RingTextCharacter represents a single character in the animation
@@ -104,7 +116,7 @@ type Ring struct {
 
 // NewRingTextEffect creates a new RingText effect
 func NewRingTextEffect(config RingTextConfig) *RingTextEffect {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng := newRNG(config.Seed)
 
 	// Set defaults
 	if config.RingGap == 0 {
@@ -146,6 +158,8 @@ func NewRingTextEffect(config RingTextConfig) *RingTextEffect {
 		width:               config.Width,
 		height:              config.Height,
 		text:                config.Text,
+		align:               config.Align,
+		margin:              config.Margin,
 		ringColors:          config.RingColors,
 		ringGap:             config.RingGap,
 		spinSpeedRange:      config.SpinSpeedRange,
@@ -154,11 +168,13 @@ func NewRingTextEffect(config RingTextConfig) *RingTextEffect {
 		spinDisperseCycles:  config.SpinDisperseCycles,
 		transitionFrames:    config.TransitionFrames,
 		staticFrames:        config.StaticFrames,
+		introOnce:           config.IntroOnce,
 		finalGradientStops:  config.FinalGradientStops,
 		finalGradientSteps:  config.FinalGradientSteps,
 		staticGradientStops: config.StaticGradientStops,
 		staticGradientDir:   config.StaticGradientDir,
 		rng:                 rng,
+		completion:          completion{callback: config.OnComplete},
 		phase:               "static",
 		frameCount:          0,
 		currentCycle:        0,
@@ -169,16 +185,25 @@ func NewRingTextEffect(config RingTextConfig) *RingTextEffect {
 	return effect
 }
 
+// NewRingTextEffectChecked creates a new RingText effect, or returns an error
+// wrapping ErrTextTooLarge instead of cutting off text that doesn't fit
+func NewRingTextEffectChecked(config RingTextConfig) (*RingTextEffect, error) {
+	if err := checkTextFits(config.Text, config.Width, config.Height, config.Align, config.Margin); err != nil {
+		return nil, err
+	}
+	return NewRingTextEffect(config), nil
+}
+
 // init initializes the effect
 func (e *RingTextEffect) init() {
 	e.centerX = float64(e.width) / 2
 	e.centerY = float64(e.height) / 2
 
 	// Create gradient for final state
-	e.finalGradient = e.createGradient(e.finalGradientStops, e.finalGradientSteps)
+	e.finalGradient = NewGradient(e.finalGradientStops, e.finalGradientSteps).Colors()
 
 	// Create gradient for static ASCII presentation (higher resolution for smooth transitions)
-	e.staticGradient = e.createGradient(e.staticGradientStops, 100)
+	e.staticGradient = NewGradient(e.staticGradientStops, 100).Colors()
 
 	// Parse text and create characters
 	e.parseText()
@@ -189,7 +214,7 @@ func (e *RingTextEffect) init() {
 	// Create 8-step gradients for each ring (for transitions)
 	for i := range e.rings {
 		// Gradient from final color to ring color
-		e.ringGradients[i] = e.createGradient([]string{e.finalGradient[0], e.rings[i].color}, 8)
+		e.ringGradients[i] = NewGradient([]string{e.finalGradient[0], e.rings[i].color}, 8).Colors()
 	}
 
 	// Generate random disperse positions for all characters
@@ -210,18 +235,17 @@ func (e *RingTextEffect) parseText() {
 	e.chars = make([]RingTextCharacter, 0)
 
 	for lineIdx, line := range lines {
-		lineRunes := []rune(line)
-		lineLen := len(lineRunes)
+		// Calculate starting X position to align line horizontally;
+		// full-width characters take two columns, everything else one
+		nextX := e.align.startX(e.width, displayWidth(line), e.margin)
 
-		// Calculate starting X position to center line horizontally
-		startX := (e.width - lineLen) / 2
-
-		for charIdx, char := range lineRunes {
+		for _, char := range line {
+			x := nextX
+			nextX += runeWidth(char)
 			if char == ' ' || char == '\n' {
 				continue // Skip spaces and newlines
 			}
 
-			x := startX + charIdx
 			y := startY + lineIdx
 
 			character := RingTextCharacter{
@@ -320,7 +344,7 @@ func (e *RingTextEffect) Update() {
 
 	switch e.phase {
 	case "static":
-		if e.frameCount >= e.staticFrames {
+		if e.frameCount >= e.staticFrames || (e.introOnce && e.looped) {
 			e.phase = "swirl_to_rings"
 			e.frameCount = 0
 		}
@@ -372,7 +396,7 @@ func (e *RingTextEffect) Update() {
 			if progress < 0.25 {
 				// Expanding vortex: ASCII → outer circles
 				expandProgress := progress / 0.25
-				easedExpand := e.easeInOutCubic(expandProgress)
+				easedExpand := ease.InOutCubic(expandProgress)
 
 				// Radius expands from start to disperse
 				currentRadius = startRadius + (disperseRadius-startRadius)*easedExpand
@@ -404,7 +428,7 @@ func (e *RingTextEffect) Update() {
 			} else {
 				// Contracting vortex: final spiral to exact ring positions
 				tightenProgress := (progress - 0.75) / 0.25
-				easedTighten := e.easeInOutCubic(tightenProgress)
+				easedTighten := ease.InOutCubic(tightenProgress)
 
 				// Calculate where we were at 75% mark
 				radius75 := disperseRadius + (targetRadius-disperseRadius)*0.99 // Almost at target
@@ -488,7 +512,7 @@ func (e *RingTextEffect) Update() {
 		}
 
 		// Ease-in-out function for smooth transition
-		easedProgress := e.easeInOutCubic(progress)
+		easedProgress := ease.InOutCubic(progress)
 
 		for i := range e.chars {
 			ring := &e.rings[e.chars[i].ringIndex]
@@ -515,6 +539,7 @@ func (e *RingTextEffect) Update() {
 
 		if e.frameCount >= e.transitionFrames {
 			e.phase = "hold"
+			e.completion.fire()
 			e.frameCount = 0
 		}
 
@@ -522,23 +547,38 @@ func (e *RingTextEffect) Update() {
 		// Hold the final state for a bit before looping
 		if e.frameCount >= 60 {
 			e.Reset()
+			e.looped = true
 		}
 	}
 }
 
-// Render returns the current frame as a colored string
-func (e *RingTextEffect) Render() string {
-	// Create a 2D buffer for the screen
-	buffer := make([][]rune, e.height)
-	colors := make([][]string, e.height)
-	for i := range buffer {
-		buffer[i] = make([]rune, e.width)
-		colors[i] = make([]string, e.width)
-		for j := range buffer[i] {
-			buffer[i][j] = ' '
-			colors[i][j] = ""
-		}
+// Stats reports the phase, frame count and spin/disperse progress
+func (e *RingTextEffect) Stats() Stats {
+	return Stats{
+		Phase: e.phase,
+		Frame: e.frameCount,
+		Counts: map[string]int{
+			"characters": len(e.chars),
+			"rings":      len(e.rings),
+			"cycle":      e.currentCycle,
+		},
 	}
+}
+
+// Render converts the ring text effect to colored text output
+func (e *RingTextEffect) Render() string {
+	return renderCells(e.RenderCells())
+}
+
+// WriteTo streams the ring text effect to w, see io.WriterTo
+func (e *RingTextEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, e.RenderCells())
+}
+
+// RenderCells returns the current frame as a grid of runes and colors
+func (e *RingTextEffect) RenderCells() [][]Cell {
+	e.buf.clear(e.width, e.height)
+	cells := e.buf.toCells()
 
 	// Draw characters
 	for _, char := range e.chars {
@@ -550,33 +590,12 @@ func (e *RingTextEffect) Render() string {
 		y := int(math.Round(char.currentY))
 
 		// Bounds check
-		if x >= 0 && x < e.width && y >= 0 && y < e.height {
-			buffer[y][x] = char.original
-			colors[y][x] = char.currentColor
-		}
-	}
-
-	// Build output (line-by-line like other effects)
-	var lines []string
-	for y := 0; y < e.height; y++ {
-		var line strings.Builder
-		for x := 0; x < e.width; x++ {
-			char := buffer[y][x]
-			color := colors[y][x]
-
-			if color != "" && char != ' ' {
-				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(color)).
-					Render(string(char))
-				line.WriteString(styled)
-			} else {
-				line.WriteRune(char)
-			}
+		if y >= 0 && y < e.height {
+			putCell(cells[y], x, Cell{Rune: char.original, Color: char.currentColor})
 		}
-		lines = append(lines, line.String())
 	}
 
-	return strings.Join(lines, "\n")
+	return cells
 }
 
 // Reset restarts the animation
@@ -601,34 +620,12 @@ func (e *RingTextEffect) Reset() {
 	e.generateDispersePositions()
 }
 
-// createGradient creates a gradient between color stops
-func (e *RingTextEffect) createGradient(stops []string, steps int) []string {
-	if len(stops) == 0 {
-		return []string{"#ffffff"}
-	}
-	if len(stops) == 1 {
-		return []string{stops[0]}
-	}
-
-	gradient := make([]string, 0)
-	stepsPerSegment := steps / (len(stops) - 1)
-
-	for i := 0; i < len(stops)-1; i++ {
-		startColor := parseHexColor(stops[i])
-		endColor := parseHexColor(stops[i+1])
-
-		for j := 0; j < stepsPerSegment; j++ {
-			t := float64(j) / float64(stepsPerSegment)
-			r := uint8(float64(startColor[0]) + (float64(endColor[0])-float64(startColor[0]))*t)
-			g := uint8(float64(startColor[1]) + (float64(endColor[1])-float64(startColor[1]))*t)
-			b := uint8(float64(startColor[2]) + (float64(endColor[2])-float64(startColor[2]))*t)
-			gradient = append(gradient, formatHexColor([3]uint8{r, g, b}))
-		}
-	}
-
-	// Add final color
-	gradient = append(gradient, stops[len(stops)-1])
-	return gradient
+// Resize reinitializes the ring text effect with new dimensions
+func (e *RingTextEffect) Resize(width, height int) {
+	e.width = width
+	e.height = height
+	e.init()
+	e.Reset()
 }
 
 // applyStaticGradient applies theme-sensitive gradient to static ASCII presentation
@@ -716,11 +713,3 @@ func (e *RingTextEffect) applyStaticGradient() {
 		e.chars[i].currentColor = e.staticGradient[gradientIndex]
 	}
 }
-
-// easeInOutCubic applies an ease-in-out cubic easing function
-func (e *RingTextEffect) easeInOutCubic(t float64) float64 {
-	if t < 0.5 {
-		return 4 * t * t * t
-	}
-	return 1 - math.Pow(-2*t+2, 3)/2
-}
@@ -0,0 +1,185 @@
+package animations
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// newTestRingText creates a small seeded ring text effect with short phases
+func newTestRingText() *RingTextEffect {
+	return NewRingTextEffect(RingTextConfig{
+		Width:              30,
+		Height:             12,
+		Text:               "RING\nTEXT",
+		StaticFrames:       2,
+		DisperseDuration:   4,
+		TransitionFrames:   4,
+		SpinDuration:       6,
+		SpinDisperseCycles: 1,
+		Seed:               1,
+	})
+}
+
+// cellsToText renders a cell grid as plain text, one line per row
+func cellsToText(cells [][]Cell) string {
+	var out strings.Builder
+	for _, row := range cells {
+		for _, cell := range row {
+			out.WriteRune(cell.Rune)
+		}
+		out.WriteString("|\n")
+	}
+	return out.String()
+}
+
+// checkGolden compares got against testdata/name.golden, rewriting it with -update
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -update to create it): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s mismatch\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+// advanceToPhase updates e until it enters phase and returns the number of frames taken
+func advanceToPhase(t *testing.T, e *RingTextEffect, phase string) int {
+	t.Helper()
+	for frames := 1; frames <= 1000; frames++ {
+		e.Update()
+		if e.phase == phase {
+			return frames
+		}
+	}
+	t.Fatalf("never reached phase %q, stuck in %q", phase, e.phase)
+	return 0
+}
+
+func TestRingTextEffect_PhaseTransitions(t *testing.T) {
+	e := newTestRingText()
+	if e.phase != "static" {
+		t.Fatalf("initial phase = %q, want static", e.phase)
+	}
+	checkGolden(t, "ringtext/static", cellsToText(e.RenderCells()))
+
+	transitions := []struct {
+		phase  string
+		frames int
+	}{
+		{"swirl_to_rings", 2}, // StaticFrames
+		{"spin", 12},          // DisperseDuration + 2*TransitionFrames
+		{"return_to_text", 6}, // SpinDuration
+		{"hold", 4},           // TransitionFrames
+	}
+	for _, tt := range transitions {
+		frames := advanceToPhase(t, e, tt.phase)
+		if frames != tt.frames {
+			t.Errorf("entering %s took %d frames, want %d", tt.phase, frames, tt.frames)
+		}
+		checkGolden(t, "ringtext/"+tt.phase, cellsToText(e.RenderCells()))
+	}
+}
+
+func TestRingTextEffect_IntroOnce(t *testing.T) {
+	e := newTestRingText()
+	e.introOnce = true
+	if frames := advanceToPhase(t, e, "swirl_to_rings"); frames != 2 {
+		t.Errorf("first intro took %d frames, want StaticFrames 2", frames)
+	}
+
+	// Holding for 60 frames loops back to static, which is then skipped
+	advanceToPhase(t, e, "hold")
+	advanceToPhase(t, e, "static")
+	if frames := advanceToPhase(t, e, "swirl_to_rings"); frames != 1 {
+		t.Errorf("looped intro took %d frames, want 1", frames)
+	}
+}
+
+func TestRingTextEffect_NegativeStaticFramesSkipsIntro(t *testing.T) {
+	e := NewRingTextEffect(RingTextConfig{Width: 30, Height: 12, Text: "RING", StaticFrames: -1, Seed: 1})
+	e.Update()
+	if e.phase != "swirl_to_rings" {
+		t.Errorf("phase after one frame = %q, want swirl_to_rings", e.phase)
+	}
+}
+
+func TestRingTextEffect_SwirlSubPhases(t *testing.T) {
+	e := newTestRingText()
+	advanceToPhase(t, e, "swirl_to_rings")
+
+	// The 12 frame swirl spends 3 frames expanding, 6 orbiting and 3 tightening
+	for frame := 1; frame <= 11; frame++ {
+		e.Update()
+		switch frame {
+		case 3:
+			checkGolden(t, "ringtext/swirl_expanded", cellsToText(e.RenderCells()))
+		case 9:
+			checkGolden(t, "ringtext/swirl_orbited", cellsToText(e.RenderCells()))
+		}
+	}
+	if e.phase != "swirl_to_rings" {
+		t.Fatalf("phase = %q after 11 swirl frames, want swirl_to_rings", e.phase)
+	}
+}
+
+func TestRingTextEffect_ReturnsToOriginalPositions(t *testing.T) {
+	e := newTestRingText()
+	want := cellsToText(e.RenderCells())
+	advanceToPhase(t, e, "hold")
+
+	for i, char := range e.chars {
+		if char.currentX != float64(char.x) || char.currentY != float64(char.y) {
+			t.Errorf("char %d (%q) ended at (%v, %v), want (%d, %d)",
+				i, char.original, char.currentX, char.currentY, char.x, char.y)
+		}
+	}
+	if got := cellsToText(e.RenderCells()); got != want {
+		t.Errorf("final frame differs from the original text\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRingTextEffect_SeedIsDeterministic(t *testing.T) {
+	a, b := newTestRingText(), newTestRingText()
+	for frame := 0; frame < 30; frame++ {
+		a.Update()
+		b.Update()
+		if got, want := cellsToText(a.RenderCells()), cellsToText(b.RenderCells()); got != want {
+			t.Fatalf("frame %d differs between effects with the same seed", frame)
+		}
+	}
+}
+
+func TestNewRingTextEffectChecked(t *testing.T) {
+	config := RingTextConfig{Width: 10, Height: 4, Text: "WIDE TEXT\nOK", Seed: 1}
+	if _, err := NewRingTextEffectChecked(config); err != nil {
+		t.Errorf("text that fits: %v", err)
+	}
+
+	config.Align, config.Margin = AlignLeft, 2
+	if _, err := NewRingTextEffectChecked(config); !errors.Is(err, ErrTextTooLarge) {
+		t.Errorf("text pushed off by the margin: err = %v, want ErrTextTooLarge", err)
+	}
+
+	config.Align, config.Height = AlignCenter, 1
+	if _, err := NewRingTextEffectChecked(config); !errors.Is(err, ErrTextTooLarge) {
+		t.Errorf("text taller than the canvas: err = %v, want ErrTextTooLarge", err)
+	}
+}
@@ -0,0 +1,92 @@
+package animations
+
+import "io"
+
+// ScaledEffect runs an effect at 1/scale of the canvas size in each direction
+// and draws every cell as a scale x scale block. Large terminals get the
+// effect for a fraction of the work, with a chunkier look.
+type ScaledEffect struct {
+	effect interface {
+		Update()
+		CellRenderer
+		Resizable
+	}
+	scale         int
+	width, height int
+	cells         [][]Cell // Upscaled grid reused between renders
+}
+
+// NewScaledEffect wraps effect and resizes it to the reduced size of a
+// width x height canvas. A scale below 2 draws the effect unchanged.
+func NewScaledEffect(effect interface {
+	Update()
+	CellRenderer
+	Resizable
+}, scale, width, height int) *ScaledEffect {
+	s := &ScaledEffect{effect: effect, scale: max(scale, 1)}
+	s.Resize(width, height)
+	return s
+}
+
+// Update advances the wrapped effect by one frame
+func (s *ScaledEffect) Update() {
+	s.effect.Update()
+}
+
+// Render converts the upscaled frame to colored text output
+func (s *ScaledEffect) Render() string {
+	return renderCells(s.RenderCells())
+}
+
+// WriteTo streams the upscaled frame to w, see io.WriterTo
+func (s *ScaledEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCells(w, s.RenderCells())
+}
+
+// RenderCells returns the wrapped effect's frame with every cell repeated
+// into a scale x scale block, cropped to the canvas
+func (s *ScaledEffect) RenderCells() [][]Cell {
+	small := s.effect.RenderCells()
+	s.cells = resetCellGrid(s.cells, s.width, s.height)
+	for y, row := range s.cells {
+		sy := y / s.scale
+		if sy >= len(small) {
+			break
+		}
+		for x := range row {
+			if sx := x / s.scale; sx < len(small[sy]) {
+				row[x] = small[sy][sx]
+			}
+		}
+	}
+	return s.cells
+}
+
+// Resize resizes the wrapped effect to cover a width x height canvas at the reduced size
+func (s *ScaledEffect) Resize(width, height int) {
+	s.width, s.height = width, height
+	s.effect.Resize((width+s.scale-1)/s.scale, (height+s.scale-1)/s.scale)
+}
+
+// Reset restarts the wrapped effect if it supports restarting
+func (s *ScaledEffect) Reset() {
+	if effect, ok := s.effect.(interface{ Reset() }); ok {
+		effect.Reset()
+	}
+}
+
+// Done reports whether the wrapped effect has finished, false for effects that never do
+func (s *ScaledEffect) Done() bool {
+	if effect, ok := s.effect.(interface{ Done() bool }); ok {
+		return effect.Done()
+	}
+	return false
+}
+
+// Stats returns the wrapped effect's Stats, or zero Stats if it doesn't report any
+func (s *ScaledEffect) Stats() Stats {
+	if effect, ok := s.effect.(StatsReporter); ok {
+		return effect.Stats()
+	}
+	return Stats{}
+}
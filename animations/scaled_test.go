@@ -0,0 +1,52 @@
+package animations
+
+import "testing"
+
+// checkerEffect draws a checkerboard of 'a' and 'b' at its current size
+type checkerEffect struct {
+	width, height int
+}
+
+func (c *checkerEffect) Update() {}
+
+func (c *checkerEffect) Resize(width, height int) {
+	c.width, c.height = width, height
+}
+
+func (c *checkerEffect) RenderCells() [][]Cell {
+	cells := newCellGrid(c.width, c.height)
+	for y := range cells {
+		for x := range cells[y] {
+			cells[y][x].Rune = 'a' + rune((x+y)%2)
+		}
+	}
+	return cells
+}
+
+func TestScaledEffect(t *testing.T) {
+	inner := &checkerEffect{}
+	scaled := NewScaledEffect(inner, 2, 5, 3)
+	if inner.width != 3 || inner.height != 2 {
+		t.Fatalf("inner effect is %dx%d, want 3x2", inner.width, inner.height)
+	}
+
+	want := []string{"aabba", "aabba", "bbaab"}
+	cells := scaled.RenderCells()
+	if len(cells) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(cells), len(want))
+	}
+	for y, row := range cells {
+		got := ""
+		for _, cell := range row {
+			got += string(cell.Rune)
+		}
+		if got != want[y] {
+			t.Errorf("row %d = %q, want %q", y, got, want[y])
+		}
+	}
+
+	scaled.Resize(8, 8)
+	if inner.width != 4 || inner.height != 4 {
+		t.Errorf("after resize the inner effect is %dx%d, want 4x4", inner.width, inner.height)
+	}
+}
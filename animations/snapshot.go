@@ -0,0 +1,62 @@
+package animations
+
+import (
+	"math/rand"
+	"time"
+)
+
+// rngState is a point in a seekableSource's sequence
+type rngState struct {
+	seed  int64
+	draws uint64
+}
+
+// seekableSource is a random source that counts its draws, so an effect can
+// snapshot where it is in the sequence and later seek back to it. math/rand
+// sources can't be copied, so seeking reseeds and replays the draws.
+type seekableSource struct {
+	src   rand.Source64
+	seed  int64
+	draws uint64
+}
+
+// newSeekableRNG returns a random source like newRNG together with the
+// seekable source behind it
+func newSeekableRNG(seed int64) (*rand.Rand, *seekableSource) {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	src := &seekableSource{src: rand.NewSource(seed).(rand.Source64), seed: seed}
+	return rand.New(src), src
+}
+
+func (s *seekableSource) Int63() int64 {
+	s.draws++
+	return s.src.Int63()
+}
+
+func (s *seekableSource) Uint64() uint64 {
+	s.draws++
+	return s.src.Uint64()
+}
+
+func (s *seekableSource) Seed(seed int64) {
+	s.src.Seed(seed)
+	s.seed = seed
+	s.draws = 0
+}
+
+// state returns the current point in the sequence
+func (s *seekableSource) state() rngState {
+	return rngState{seed: s.seed, draws: s.draws}
+}
+
+// seek moves the source to a point returned by state
+func (s *seekableSource) seek(state rngState) {
+	if state.seed != s.seed || state.draws < s.draws {
+		s.Seed(state.seed)
+	}
+	for s.draws < state.draws {
+		s.Uint64()
+	}
+}
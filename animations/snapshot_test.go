@@ -0,0 +1,51 @@
+package animations
+
+import "testing"
+
+// seekable is an effect whose state can be snapshot and restored
+type seekable[S any] interface {
+	Update()
+	Render() string
+	Snapshot() S
+	Restore(S)
+}
+
+// checkRestore runs an effect past a snapshot, restores it and checks that
+// the frames after the snapshot play out exactly the same again
+func checkRestore[S any](t *testing.T, effect seekable[S]) {
+	t.Helper()
+	for i := 0; i < 30; i++ {
+		effect.Update()
+	}
+	snap := effect.Snapshot()
+
+	var frames []string
+	for i := 0; i < 60; i++ {
+		effect.Update()
+		frames = append(frames, effect.Render())
+	}
+
+	effect.Restore(snap)
+	for i, want := range frames {
+		effect.Update()
+		if got := effect.Render(); got != want {
+			t.Fatalf("frame %d after restore differs from the original run", i)
+		}
+	}
+}
+
+func TestDecryptEffect_SnapshotRestore(t *testing.T) {
+	checkRestore[DecryptSnapshot](t, NewDecryptEffect(DecryptConfig{
+		Width: 30, Height: 5, Text: "SNAPSHOT\nRESTORE",
+		CiphertextColors: []string{"#00ff00", "#008800"}, FinalGradientStops: []string{"#ffffff"},
+		Seed: 7,
+	}))
+}
+
+func TestPourEffect_SnapshotRestore(t *testing.T) {
+	checkRestore[PourSnapshot](t, NewPourEffect(PourConfig{
+		Width: 30, Height: 10, Text: "SNAPSHOT\nRESTORE", PourSpeed: 1, MovementSpeed: 0.2, Gap: 1,
+		StartingColor: "#ffffff", FinalGradientStops: []string{"#ff0000", "#0000ff"}, FinalGradientSteps: 8,
+		FinalGradientFrames: 2, Jitter: 0.5, Seed: 7,
+	}))
+}
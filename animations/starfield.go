@@ -0,0 +1,219 @@
+package animations
+
+import (
+	"cmp"
+	"io"
+	"math"
+	"math/rand"
+	"slices"
+)
+
+const (
+	starfieldShades    = 8    // Brightness levels per star color, far to near
+	starfieldNear      = 0.05 // Depth at which a star passes the viewer and respawns
+	starfieldMaxStreak = 12   // Longest streak in cells
+	starfieldSpread    = 0.1  // Share of the half-screen the field covers at the far end
+)
+
+// StarfieldEffect flies through a field of 3D stars. Stars start far away
+// near the center of the screen and move toward the viewer, speeding up and
+// drawing longer streaks as they near the edges.
+type StarfieldEffect struct {
+	width     int
+	height    int
+	speed     float64
+	starCount int
+	shades    [][]string // Per star color, starfieldShades shades from dim to full
+	stars     []star
+	order     []int // Star indices, far to near, reused between renders
+	frame     int
+	rng       *rand.Rand
+	cells     [][]Cell // Frame grid reused between renders
+}
+
+// star is a point in the field: x and y in [-1, 1], z the depth from 1
+// (far) to starfieldNear (at the viewer)
+type star struct {
+	x, y, z float64
+	color   int // Index into shades
+}
+
+// StarfieldConfig holds configuration for the starfield effect
+type StarfieldConfig struct {
+	Width      int
+	Height     int
+	StarColors []string // Star colors, each star picks one (default white)
+	Speed      float64  // Depth covered per frame (default 0.02)
+	StarCount  int      // Stars in the field (default 200)
+	Seed       int64    // Random seed for reproducible output, 0 = seeded from the clock
+}
+
+// NewStarfieldEffect creates a starfield effect with the given configuration
+func NewStarfieldEffect(config StarfieldConfig) *StarfieldEffect {
+	speed := config.Speed
+	if speed <= 0 {
+		speed = 0.02
+	}
+	starCount := config.StarCount
+	if starCount <= 0 {
+		starCount = 200
+	}
+	colors := config.StarColors
+	if len(colors) == 0 {
+		colors = []string{"#ffffff"}
+	}
+
+	shades := make([][]string, len(colors))
+	for i, color := range colors {
+		shades[i] = make([]string, starfieldShades)
+		for level := range shades[i] {
+			// Far stars fade toward black, near ones get the full color
+			t := float64(level+1) / starfieldShades
+			shades[i][level] = blendColor("#000000", color, 0.25+0.75*t)
+		}
+	}
+
+	s := &StarfieldEffect{
+		width:     config.Width,
+		height:    config.Height,
+		speed:     speed,
+		starCount: starCount,
+		shades:    shades,
+		rng:       newRNG(config.Seed),
+	}
+	s.Reset()
+	return s
+}
+
+// Reset scatters the stars through the whole depth of the field
+func (s *StarfieldEffect) Reset() {
+	s.stars = make([]star, s.starCount)
+	for i := range s.stars {
+		s.spawn(&s.stars[i])
+		s.stars[i].z = starfieldNear + s.rng.Float64()*(1-starfieldNear)
+	}
+	s.frame = 0
+}
+
+// spawn places a star at the far end of the field
+func (s *StarfieldEffect) spawn(st *star) {
+	*st = star{
+		x:     s.rng.Float64()*2 - 1,
+		y:     s.rng.Float64()*2 - 1,
+		z:     1,
+		color: s.rng.Intn(len(s.shades)),
+	}
+}
+
+// project returns the screen position of a star at depth z
+func (s *StarfieldEffect) project(st star, z float64) (float64, float64) {
+	cx, cy := float64(s.width)/2, float64(s.height)/2
+	return cx + st.x/z*cx*starfieldSpread, cy + st.y/z*cy*starfieldSpread
+}
+
+// onScreen reports whether a projected position falls inside the canvas
+func (s *StarfieldEffect) onScreen(x, y float64) bool {
+	return x >= 0 && y >= 0 && x < float64(s.width) && y < float64(s.height)
+}
+
+// Update moves every star toward the viewer, respawning those that pass it
+// or leave the screen
+func (s *StarfieldEffect) Update() {
+	s.frame++
+	for i := range s.stars {
+		st := &s.stars[i]
+		st.z -= s.speed
+		if st.z < starfieldNear {
+			s.spawn(st)
+			continue
+		}
+		if x, y := s.project(*st, st.z); !s.onScreen(x, y) {
+			s.spawn(st)
+		}
+	}
+}
+
+// Resize adapts the field to new dimensions
+func (s *StarfieldEffect) Resize(width, height int) {
+	s.width, s.height = width, height
+}
+
+// Stats returns the frame counter and star count
+func (s *StarfieldEffect) Stats() Stats {
+	return Stats{Frame: s.frame, Counts: map[string]int{"stars": len(s.stars)}}
+}
+
+// RenderCells draws every star as a streak from where it was a few frames
+// ago to where it is now, brighter and longer the closer it gets
+func (s *StarfieldEffect) RenderCells() [][]Cell {
+	s.cells = resetCellGrid(s.cells, s.width, s.height)
+
+	// Far stars first, so near ones draw over them
+	s.order = s.order[:0]
+	for i := range s.stars {
+		s.order = append(s.order, i)
+	}
+	slices.SortFunc(s.order, func(a, b int) int {
+		return cmp.Compare(s.stars[b].z, s.stars[a].z)
+	})
+
+	for _, i := range s.order {
+		st := s.stars[i]
+		x1, y1 := s.project(st, st.z)
+		x0, y0 := s.project(st, math.Min(1, st.z+3*s.speed))
+		nearness := 1 - (st.z-starfieldNear)/(1-starfieldNear)
+		level := min(int(nearness*starfieldShades), starfieldShades-1)
+		color := s.shades[st.color][level]
+
+		dx, dy := x1-x0, y1-y0
+		steps := min(int(math.Max(math.Abs(dx), math.Abs(dy))), starfieldMaxStreak)
+		tail := streakGlyph(dx, dy)
+		for k := steps; k >= 1; k-- {
+			t := float64(k) / float64(steps+1)
+			s.plot(x1-dx*t, y1-dy*t, Cell{Rune: tail, Color: s.shades[st.color][level/2]})
+		}
+
+		head := '.'
+		switch {
+		case nearness > 0.8:
+			head = '*'
+		case nearness > 0.4:
+			head = '+'
+		}
+		s.plot(x1, y1, Cell{Rune: head, Color: color, Bold: nearness > 0.8})
+	}
+	return s.cells
+}
+
+// plot sets the cell under a projected position if it is on screen
+func (s *StarfieldEffect) plot(x, y float64, cell Cell) {
+	if s.onScreen(x, y) {
+		s.cells[int(y)][int(x)] = cell
+	}
+}
+
+// Render converts the starfield to colored text output
+func (s *StarfieldEffect) Render() string {
+	return renderCellsBatched(s.RenderCells())
+}
+
+// WriteTo streams the starfield to w, see io.WriterTo
+func (s *StarfieldEffect) WriteTo(w io.Writer) (int64, error) {
+	return writeCellsBatched(w, s.RenderCells())
+}
+
+// streakGlyph picks a line character for a streak moving by dx, dy.
+// Cells are about twice as tall as wide, so dy counts double.
+func streakGlyph(dx, dy float64) rune {
+	angle := math.Atan2(2*dy, dx)
+	switch octant := int(math.Round(angle/(math.Pi/4))+8) % 4; octant {
+	case 0:
+		return '-'
+	case 1:
+		return '\\'
+	case 2:
+		return '|'
+	default:
+		return '/'
+	}
+}
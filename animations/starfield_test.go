@@ -0,0 +1,29 @@
+package animations
+
+import "testing"
+
+func TestStarfield_StarsMoveOutward(t *testing.T) {
+	sf := NewStarfieldEffect(StarfieldConfig{Width: 80, Height: 24, StarCount: 50, Seed: 1})
+	for i := 0; i < 200; i++ {
+		sf.Update()
+		for _, st := range sf.stars {
+			if st.z < starfieldNear || st.z > 1 {
+				t.Fatalf("frame %d: star at depth %.3f, want %.2f..1", i, st.z, starfieldNear)
+			}
+			if x, y := sf.project(st, st.z); !sf.onScreen(x, y) {
+				t.Fatalf("frame %d: star off screen at %.1f,%.1f", i, x, y)
+			}
+		}
+	}
+	if len(sf.stars) != 50 {
+		t.Errorf("%d stars, want 50", len(sf.stars))
+	}
+
+	// A star close to the viewer streaks away from the center
+	sf.stars = []star{{x: 0.5, y: 0, z: 0.2}}
+	cells := sf.RenderCells()
+	x, y := sf.project(sf.stars[0], 0.2)
+	if cells[int(y)][int(x)-1].Rune != '-' {
+		t.Errorf("no horizontal streak behind a star moving right, got %q", cells[int(y)][int(x)-1].Rune)
+	}
+}
@@ -0,0 +1,66 @@
+package animations
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPrintEffect_TrailSymbols(t *testing.T) {
+	tests := []struct {
+		name    string
+		symbols []string
+		want    []string
+	}{
+		{"nil", nil, []string{"░", "▒", "▓"}},
+		{"empty", []string{}, []string{"░", "▒", "▓"}},
+		{"single", []string{"·"}, []string{"·"}},
+		{"blank entries", []string{"", "ab", "\t"}, []string{"░", "▒", "▓"}},
+		{"mixed", []string{"", "-", "=="}, []string{"-"}},
+	}
+	for _, tt := range tests {
+		p := NewPrintEffect(PrintConfig{Width: 20, Height: 3, Text: "HELLO", PrintHeadSymbol: "", TrailSymbols: tt.symbols, Seed: 1})
+		if !slices.Equal(p.trailSymbols, tt.want) {
+			t.Errorf("%s: trail symbols = %q, want %q", tt.name, p.trailSymbols, tt.want)
+		}
+		if p.printHeadSymbol != "█" {
+			t.Errorf("%s: empty head symbol not replaced, got %q", tt.name, p.printHeadSymbol)
+		}
+		for i := 0; i < 10; i++ {
+			p.Update()
+			p.Render()
+		}
+	}
+}
+
+func TestBeamEffects_Symbols(t *testing.T) {
+	tests := []struct {
+		name     string
+		symbols  []rune
+		wantRow  []rune
+		wantCols []rune
+	}{
+		{"nil", nil, defaultBeamRowSymbols, defaultBeamColumnSymbols},
+		{"empty", []rune{}, defaultBeamRowSymbols, defaultBeamColumnSymbols},
+		{"single", []rune{'#'}, []rune{'#'}, []rune{'#'}},
+		{"control only", []rune{0, '\n'}, defaultBeamRowSymbols, defaultBeamColumnSymbols},
+	}
+	for _, tt := range tests {
+		beams := NewBeamsEffect(BeamsConfig{Width: 20, Height: 6, BeamRowSymbols: tt.symbols, BeamColumnSymbols: tt.symbols, Seed: 1})
+		if !slices.Equal(beams.beamRowSymbols, tt.wantRow) || !slices.Equal(beams.beamColumnSymbols, tt.wantCols) {
+			t.Errorf("beams %s: symbols = %q/%q", tt.name, beams.beamRowSymbols, beams.beamColumnSymbols)
+		}
+		text := NewBeamTextEffect(BeamTextConfig{Width: 20, Height: 6, Text: "BEAM\nTEXT", BeamRowSymbols: tt.symbols, BeamColumnSymbols: tt.symbols, Seed: 1})
+		if !slices.Equal(text.beamRowSymbols, tt.wantRow) || !slices.Equal(text.beamColumnSymbols, tt.wantCols) {
+			t.Errorf("beam-text %s: symbols = %q/%q", tt.name, text.beamRowSymbols, text.beamColumnSymbols)
+		}
+		for i := 0; i < 50; i++ {
+			beams.Update()
+			text.Update()
+			if frame := text.Render(); strings.ContainsRune(frame, 0) {
+				t.Fatalf("beam-text %s: frame %d draws a NUL", tt.name, i)
+			}
+			beams.Render()
+		}
+	}
+}
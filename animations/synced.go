@@ -0,0 +1,106 @@
+package animations
+
+import (
+	"io"
+	"sync"
+)
+
+// SyncedEffect wraps an effect with a mutex so it can be advanced on one
+// goroutine, such as a ticker or a bubbletea command, and drawn on another.
+// Effects on their own are not safe for concurrent use.
+type SyncedEffect struct {
+	mu     sync.Mutex
+	effect interface {
+		Update()
+		Render() string
+	}
+}
+
+// NewSyncedEffect wraps effect for concurrent use. After wrapping, only call
+// the effect through the SyncedEffect.
+func NewSyncedEffect(effect interface {
+	Update()
+	Render() string
+}) *SyncedEffect {
+	return &SyncedEffect{effect: effect}
+}
+
+// Tick advances the effect by one frame and returns that frame. The string
+// is a snapshot, so it stays valid while other goroutines keep ticking.
+func (s *SyncedEffect) Tick() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.effect.Update()
+	return s.effect.Render()
+}
+
+// Update advances the effect by one frame
+func (s *SyncedEffect) Update() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.effect.Update()
+}
+
+// Render returns the current frame
+func (s *SyncedEffect) Render() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.effect.Render()
+}
+
+// WriteTo writes the current frame to w, streaming it when the effect
+// implements io.WriterTo. The effect stays locked until the write is done.
+func (s *SyncedEffect) WriteTo(w io.Writer) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if writer, ok := s.effect.(io.WriterTo); ok {
+		return writer.WriteTo(w)
+	}
+	return writeFrame(w, s.effect.Render())
+}
+
+// RenderCells returns a copy of the current frame's cells, or nil if the
+// effect doesn't expose cells. Unlike an effect's own RenderCells, the grid
+// is not reused by later frames.
+func (s *SyncedEffect) RenderCells() [][]Cell {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	renderer, ok := s.effect.(CellRenderer)
+	if !ok {
+		return nil
+	}
+	cells := renderer.RenderCells()
+	snapshot := make([][]Cell, len(cells))
+	for y := range cells {
+		snapshot[y] = append([]Cell(nil), cells[y]...)
+	}
+	return snapshot
+}
+
+// Stats returns the effect's Stats, or zero Stats if it doesn't report any
+func (s *SyncedEffect) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if effect, ok := s.effect.(StatsReporter); ok {
+		return effect.Stats()
+	}
+	return Stats{}
+}
+
+// Reset restarts the effect if it supports restarting
+func (s *SyncedEffect) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if effect, ok := s.effect.(interface{ Reset() }); ok {
+		effect.Reset()
+	}
+}
+
+// Resize changes the effect's dimensions if it is Resizable
+func (s *SyncedEffect) Resize(width, height int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if effect, ok := s.effect.(Resizable); ok {
+		effect.Resize(width, height)
+	}
+}
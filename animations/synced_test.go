@@ -0,0 +1,51 @@
+package animations
+
+import (
+	"sync"
+	"testing"
+)
+
+// Run with -race to check that SyncedEffect serializes access to the effect
+func TestSyncedEffect_ConcurrentTick(t *testing.T) {
+	effects := map[string]*SyncedEffect{
+		"fire": NewSyncedEffect(NewFireEffectWithConfig(FireConfig{
+			Width:   40,
+			Height:  12,
+			Palette: []string{"#000000", "#ff0000", "#ffff00"},
+			Seed:    1,
+		})),
+		"beams": NewSyncedEffect(NewBeamsEffect(BeamsConfig{Width: 40, Height: 12, Seed: 1})),
+	}
+
+	for name, effect := range effects {
+		t.Run(name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			for i := 0; i < 4; i++ {
+				wg.Add(3)
+				go func() {
+					defer wg.Done()
+					for frame := 0; frame < 25; frame++ {
+						if effect.Tick() == "" {
+							t.Error("Tick returned an empty frame")
+							return
+						}
+					}
+				}()
+				go func() {
+					defer wg.Done()
+					for frame := 0; frame < 25; frame++ {
+						if cells := effect.RenderCells(); len(cells) == 0 {
+							t.Error("RenderCells returned no rows")
+							return
+						}
+					}
+				}()
+				go func(i int) {
+					defer wg.Done()
+					effect.Resize(40+i, 12+i)
+				}(i)
+			}
+			wg.Wait()
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package animations
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// Theme holds the colors used by every effect for one color scheme.
+// Built-in themes live in Themes; custom themes can be loaded with LoadTheme
+// and completed with WithDefaults.
+type Theme struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// Particle effects
+	FirePalette      []string `json:"fire_palette,omitempty"`
+	MatrixPalette    []string `json:"matrix_palette,omitempty"`
+	RainPalette      []string `json:"rain_palette,omitempty"`
+	FireworksPalette []string `json:"fireworks_palette,omitempty"`
+	ParticlePalette  []string `json:"particle_palette,omitempty"`
+
+	// Screensaver: background, ascii primary/secondary, clock primary/secondary, date
+	ScreensaverPalette []string `json:"screensaver_palette,omitempty"`
+
+	// Text effects
+	GradientStops      []string `json:"gradient_stops,omitempty"`       // Pour final gradient
+	PrintGradientStops []string `json:"print_gradient_stops,omitempty"` // Print gradient
+	BeamGradientStops  []string `json:"beam_gradient_stops,omitempty"`  // Beams and beam-text
+	FinalGradientStops []string `json:"final_gradient_stops,omitempty"` // Beams, beam-text and ring-text
+	RingColors         []string `json:"ring_colors,omitempty"`
+	StarColors         []string `json:"star_colors,omitempty"`
+	BlackholeColor     string   `json:"blackhole_color,omitempty"`
+
+	// Aquarium
+	AquariumFishColors    []string `json:"aquarium_fish_colors,omitempty"`
+	AquariumWaterColors   []string `json:"aquarium_water_colors,omitempty"`
+	AquariumSeaweedColors []string `json:"aquarium_seaweed_colors,omitempty"`
+	AquariumBubbleColor   string   `json:"aquarium_bubble_color,omitempty"`
+	AquariumDiverColor    string   `json:"aquarium_diver_color,omitempty"`
+	AquariumBoatColor     string   `json:"aquarium_boat_color,omitempty"`
+	AquariumMermaidColor  string   `json:"aquarium_mermaid_color,omitempty"`
+	AquariumAnchorColor   string   `json:"aquarium_anchor_color,omitempty"`
+	AquariumChestColor    string   `json:"aquarium_chest_color,omitempty"`
+}
+
+// LoadTheme reads a theme from a JSON file.
+// Unknown keys and malformed colors are reported as errors so typos don't
+// silently fall back to the built-in colors.
+func LoadTheme(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme file: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var theme Theme
+	if err := decoder.Decode(&theme); err != nil {
+		return nil, fmt.Errorf("failed to parse theme JSON: %w", err)
+	}
+
+	if err := theme.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &theme, nil
+}
+
+// WithDefaults returns a copy of t where every empty field is taken from base
+func (t Theme) WithDefaults(base Theme) Theme {
+	merged := reflect.ValueOf(&t).Elem()
+	defaults := reflect.ValueOf(base)
+	for i := 0; i < merged.NumField(); i++ {
+		if merged.Field(i).IsZero() {
+			merged.Field(i).Set(defaults.Field(i))
+		}
+	}
+	return t
+}
+
+// Validate checks that every color in the theme is a #RRGGBB hex string
+func (t *Theme) Validate() error {
+	v := reflect.ValueOf(t).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Name == "Name" || field.Name == "Description" {
+			continue
+		}
+
+		key := strings.Split(field.Tag.Get("json"), ",")[0]
+		switch value := v.Field(i).Interface().(type) {
+		case string:
+			if value != "" && !IsHexColor(value) {
+				return fmt.Errorf("invalid color for %s: %q", key, value)
+			}
+		case []string:
+			for _, color := range value {
+				if !IsHexColor(color) {
+					return fmt.Errorf("invalid color for %s: %q", key, color)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// IsHexColor reports whether s has the form #RRGGBB
+func IsHexColor(s string) bool {
+	if len(s) != 7 || s[0] != '#' {
+		return false
+	}
+	for _, c := range s[1:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
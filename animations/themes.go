@@ -0,0 +1,466 @@
+package animations
+
+import "strings"
+
+// Themes holds every built-in theme keyed by name.
+// Adding a theme here makes it available to the CLI, the TUI and the palette helpers.
+var Themes = map[string]Theme{}
+
+func init() {
+	for _, theme := range builtinThemes {
+		Themes[theme.Name] = theme
+	}
+}
+
+// GetTheme returns the built-in theme for name (case-insensitive, aliases included).
+// Unknown names return the default colors and false.
+func GetTheme(name string) (Theme, bool) {
+	name = strings.ToLower(name)
+	if meta := GetThemeMetadata(name); meta != nil {
+		name = meta.Name
+	}
+	if theme, ok := Themes[name]; ok {
+		return theme, true
+	}
+	return defaultTheme, false
+}
+
+// builtinThemes lists the themes shipped with the library
+var builtinThemes = []Theme{
+	{
+		Name: "dracula",
+		FirePalette: []string{
+			"#282a36", // Background
+			"#44475a", // Current line
+			"#6272a4", // Comment
+			"#8be9fd", // Cyan
+			"#50fa7b", // Green
+			"#f1fa8c", // Yellow
+			"#ffb86c", // Orange
+			"#ff79c6", // Pink
+			"#ff5555", // Red (hottest)
+		},
+		MatrixPalette:         []string{"#282a36", "#44475a", "#6272a4", "#8be9fd", "#50fa7b", "#ff5555"},
+		ParticlePalette:       []string{"#bd93f9", "#ff79c6", "#8be9fd", "#50fa7b"},
+		RainPalette:           []string{"#8be9fd", "#50fa7b", "#ffb86c", "#ff79c6", "#bd93f9"},
+		FireworksPalette:      []string{"#ff5555", "#ff79c6", "#bd93f9", "#8be9fd", "#50fa7b", "#ffb86c", "#ffffff"},
+		ScreensaverPalette:    []string{"#282a36", "#bd93f9", "#8be9fd", "#50fa7b", "#f1fa8c", "#f8f8f2"},
+		GradientStops:         []string{"#ff79c6", "#bd93f9", "#ffffff"},
+		PrintGradientStops:    []string{"#ff79c6", "#bd93f9", "#8be9fd"},
+		BeamGradientStops:     []string{"#ffffff", "#8be9fd", "#bd93f9"},
+		FinalGradientStops:    []string{"#6272a4", "#bd93f9", "#f8f8f2"},
+		RingColors:            []string{"#bd93f9", "#ff79c6", "#f1fa8c", "#8be9fd", "#50fa7b", "#ffb86c"},
+		StarColors:            []string{"#bd93f9", "#ff79c6", "#f1fa8c", "#8be9fd", "#50fa7b", "#ffb86c"},
+		BlackholeColor:        "#f8f8f2",
+		AquariumFishColors:    []string{"#ff79c6", "#bd93f9", "#8be9fd", "#50fa7b", "#ffb86c"},
+		AquariumWaterColors:   []string{"#6272a4", "#c2b280"},
+		AquariumSeaweedColors: []string{"#44475a", "#50fa7b", "#8be9fd"},
+		AquariumBubbleColor:   "#8be9fd",
+		AquariumDiverColor:    "#f8f8f2",
+		AquariumBoatColor:     "#ffb86c",
+		AquariumMermaidColor:  "#ff79c6",
+		AquariumAnchorColor:   "#6272a4",
+		AquariumChestColor:    "#f1fa8c",
+	},
+	{
+		Name: "catppuccin",
+		FirePalette: []string{
+			"#1e1e2e", // Base
+			"#181825", // Mantle
+			"#313244", // Surface0
+			"#45475a", // Surface1
+			"#f38ba8", // Red
+			"#fab387", // Peach
+			"#f9e2af", // Yellow
+			"#a6e3a1", // Green (hot tip)
+		},
+		MatrixPalette:         []string{"#1e1e2e", "#313244", "#45475a", "#89dceb", "#a6e3a1", "#f38ba8"},
+		ParticlePalette:       []string{"#cba6f7", "#f38ba8", "#89dceb", "#a6e3a1"},
+		RainPalette:           []string{"#89dceb", "#a6e3a1", "#f9e2af", "#f5c2e7", "#cba6f7"},
+		FireworksPalette:      []string{"#f38ba8", "#f5c2e7", "#cba6f7", "#89b4fa", "#89dceb", "#a6e3a1", "#f9e2af", "#ffffff"},
+		ScreensaverPalette:    []string{"#1e1e2e", "#cba6f7", "#89b4fa", "#a6e3a1", "#f9e2af", "#cdd6f4"},
+		GradientStops:         []string{"#cba6f7", "#f5c2e7", "#ffffff"},
+		PrintGradientStops:    []string{"#cba6f7", "#f5c2e7", "#f5e0dc"},
+		BeamGradientStops:     []string{"#ffffff", "#89dceb", "#cba6f7"},
+		FinalGradientStops:    []string{"#45475a", "#cba6f7", "#cdd6f4"},
+		RingColors:            []string{"#cba6f7", "#f5c2e7", "#a6e3a1", "#89b4fa", "#f38ba8", "#fab387"},
+		StarColors:            []string{"#cba6f7", "#f5c2e7", "#a6e3a1", "#89dceb", "#fab387", "#f38ba8"},
+		BlackholeColor:        "#cdd6f4",
+		AquariumFishColors:    []string{"#f5c2e7", "#cba6f7", "#89dceb", "#a6e3a1", "#fab387"},
+		AquariumWaterColors:   []string{"#89b4fa", "#f9e2af"},
+		AquariumSeaweedColors: []string{"#1e1e2e", "#a6e3a1", "#94e2d5"},
+		AquariumBubbleColor:   "#89dceb",
+		AquariumDiverColor:    "#cdd6f4",
+		AquariumBoatColor:     "#fab387",
+		AquariumMermaidColor:  "#f5c2e7",
+		AquariumAnchorColor:   "#45475a",
+		AquariumChestColor:    "#f9e2af",
+	},
+	{
+		Name: "nord",
+		FirePalette: []string{
+			"#2e3440", // Polar Night
+			"#3b4252",
+			"#434c5e",
+			"#4c566a",
+			"#bf616a", // Aurora Red
+			"#d08770", // Aurora Orange
+			"#ebcb8b", // Aurora Yellow
+			"#a3be8c", // Aurora Green
+		},
+		MatrixPalette:         []string{"#2e3440", "#3b4252", "#434c5e", "#88c0d0", "#81a1c1", "#bf616a"},
+		ParticlePalette:       []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb"},
+		RainPalette:           []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb"},
+		FireworksPalette:      []string{"#bf616a", "#d08770", "#ebcb8b", "#a3be8c", "#88c0d0", "#81a1c1", "#b48ead", "#ffffff"},
+		ScreensaverPalette:    []string{"#2e3440", "#81a1c1", "#88c0d0", "#8fbcbb", "#d8dee9", "#eceff4"},
+		GradientStops:         []string{"#88c0d0", "#81a1c1", "#ffffff"},
+		PrintGradientStops:    []string{"#88c0d0", "#81a1c1", "#5e81ac"},
+		BeamGradientStops:     []string{"#ffffff", "#88c0d0", "#81a1c1"},
+		FinalGradientStops:    []string{"#434c5e", "#88c0d0", "#eceff4"},
+		RingColors:            []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb", "#b48ead", "#a3be8c"},
+		StarColors:            []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb", "#b48ead", "#a3be8c"},
+		BlackholeColor:        "#eceff4",
+		AquariumFishColors:    []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb", "#b48ead"},
+		AquariumWaterColors:   []string{"#5e81ac", "#d08770"},
+		AquariumSeaweedColors: []string{"#2e3440", "#a3be8c", "#8fbcbb"},
+		AquariumBubbleColor:   "#88c0d0",
+		AquariumDiverColor:    "#eceff4",
+		AquariumBoatColor:     "#d08770",
+		AquariumMermaidColor:  "#b48ead",
+		AquariumAnchorColor:   "#4c566a",
+		AquariumChestColor:    "#ebcb8b",
+	},
+	{
+		Name: "tokyo-night",
+		FirePalette: []string{
+			"#1a1b26", // Background
+			"#24283b", // Background Dark
+			"#414868", // Foreground Gutter
+			"#f7768e", // Red
+			"#ff9e64", // Orange
+			"#e0af68", // Yellow
+			"#9ece6a", // Green
+		},
+		MatrixPalette:         []string{"#1a1b26", "#24283b", "#414868", "#7aa2f7", "#9ece6a", "#f7768e"},
+		ParticlePalette:       []string{"#7aa2f7", "#bb9af7", "#7dcfff", "#9ece6a"},
+		RainPalette:           []string{"#7dcfff", "#7aa2f7", "#2ac3de", "#b4f9f8"},
+		FireworksPalette:      []string{"#f7768e", "#ff9e64", "#e0af68", "#9ece6a", "#7aa2f7", "#bb9af7", "#7dcfff", "#ffffff"},
+		ScreensaverPalette:    []string{"#1a1b26", "#7aa2f7", "#bb9af7", "#9ece6a", "#e0af68", "#c0caf5"},
+		GradientStops:         []string{"#9ece6a", "#e0af68", "#ffffff"},
+		PrintGradientStops:    []string{"#9ece6a", "#e0af68", "#bb9af7"},
+		BeamGradientStops:     []string{"#ffffff", "#7dcfff", "#bb9af7"},
+		FinalGradientStops:    []string{"#414868", "#7aa2f7", "#c0caf5"},
+		RingColors:            []string{"#7dcfff", "#bb9af7", "#9ece6a", "#7aa2f7", "#ff9e64", "#f7768e"},
+		StarColors:            []string{"#7dcfff", "#bb9af7", "#9ece6a", "#7aa2f7", "#f7768e", "#e0af68"},
+		BlackholeColor:        "#c0caf5",
+		AquariumFishColors:    []string{"#7aa2f7", "#bb9af7", "#7dcfff", "#9ece6a", "#f7768e"},
+		AquariumWaterColors:   []string{"#7aa2f7", "#e0af68"},
+		AquariumSeaweedColors: []string{"#1a1b26", "#9ece6a", "#7dcfff"},
+		AquariumBubbleColor:   "#7dcfff",
+		AquariumDiverColor:    "#c0caf5",
+		AquariumBoatColor:     "#e0af68",
+		AquariumMermaidColor:  "#bb9af7",
+		AquariumAnchorColor:   "#414868",
+		AquariumChestColor:    "#e0af68",
+	},
+	{
+		Name: "gruvbox",
+		FirePalette: []string{
+			"#282828", // Background
+			"#3c3836", // BG1
+			"#504945", // BG2
+			"#cc241d", // Red
+			"#d65d0e", // Orange
+			"#d79921", // Yellow
+			"#fabd2f", // Bright Yellow
+			"#b8bb26", // Green (hot)
+		},
+		MatrixPalette:         []string{"#282828", "#3c3836", "#504945", "#83a598", "#b8bb26", "#fb4934"},
+		ParticlePalette:       []string{"#d3869b", "#83a598", "#b8bb26", "#fabd2f"},
+		RainPalette:           []string{"#83a598", "#8ec07c", "#d3869b", "#fabd2f"},
+		FireworksPalette:      []string{"#fb4934", "#fe8019", "#fabd2f", "#b8bb26", "#83a598", "#d3869b", "#ffffff"},
+		ScreensaverPalette:    []string{"#282828", "#fe8019", "#8ec07c", "#fabd2f", "#d79921", "#ebdbb2"},
+		GradientStops:         []string{"#fe8019", "#fabd2f", "#ffffff"},
+		PrintGradientStops:    []string{"#fe8019", "#fabd2f", "#b8bb26"},
+		BeamGradientStops:     []string{"#ffffff", "#fabd2f", "#fe8019"},
+		FinalGradientStops:    []string{"#504945", "#fabd2f", "#ebdbb2"},
+		RingColors:            []string{"#fabd2f", "#fe8019", "#b8bb26", "#83a598", "#d3869b", "#fb4934"},
+		StarColors:            []string{"#fabd2f", "#fe8019", "#b8bb26", "#83a598", "#d3869b", "#fb4934"},
+		BlackholeColor:        "#ebdbb2",
+		AquariumFishColors:    []string{"#fe8019", "#fabd2f", "#b8bb26", "#83a598", "#d3869b"},
+		AquariumWaterColors:   []string{"#458588", "#d79921"},
+		AquariumSeaweedColors: []string{"#3c3836", "#98971a", "#b8bb26"},
+		AquariumBubbleColor:   "#83a598",
+		AquariumDiverColor:    "#ebdbb2",
+		AquariumBoatColor:     "#fabd2f",
+		AquariumMermaidColor:  "#d3869b",
+		AquariumAnchorColor:   "#504945",
+		AquariumChestColor:    "#d79921",
+	},
+	{
+		Name: "material",
+		FirePalette: []string{
+			"#263238", // Background
+			"#37474f", // Lighter bg
+			"#546e7a", // Selection
+			"#f07178", // Red
+			"#f78c6c", // Orange
+			"#ffcb6b", // Yellow
+			"#c3e88d", // Green
+		},
+		MatrixPalette:         []string{"#263238", "#37474f", "#546e7a", "#89ddff", "#c3e88d", "#f07178"},
+		ParticlePalette:       []string{"#89ddff", "#f07178", "#c3e88d", "#ffcb6b"},
+		RainPalette:           []string{"#89ddff", "#82aaff", "#c3e88d", "#ffcb6b"},
+		FireworksPalette:      []string{"#f07178", "#f78c6c", "#ffcb6b", "#c3e88d", "#82aaff", "#c792ea", "#89ddff", "#ffffff"},
+		ScreensaverPalette:    []string{"#263238", "#80cbc4", "#64b5f6", "#ffab40", "#ffd54f", "#eceff1"},
+		GradientStops:         []string{"#03dac6", "#bb86fc", "#ffffff"},
+		PrintGradientStops:    []string{"#03dac6", "#bb86fc", "#cf6679"},
+		BeamGradientStops:     []string{"#ffffff", "#89ddff", "#bb86fc"},
+		FinalGradientStops:    []string{"#546e7a", "#89ddff", "#eceff1"},
+		RingColors:            []string{"#bb86fc", "#03dac6", "#cf6679", "#89ddff", "#ffcb6b", "#c3e88d"},
+		StarColors:            []string{"#bb86fc", "#03dac6", "#cf6679", "#89ddff", "#c3e88d", "#ffcb6b"},
+		BlackholeColor:        "#eceff1",
+		AquariumFishColors:    []string{"#82aaff", "#c792ea", "#89ddff", "#c3e88d", "#f78c6c"},
+		AquariumWaterColors:   []string{"#82aaff", "#ffcb6b"},
+		AquariumSeaweedColors: []string{"#263238", "#c3e88d", "#89ddff"},
+		AquariumBubbleColor:   "#89ddff",
+		AquariumDiverColor:    "#eceff1",
+		AquariumBoatColor:     "#ffcb6b",
+		AquariumMermaidColor:  "#c792ea",
+		AquariumAnchorColor:   "#37474f",
+		AquariumChestColor:    "#ffcb6b",
+	},
+	{
+		Name: "solarized",
+		FirePalette: []string{
+			"#002b36", // Base03 - darkest
+			"#073642", // Base02
+			"#586e75", // Base01
+			"#dc322f", // Red
+			"#cb4b16", // Orange
+			"#b58900", // Yellow
+			"#859900", // Green
+		},
+		MatrixPalette:         []string{"#002b36", "#073642", "#586e75", "#2aa198", "#859900", "#dc322f"},
+		ParticlePalette:       []string{"#268bd2", "#2aa198", "#859900", "#b58900"},
+		RainPalette:           []string{"#2aa198", "#268bd2", "#6c71c4", "#859900"},
+		FireworksPalette:      []string{"#dc322f", "#cb4b16", "#b58900", "#859900", "#2aa198", "#268bd2", "#6c71c4", "#ffffff"},
+		ScreensaverPalette:    []string{"#002b36", "#268bd2", "#2aa198", "#859900", "#b58900", "#fdf6e3"},
+		GradientStops:         []string{"#268bd2", "#2aa198", "#ffffff"},
+		PrintGradientStops:    []string{"#268bd2", "#2aa198", "#859900"},
+		BeamGradientStops:     []string{"#ffffff", "#2aa198", "#268bd2"},
+		FinalGradientStops:    []string{"#586e75", "#2aa198", "#fdf6e3"},
+		RingColors:            []string{"#268bd2", "#2aa198", "#859900", "#cb4b16", "#d33682", "#6c71c4"},
+		StarColors:            []string{"#268bd2", "#2aa198", "#859900", "#cb4b16", "#6c71c4", "#b58900"},
+		BlackholeColor:        "#fdf6e3",
+		AquariumFishColors:    []string{"#268bd2", "#2aa198", "#859900", "#cb4b16", "#6c71c4"},
+		AquariumWaterColors:   []string{"#268bd2", "#b58900"},
+		AquariumSeaweedColors: []string{"#002b36", "#859900", "#2aa198"},
+		AquariumBubbleColor:   "#2aa198",
+		AquariumDiverColor:    "#fdf6e3",
+		AquariumBoatColor:     "#cb4b16",
+		AquariumMermaidColor:  "#d33682",
+		AquariumAnchorColor:   "#073642",
+		AquariumChestColor:    "#b58900",
+	},
+	{
+		Name: "monochrome",
+		FirePalette: []string{
+			"#1a1a1a", // Dark gray
+			"#2a2a2a",
+			"#3a3a3a",
+			"#4a4a4a",
+			"#5a5a5a",
+			"#7a7a7a",
+			"#9a9a9a",
+			"#bababa",
+			"#dadada", // Light gray (hottest)
+		},
+		MatrixPalette:         []string{"#1a1a1a", "#3a3a3a", "#5a5a5a", "#7a7a7a", "#9a9a9a", "#bababa"},
+		ParticlePalette:       []string{"#5a5a5a", "#7a7a7a", "#9a9a9a", "#bababa"},
+		RainPalette:           []string{"#cccccc", "#aaaaaa", "#888888", "#666666"},
+		FireworksPalette:      []string{"#5a5a5a", "#7a5a7a", "#9a9a9a", "#bababa", "#ffffff"},
+		ScreensaverPalette:    []string{"#1a1a1a", "#ffffff", "#cccccc", "#888888", "#666666", "#ffffff"},
+		GradientStops:         []string{"#808080", "#c0c0c0", "#ffffff"},
+		PrintGradientStops:    []string{"#808080", "#c0c0c0", "#ffffff"},
+		BeamGradientStops:     []string{"#ffffff", "#c0c0c0", "#808080"},
+		FinalGradientStops:    []string{"#3a3a3a", "#9a9a9a", "#ffffff"},
+		RingColors:            []string{"#ffffff", "#e0e0e0", "#c0c0c0", "#a0a0a0", "#808080", "#606060"},
+		StarColors:            []string{"#ffffff", "#c0c0c0", "#808080", "#9a9a9a", "#bababa", "#dadada"},
+		BlackholeColor:        "#ffffff",
+		AquariumFishColors:    []string{"#9a9a9a", "#bababa", "#dadada", "#c0c0c0", "#808080"},
+		AquariumWaterColors:   []string{"#5a5a5a", "#8a8a8a"},
+		AquariumSeaweedColors: []string{"#1a1a1a", "#5a5a5a", "#7a7a7a"},
+		AquariumBubbleColor:   "#c0c0c0",
+		AquariumDiverColor:    "#ffffff",
+		AquariumBoatColor:     "#9a9a9a",
+		AquariumMermaidColor:  "#bababa",
+		AquariumAnchorColor:   "#3a3a3a",
+		AquariumChestColor:    "#bababa",
+	},
+	{
+		Name: "transishardjob",
+		FirePalette: []string{
+			"#55cdfc", // Trans blue
+			"#f7a8b8", // Trans pink
+			"#ffffff", // White
+			"#f7a8b8", // Pink again
+			"#55cdfc", // Blue again
+			"#ffffff", // White (hottest)
+		},
+		MatrixPalette:         []string{"#1a1a1a", "#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc"},
+		ParticlePalette:       []string{"#55cdfc", "#f7a8b8", "#ffffff"},
+		RainPalette:           []string{"#55cdfc", "#f7a8b8", "#ffffff"},
+		FireworksPalette:      []string{"#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc", "#ffffff"},
+		ScreensaverPalette:    []string{"#1a1a1a", "#5BCEFA", "#F5A9B8", "#FFFFFF", "#F5A9B8", "#FFFFFF"},
+		GradientStops:         []string{"#55cdfc", "#f7a8b8", "#ffffff"},
+		PrintGradientStops:    []string{"#55cdfc", "#f7a8b8", "#ffffff"},
+		BeamGradientStops:     []string{"#ffffff", "#55cdfc", "#f7a8b8"},
+		FinalGradientStops:    []string{"#55cdfc", "#f7a8b8", "#ffffff"},
+		RingColors:            []string{"#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc", "#ffffff"},
+		StarColors:            []string{"#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc", "#ffffff"},
+		BlackholeColor:        "#ffffff",
+		AquariumFishColors:    []string{"#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc"},
+		AquariumWaterColors:   []string{"#55cdfc", "#f7a8b8"},
+		AquariumSeaweedColors: []string{"#1a1a1a", "#55cdfc", "#f7a8b8"},
+		AquariumBubbleColor:   "#ffffff",
+		AquariumDiverColor:    "#ffffff",
+		AquariumBoatColor:     "#f7a8b8",
+		AquariumMermaidColor:  "#f7a8b8",
+		AquariumAnchorColor:   "#55cdfc",
+		AquariumChestColor:    "#ffffff",
+	},
+	{
+		Name: "rama",
+		FirePalette: []string{
+			"#2b2d42", // Space cadet (background)
+			"#8d99ae", // Cool gray
+			"#d90429", // Fire engine red
+			"#ef233c", // Red Pantone
+			"#edf2f4", // Anti-flash white (hottest)
+		},
+		MatrixPalette:         []string{"#2b2d42", "#8d99ae", "#d90429", "#ef233c", "#edf2f4"},
+		ParticlePalette:       []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4"},
+		RainPalette:           []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4"},
+		FireworksPalette:      []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4", "#ef233c", "#edf2f4"},
+		ScreensaverPalette:    []string{"#2b2d42", "#ef233c", "#d90429", "#edf2f4", "#8d99ae", "#edf2f4"},
+		GradientStops:         []string{"#ef233c", "#d90429", "#edf2f4"},
+		PrintGradientStops:    []string{"#ef233c", "#d90429", "#edf2f4"},
+		BeamGradientStops:     []string{"#ffffff", "#ef233c", "#d90429"},
+		FinalGradientStops:    []string{"#8d99ae", "#ef233c", "#edf2f4"},
+		RingColors:            []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4", "#ef233c", "#d90429"},
+		StarColors:            []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4", "#ef233c", "#d90429"},
+		BlackholeColor:        "#edf2f4",
+		AquariumFishColors:    []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4", "#ef233c"},
+		AquariumWaterColors:   []string{"#8d99ae", "#ef233c"},
+		AquariumSeaweedColors: []string{"#2b2d42", "#8d99ae", "#ef233c"},
+		AquariumBubbleColor:   "#edf2f4",
+		AquariumDiverColor:    "#edf2f4",
+		AquariumBoatColor:     "#ef233c",
+		AquariumMermaidColor:  "#d90429",
+		AquariumAnchorColor:   "#8d99ae",
+		AquariumChestColor:    "#edf2f4",
+	},
+	{
+		Name: "eldritch",
+		FirePalette: []string{
+			"#212337", // Background
+			"#292e42", // Current line
+			"#7081d0", // Comment
+			"#04d1f9", // Cyan
+			"#37f499", // Green
+			"#f1fc79", // Yellow
+			"#f7c67f", // Orange
+			"#f265b5", // Pink
+			"#f16c75", // Red (hottest)
+		},
+		MatrixPalette:         []string{"#212337", "#292e42", "#7081d0", "#04d1f9", "#37f499", "#f16c75"},
+		ParticlePalette:       []string{"#37f499", "#04d1f9", "#a48cf2", "#f265b5"},
+		RainPalette:           []string{"#04d1f9", "#37f499", "#f7c67f", "#f265b5", "#a48cf2"},
+		FireworksPalette:      []string{"#f16c75", "#37f499", "#a48cf2", "#04d1f9", "#7081d0", "#f7c67f", "#ebfafa"},
+		ScreensaverPalette:    []string{"#212337", "#37f499", "#04d1f9", "#a48cf2", "#f265b5", "#ebfafa"},
+		GradientStops:         []string{"#37f499", "#04d1f9", "#ebfafa"},
+		PrintGradientStops:    []string{"#37f499", "#04d1f9", "#ebfafa"},
+		BeamGradientStops:     []string{"#ffffff", "#37f499", "#04d1f9"},
+		FinalGradientStops:    []string{"#7081d0", "#37f499", "#ebfafa"},
+		RingColors:            []string{"#37f499", "#04d1f9", "#a48cf2", "#f265b5", "#f16c75", "#f7c67f"},
+		StarColors:            []string{"#37f499", "#04d1f9", "#a48cf2", "#f265b5", "#f16c75", "#f7c67f"},
+		BlackholeColor:        "#ebfafa",
+		AquariumFishColors:    []string{"#37f499", "#04d1f9", "#a48cf2", "#f265b5", "#f16c75"},
+		AquariumWaterColors:   []string{"#7081d0", "#a48cf2"},
+		AquariumSeaweedColors: []string{"#212337", "#37f499", "#04d1f9"},
+		AquariumBubbleColor:   "#04d1f9",
+		AquariumDiverColor:    "#ebfafa",
+		AquariumBoatColor:     "#f7c67f",
+		AquariumMermaidColor:  "#f265b5",
+		AquariumAnchorColor:   "#292e42",
+		AquariumChestColor:    "#f1fc79",
+	},
+	{
+		Name: "dark",
+		FirePalette: []string{
+			"#000000", // True black
+			"#333333", // Dark gray
+			"#666666", // Mid gray
+			"#999999", // Light gray
+			"#cccccc", // Lighter gray
+			"#ffffff", // True white (hottest)
+		},
+		MatrixPalette:         []string{"#000000", "#333333", "#666666", "#999999", "#cccccc", "#ffffff"},
+		ParticlePalette:       []string{"#ffffff", "#cccccc", "#999999", "#666666"},
+		RainPalette:           []string{"#ffffff", "#cccccc", "#999999", "#666666"},
+		FireworksPalette:      []string{"#ffffff", "#cccccc", "#999999", "#666666", "#333333", "#ffffff"},
+		ScreensaverPalette:    []string{"#000000", "#ffffff", "#ffffff", "#ffffff", "#cccccc", "#ffffff"},
+		GradientStops:         []string{"#ffffff", "#cccccc", "#ffffff"},
+		PrintGradientStops:    []string{"#ffffff", "#cccccc", "#ffffff"},
+		BeamGradientStops:     []string{"#ffffff", "#cccccc", "#999999"},
+		FinalGradientStops:    []string{"#333333", "#ffffff", "#ffffff"},
+		RingColors:            []string{"#ffffff", "#cccccc", "#999999", "#666666", "#999999", "#ffffff"},
+		StarColors:            []string{"#ffffff", "#cccccc", "#999999", "#666666", "#999999", "#ffffff"},
+		BlackholeColor:        "#ffffff",
+		AquariumFishColors:    []string{"#ffffff", "#cccccc", "#999999", "#ffffff", "#cccccc"},
+		AquariumWaterColors:   []string{"#666666", "#999999"},
+		AquariumSeaweedColors: []string{"#000000", "#333333", "#666666"},
+		AquariumBubbleColor:   "#ffffff",
+		AquariumDiverColor:    "#ffffff",
+		AquariumBoatColor:     "#cccccc",
+		AquariumMermaidColor:  "#ffffff",
+		AquariumAnchorColor:   "#333333",
+		AquariumChestColor:    "#aaaaaa",
+	},
+}
+
+// defaultTheme is used when a theme name is not recognized
+var defaultTheme = Theme{
+	Name: "default",
+	FirePalette: []string{
+		"#000000", "#1a0000", "#330000", "#4d0000",
+		"#660000", "#7f0000", "#990000", "#b30000",
+		"#cc0000", "#e60000", "#ff0000", "#ff1a1a",
+		"#ff3333", "#ff4d4d", "#ff6600", "#ff7f00",
+		"#ff9900", "#ffb300", "#ffcc00", "#ffe600",
+		"#ffff00", "#ffff33", "#ffff66", "#ffff99",
+		"#ffffcc", "#ffffff",
+	},
+	MatrixPalette:         []string{"#001100", "#003300", "#005500", "#007700", "#00aa00", "#00ff00"},
+	ParticlePalette:       []string{"#ffffff", "#00ffff", "#ff00ff", "#ffff00"},
+	RainPalette:           []string{"#00ff00", "#00cc00", "#009900", "#006600"},
+	FireworksPalette:      []string{"#ff0000", "#ff8000", "#ffff00", "#80ff00", "#00ff80", "#00ffff", "#8000ff", "#ff00ff", "#ffffff"},
+	ScreensaverPalette:    []string{"#1a1a1a", "#8b5cf6", "#06b6d4", "#10b981", "#f59e0b", "#f8fafc"},
+	GradientStops:         []string{"#8A008A", "#00D1FF", "#FFFFFF"},
+	PrintGradientStops:    []string{"#8A008A", "#00D1FF", "#FFFFFF"},
+	BeamGradientStops:     []string{"#ffffff", "#00D1FF", "#8A008A"},
+	FinalGradientStops:    []string{"#4A4A4A", "#00D1FF", "#FFFFFF"},
+	RingColors:            []string{"#bd93f9", "#ff79c6", "#f1fa8c", "#8be9fd", "#50fa7b", "#ffb86c"},
+	StarColors:            []string{"#ffffff", "#ffd700", "#ff6b6b", "#4ecdc4", "#95e1d3", "#f38181"},
+	BlackholeColor:        "#ffffff",
+	AquariumFishColors:    []string{"#00ffff", "#ff00ff", "#ffff00", "#00ff00", "#ff8000"},
+	AquariumWaterColors:   []string{"#4a9eff", "#c2b280"},
+	AquariumSeaweedColors: []string{"#001a1a", "#00ff00", "#00ffff"},
+	AquariumBubbleColor:   "#00ffff",
+	AquariumDiverColor:    "#ffffff",
+	AquariumBoatColor:     "#ff8000",
+	AquariumMermaidColor:  "#ff00ff",
+	AquariumAnchorColor:   "#808080",
+	AquariumChestColor:    "#ffd700",
+}
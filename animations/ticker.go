@@ -1,7 +1,10 @@
 package animations
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/rand"
+	"os"
 	"strings"
 	"time"
 )
@@ -59,11 +62,67 @@ var spinnerFrames = []string{
 	"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏",
 }
 
+// defaultRoast is shown for window managers missing from the built-in roast table
+const defaultRoast = "Your WM: So obscure even I don't have a roast for it │ "
+
+// roastTable maps keys to │-separated phrase lists
+type roastTable struct {
+	entries  map[string]string
+	fallback string // Phrases for keys missing from entries
+	labeled  bool   // Entries start with a "Name:" label that isn't shown
+}
+
+// builtinRoasts returns the table of window manager roasts
+func builtinRoasts() roastTable {
+	return roastTable{entries: wmRoasts, fallback: defaultRoast, labeled: true}
+}
+
+// phrases returns the shuffled phrases for key
+func (t roastTable) phrases(key string) []string {
+	text, ok := matchRoast(t.entries, key)
+	if !ok {
+		text = t.fallback
+	}
+	return splitRoasts(text, t.labeled)
+}
+
+// LoadRoasts reads a roast table from a JSON object file. Each value is
+// either one string of phrases separated by │ or an array of phrases:
+//
+//	{"tips": ["Press Q to quit", "Try -theme nord"], "quotes": "Stay hungry │ Stay foolish"}
+func LoadRoasts(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid roast file %s: %w", path, err)
+	}
+
+	roasts := make(map[string]string, len(raw))
+	for key, value := range raw {
+		var text string
+		if err := json.Unmarshal(value, &text); err == nil {
+			roasts[key] = text
+			continue
+		}
+		var phrases []string
+		if err := json.Unmarshal(value, &phrases); err != nil {
+			return nil, fmt.Errorf("invalid roast file %s: %q must be a string or an array of strings", path, key)
+		}
+		roasts[key] = strings.Join(phrases, " │ ")
+	}
+	return roasts, nil
+}
+
 // RoastingTicker provides scrolling text with WM-specific roasts
 type RoastingTicker struct {
 	offset     int
 	lastUpdate time.Time
 	frameDur   time.Duration
+	table      roastTable
 	roasts     []string // Array of individual roast phrases
 	currentWM  string
 	roastIndex int // Which roast we're currently showing
@@ -73,11 +132,23 @@ type RoastingTicker struct {
 
 // NewRoastingTicker creates a scrolling roast ticker
 func NewRoastingTicker(wmName string) *RoastingTicker {
+	return newRoastingTicker(builtinRoasts(), wmName)
+}
+
+// NewRoastingTickerWithRoasts creates a scrolling ticker over a custom table,
+// such as tips or quotes keyed by topic. It shows nothing until UpdateWM
+// selects a key or SetDefaultRoast sets phrases for unknown keys.
+func NewRoastingTickerWithRoasts(roasts map[string]string) *RoastingTicker {
+	return newRoastingTicker(roastTable{entries: roasts}, "")
+}
+
+func newRoastingTicker(table roastTable, wmName string) *RoastingTicker {
 	return &RoastingTicker{
 		offset:     0,
 		lastUpdate: time.Now(),
 		frameDur:   time.Millisecond * 33, // CHANGED 2025-10-04 - Reduced speed by 30% (25ms -> 33ms)
-		roasts:     splitRoasts(getRoastForWM(wmName)),
+		table:      table,
+		roasts:     table.phrases(wmName),
 		currentWM:  wmName,
 		roastIndex: 0,
 		paused:     false,
@@ -85,21 +156,36 @@ func NewRoastingTicker(wmName string) *RoastingTicker {
 	}
 }
 
-// UpdateWM changes the roast text when WM selection changes
+// UpdateWM changes the roast text when WM selection changes.
+// With a custom table wmName is simply the key to show.
 func (r *RoastingTicker) UpdateWM(wmName string) {
 	if wmName != r.currentWM {
-		r.roasts = splitRoasts(getRoastForWM(wmName))
 		r.currentWM = wmName
-		r.offset = 0
-		r.roastIndex = 0
-		r.lastUpdate = time.Now()
-		r.paused = false
+		r.restart()
 	}
 }
 
-// splitRoasts splits a roast string on │ separator and cleans up
+// SetDefaultRoast sets the │-separated phrases shown for keys missing from
+// the table, replacing the built-in line about obscure window managers
+func (r *RoastingTicker) SetDefaultRoast(roast string) {
+	r.table.fallback = roast
+	r.table.labeled = false
+	r.restart()
+}
+
+// restart reloads the phrases for the current key and starts scrolling from the first
+func (r *RoastingTicker) restart() {
+	r.roasts = r.table.phrases(r.currentWM)
+	r.offset = 0
+	r.roastIndex = 0
+	r.lastUpdate = time.Now()
+	r.paused = false
+}
+
+// splitRoasts splits a roast string on │ separator and cleans up.
+// A labeled roast has a "Name:" prefix on its first phrase which is stripped.
 // Randomize roast order
-func splitRoasts(roastText string) []string {
+func splitRoasts(roastText string, labeled bool) []string {
 	// Split on │ separator
 	parts := strings.Split(roastText, "│")
 
@@ -112,7 +198,7 @@ func splitRoasts(roastText string) []string {
 		}
 
 		// For first part, strip "WM:" prefix if present
-		if i == 0 {
+		if i == 0 && labeled {
 			// Find first colon and strip everything before it
 			colonIdx := strings.Index(trimmed, ":")
 			if colonIdx > 0 && colonIdx < 20 { // Sanity check - WM names are short
@@ -156,8 +242,8 @@ func (r *RoastingTicker) GetScrollingText(width int) string {
 		r.roastIndex = (r.roastIndex + 1) % len(r.roasts)
 	}
 
-	// Get current roast phrase
-	currentRoast := r.roasts[r.roastIndex]
+	// Get current roast phrase, as runes so multi-byte characters scroll whole
+	currentRoast := []rune(r.roasts[r.roastIndex])
 
 	// Advance scroll position
 	if now.Sub(r.lastUpdate) >= r.frameDur {
@@ -175,10 +261,17 @@ func (r *RoastingTicker) GetScrollingText(width int) string {
 		}
 	}
 
+	return marquee(currentRoast, r.offset, width)
+}
+
+// marquee returns the width characters of text visible offset steps into a
+// scroll: text enters from the right edge at offset 1 and has fully left on
+// the left at offset len(text)+width
+func marquee(text []rune, offset, width int) string {
 	// Pad text with leading/trailing spaces
-	paddedText := strings.Repeat(" ", width) + currentRoast + strings.Repeat(" ", width)
+	paddedText := []rune(strings.Repeat(" ", width) + string(text) + strings.Repeat(" ", width))
 
-	start := r.offset
+	start := offset
 	end := start + width
 
 	// Ensure we don't go out of bounds
@@ -189,17 +282,10 @@ func (r *RoastingTicker) GetScrollingText(width int) string {
 		return strings.Repeat(" ", width)
 	}
 
-	result := paddedText[start:end]
-
-	// Force exact width, truncate if needed
-	// Ensure exactly width characters (pad or truncate)
-	runes := []rune(result)
-	if len(runes) > width {
-		// Truncate to exact width
-		result = string(runes[:width])
-	} else if len(runes) < width {
-		// Pad to exact width
-		result += strings.Repeat(" ", width-len(runes))
+	// Ensure exactly width characters (pad if the slice ran short)
+	result := string(paddedText[start:end])
+	if n := end - start; n < width {
+		result += strings.Repeat(" ", width-n)
 	}
 
 	return result
@@ -207,108 +293,110 @@ func (r *RoastingTicker) GetScrollingText(width int) string {
 
 // WM roast messages - funny quotes about each window manager
 // Expanded roasts with community feedback
-func getRoastForWM(wmName string) string {
-	roasts := map[string]string{
-		// GNOME - The Great Destroyer
-		"GNOME": "GNOME: Removing features since 3.0 │ " +
-			"If a soyboy was a desktop environment │ Where customization goes to die │ " +
-			"If Lenin wore programming socks │ 'We know better than you' - The Desktop │ " +
-			"Where Linux development meets ideology │ " +
-			"System tray? Never heard of her │ Extensions break every update │ " +
-			"Minimizing windows is a power user feature │ GNOME devs: Making users migrate to KDE since 2011 │ " +
-			"Eulogizing legacy code as toxic masculinity since 2023 │ " +
-			"We fixed your workflow by removing options you didn't know you hated │ " +
-			"Your right-click was problematic; consider it canceled │ " +
-			"We liberated your desktop from X11's toxic flexibility │ " +
-			"Dynamic workspaces: Dynamic enough to disappear when you need them most │ " +
-			"Dynamic theming: Themes that adapt to your apps, by breaking them│ " +
-			"Open-source openness: Openly open to cancelling devs we dislike │ " +
-			"Why is all my text aligning left? │ " +
-			"False consciousness in flat design │ " +
-			"Dunning Kruger neckbeards conflating political praxis with programming, many such cases│ ",
-
-		"GNOME on Wayland": "GNOME on Wayland: Breaking what never worked │ " +
-			"Screen sharing? That's a premium feature │ Variable refresh rate? Too advanced for you │ ",
-
-		// KDE Plasma
-		"KDE": "KDE: 5000 settings, 4950 you'll never use │ Bloatware masquerading as customization │ " +
-			"RAM is cheap, right? RIGHT?! │ 47 daemons running to display a wallpaper │ " +
-			"'Lightweight' said no one ever │ Akonadi has entered the chat (and consumed 2GB RAM) │ " +
-			"KDE: When you want Windows-level resource usage on Linux │ " +
-			"Breaking theming since Plasma 5 │ 15 different ways to crash kwin │ " +
-			"Customization so deep, you'll need a map—and a PhD │ " +
-			"Your desktop, now with 57 shades of widget—pick wisely │ ",
-
-		"KDE Plasma": "KDE Plasma: Baloo indexing your soul at 100% CPU │ " +
-			"Settings menu has settings for the settings │ Compositor crashed? Just restart it for the 5th time today │ " +
-			"Customization so deep, you'll need a map—and a PhD │ " +
-			"Your desktop, now with 57 shades of widget—pick wisely │ ",
-
-		// The Chaotic Ones
-		"Hyprland": ">install hyprland update >breaks again >downgrade again >repeat │ Breaking configs since yesterday │ " +
-			"It really isn't that bad │ Animations over stability every time │ " +
-			"'Let me just rewrite this core system real quick' - Vaxry │ " +
-			"Your config worked yesterday? Not anymore! │ Git blame Vaxry │ " +
-			"Voted number one tiling manager on Reddit 2023-2024 │ " +
-			"The tiktok tiling manager - swipe right on stability │ ",
-
-		// The Perfect
-		"niri": "niri: Perfection in compositor form │ Scrollable tiling done right │ " +
-			"No bugs, only features │ The chosen one │ Russian excellence │ " +
-			"Because finite desktops were too confining │ ",
-
-		"NIRI": "NIRI: Perfection in compositor form │ Scrollable tiling done right │ " +
-			"No bugs, only features │ The chosen one │ Russian excellence │ " +
-			"Because finite desktops were too confining │ ",
-
-		// The Actually Good Ones
-		"XFCE": "XFCE: The best desktop environment, period │ Lightweight, stable, customizable │ " +
-			"Doesn't remove features you love │ Doesn't consume 4GB RAM │ " +
-			"XFCE: Quietly being perfect while GNOME implodes │ GTK's last stand │ ",
-
-		"Sway": "Sway: i3 but we pretend X11 never existed │ Minimalism with Wayland pain │ ",
-		"i3": "i3: Tiling before it was cool (and bloated) │ The last WM that just works │ X11 gang represent │ " +
-			"Status bars? Slap on i3bar.. │ ",
-		"AwesomeWM": "AwesomeWM: Lua configs because sanity is overrated │ ",
-		"awesome":   "Awesome: Lua configs because XML wasn't painful enough │ ",
-
-		// The Memes
-		"dwm": "dwm: Recompile to change wallpaper │ Suckless: Because git patches are a lifestyle │ " +
-			"Actually pretty based │ ",
-		"bspwm": "bspwm: For when you want to write more shell scripts │ Binary space partitioning your sanity │ " +
-			"Wayland? Over our dead keyboard shortcuts │ ",
-		"qtile": "Qtile: Python configs for people who can't C │ ",
-		"xmonad": "Xmonad: Haskell - because learning WM config should require a PhD │ " +
-			"Recompile to apply config │ Monads in your window manager │ ",
-
-		// The Forgotten Ones
-		"Openbox":       "Openbox: The WM your grandma uses │ Right-click: The desktop experience │ ",
-		"Fluxbox":       "Fluxbox: Like Openbox but with more Y2K vibes │ ",
-		"Enlightenment": "Enlightenment: Still waiting for E17 │ Remember when this was the future? │ ",
-
-		// GNOME Forks (GNOME 2 refugees)
-		"Cinnamon": "Cinnamon: GNOME 2 cosplay │ Mint's apology for GNOME 3 │ " +
-			"The number one voted desktop for Windows users │ ",
-		"MATE":   "MATE: GNOME 2 but we actually mean it │ Keeping the dream alive │ ",
-		"Budgie": "Budgie: Solus says 'we can fix GNOME' │ Narrator: They couldn't │ ",
-
-		// The Lightweights
-		"LXQt": "LXQt: LXDE but now with more Q's │ Qt's lightweight cousin │ ",
-		"LXDE": "LXDE: For when your potato is actually a potato │ ",
-
-		// Wayland Pioneers
-		"Wayfire": "Wayfire: Compiz nostalgia in Wayland │ Spinning cube! │ ",
-		"River":   "River: Minimalism meets Zig │ For people who think Sway has too many features │ ",
-
-		// The Tilers
-		"leftwm": "LeftWM: Rust btw │ Tiling for people who read r/unixporn │ " +
-			"A rust tiling manager......................... │ ",
-		"Herbstluftwm": "Herbstluftwm: German engineering applied to window management │ ",
-
-		// Gaming WMs
-		"Gamescope": "Gamescope: For when you game more than you configure │ ",
-	}
+var wmRoasts = map[string]string{
+	// GNOME - The Great Destroyer
+	"GNOME": "GNOME: Removing features since 3.0 │ " +
+		"If a soyboy was a desktop environment │ Where customization goes to die │ " +
+		"If Lenin wore programming socks │ 'We know better than you' - The Desktop │ " +
+		"Where Linux development meets ideology │ " +
+		"System tray? Never heard of her │ Extensions break every update │ " +
+		"Minimizing windows is a power user feature │ GNOME devs: Making users migrate to KDE since 2011 │ " +
+		"Eulogizing legacy code as toxic masculinity since 2023 │ " +
+		"We fixed your workflow by removing options you didn't know you hated │ " +
+		"Your right-click was problematic; consider it canceled │ " +
+		"We liberated your desktop from X11's toxic flexibility │ " +
+		"Dynamic workspaces: Dynamic enough to disappear when you need them most │ " +
+		"Dynamic theming: Themes that adapt to your apps, by breaking them│ " +
+		"Open-source openness: Openly open to cancelling devs we dislike │ " +
+		"Why is all my text aligning left? │ " +
+		"False consciousness in flat design │ " +
+		"Dunning Kruger neckbeards conflating political praxis with programming, many such cases│ ",
+
+	"GNOME on Wayland": "GNOME on Wayland: Breaking what never worked │ " +
+		"Screen sharing? That's a premium feature │ Variable refresh rate? Too advanced for you │ ",
+
+	// KDE Plasma
+	"KDE": "KDE: 5000 settings, 4950 you'll never use │ Bloatware masquerading as customization │ " +
+		"RAM is cheap, right? RIGHT?! │ 47 daemons running to display a wallpaper │ " +
+		"'Lightweight' said no one ever │ Akonadi has entered the chat (and consumed 2GB RAM) │ " +
+		"KDE: When you want Windows-level resource usage on Linux │ " +
+		"Breaking theming since Plasma 5 │ 15 different ways to crash kwin │ " +
+		"Customization so deep, you'll need a map—and a PhD │ " +
+		"Your desktop, now with 57 shades of widget—pick wisely │ ",
+
+	"KDE Plasma": "KDE Plasma: Baloo indexing your soul at 100% CPU │ " +
+		"Settings menu has settings for the settings │ Compositor crashed? Just restart it for the 5th time today │ " +
+		"Customization so deep, you'll need a map—and a PhD │ " +
+		"Your desktop, now with 57 shades of widget—pick wisely │ ",
+
+	// The Chaotic Ones
+	"Hyprland": ">install hyprland update >breaks again >downgrade again >repeat │ Breaking configs since yesterday │ " +
+		"It really isn't that bad │ Animations over stability every time │ " +
+		"'Let me just rewrite this core system real quick' - Vaxry │ " +
+		"Your config worked yesterday? Not anymore! │ Git blame Vaxry │ " +
+		"Voted number one tiling manager on Reddit 2023-2024 │ " +
+		"The tiktok tiling manager - swipe right on stability │ ",
+
+	// The Perfect
+	"niri": "niri: Perfection in compositor form │ Scrollable tiling done right │ " +
+		"No bugs, only features │ The chosen one │ Russian excellence │ " +
+		"Because finite desktops were too confining │ ",
+
+	"NIRI": "NIRI: Perfection in compositor form │ Scrollable tiling done right │ " +
+		"No bugs, only features │ The chosen one │ Russian excellence │ " +
+		"Because finite desktops were too confining │ ",
+
+	// The Actually Good Ones
+	"XFCE": "XFCE: The best desktop environment, period │ Lightweight, stable, customizable │ " +
+		"Doesn't remove features you love │ Doesn't consume 4GB RAM │ " +
+		"XFCE: Quietly being perfect while GNOME implodes │ GTK's last stand │ ",
+
+	"Sway": "Sway: i3 but we pretend X11 never existed │ Minimalism with Wayland pain │ ",
+	"i3": "i3: Tiling before it was cool (and bloated) │ The last WM that just works │ X11 gang represent │ " +
+		"Status bars? Slap on i3bar.. │ ",
+	"AwesomeWM": "AwesomeWM: Lua configs because sanity is overrated │ ",
+	"awesome":   "Awesome: Lua configs because XML wasn't painful enough │ ",
+
+	// The Memes
+	"dwm": "dwm: Recompile to change wallpaper │ Suckless: Because git patches are a lifestyle │ " +
+		"Actually pretty based │ ",
+	"bspwm": "bspwm: For when you want to write more shell scripts │ Binary space partitioning your sanity │ " +
+		"Wayland? Over our dead keyboard shortcuts │ ",
+	"qtile": "Qtile: Python configs for people who can't C │ ",
+	"xmonad": "Xmonad: Haskell - because learning WM config should require a PhD │ " +
+		"Recompile to apply config │ Monads in your window manager │ ",
+
+	// The Forgotten Ones
+	"Openbox":       "Openbox: The WM your grandma uses │ Right-click: The desktop experience │ ",
+	"Fluxbox":       "Fluxbox: Like Openbox but with more Y2K vibes │ ",
+	"Enlightenment": "Enlightenment: Still waiting for E17 │ Remember when this was the future? │ ",
+
+	// GNOME Forks (GNOME 2 refugees)
+	"Cinnamon": "Cinnamon: GNOME 2 cosplay │ Mint's apology for GNOME 3 │ " +
+		"The number one voted desktop for Windows users │ ",
+	"MATE":   "MATE: GNOME 2 but we actually mean it │ Keeping the dream alive │ ",
+	"Budgie": "Budgie: Solus says 'we can fix GNOME' │ Narrator: They couldn't │ ",
+
+	// The Lightweights
+	"LXQt": "LXQt: LXDE but now with more Q's │ Qt's lightweight cousin │ ",
+	"LXDE": "LXDE: For when your potato is actually a potato │ ",
+
+	// Wayland Pioneers
+	"Wayfire": "Wayfire: Compiz nostalgia in Wayland │ Spinning cube! │ ",
+	"River":   "River: Minimalism meets Zig │ For people who think Sway has too many features │ ",
+
+	// The Tilers
+	"leftwm": "LeftWM: Rust btw │ Tiling for people who read r/unixporn │ " +
+		"A rust tiling manager......................... │ ",
+	"Herbstluftwm": "Herbstluftwm: German engineering applied to window management │ ",
+
+	// Gaming WMs
+	"Gamescope": "Gamescope: For when you game more than you configure │ ",
+}
 
+// matchRoast finds the roast for wmName, trying an exact then a partial
+// case-insensitive match of the cleaned-up name
+func matchRoast(roasts map[string]string, wmName string) (string, bool) {
 	// Improved WM name matching with regex cleanup
 	// Clean up the WM name - remove parentheses, "Session", "on", etc.
 	cleanedName := wmName
@@ -319,10 +407,15 @@ func getRoastForWM(wmName string) string {
 	cleanedName = strings.TrimSpace(cleanedName)
 	wmLower := strings.ToLower(cleanedName)
 
+	// An empty name would partially match every key
+	if wmLower == "" {
+		return "", false
+	}
+
 	// First try exact match
 	for key, roast := range roasts {
 		if strings.ToLower(key) == wmLower {
-			return roast
+			return roast, true
 		}
 	}
 
@@ -330,17 +423,17 @@ func getRoastForWM(wmName string) string {
 	for key, roast := range roasts {
 		keyLower := strings.ToLower(key)
 		if strings.Contains(wmLower, keyLower) || strings.Contains(keyLower, wmLower) {
-			return roast
+			return roast, true
 		}
 	}
 
-	// Default roast for unknown WMs
-	return "Your WM: So obscure even I don't have a roast for it │ "
+	return "", false
 }
 
 // TypewriterTicker types out text one character at a time with a block cursor
 // This provides a "typewriter" effect for the roast messages
 type TypewriterTicker struct {
+	table        roastTable    // Where roast messages come from
 	roasts       []string      // All roast messages
 	currentWM    string        // Current WM name
 	roastIndex   int           // Current message index
@@ -354,8 +447,19 @@ type TypewriterTicker struct {
 
 // NewTypewriterTicker creates a new typewriter ticker
 func NewTypewriterTicker(wmName string) *TypewriterTicker {
+	return newTypewriterTicker(builtinRoasts(), wmName)
+}
+
+// NewTypewriterTickerWithRoasts creates a typewriter ticker over a custom table,
+// see NewRoastingTickerWithRoasts
+func NewTypewriterTickerWithRoasts(roasts map[string]string) *TypewriterTicker {
+	return newTypewriterTicker(roastTable{entries: roasts}, "")
+}
+
+func newTypewriterTicker(table roastTable, wmName string) *TypewriterTicker {
 	return &TypewriterTicker{
-		roasts:       splitRoasts(getRoastForWM(wmName)),
+		table:        table,
+		roasts:       table.phrases(wmName),
 		currentWM:    wmName,
 		roastIndex:   0,
 		charIndex:    0,
@@ -367,18 +471,32 @@ func NewTypewriterTicker(wmName string) *TypewriterTicker {
 	}
 }
 
-// UpdateWM changes the roast text when WM selection changes
+// UpdateWM changes the roast text when WM selection changes.
+// With a custom table wmName is simply the key to show.
 func (t *TypewriterTicker) UpdateWM(wmName string) {
 	if wmName != t.currentWM {
-		t.roasts = splitRoasts(getRoastForWM(wmName))
 		t.currentWM = wmName
-		t.roastIndex = 0
-		t.charIndex = 0
-		t.paused = false
-		t.lastUpdate = time.Now()
+		t.restart()
 	}
 }
 
+// SetDefaultRoast sets the │-separated phrases shown for keys missing from
+// the table, replacing the built-in line about obscure window managers
+func (t *TypewriterTicker) SetDefaultRoast(roast string) {
+	t.table.fallback = roast
+	t.table.labeled = false
+	t.restart()
+}
+
+// restart reloads the messages for the current key and starts typing the first
+func (t *TypewriterTicker) restart() {
+	t.roasts = t.table.phrases(t.currentWM)
+	t.roastIndex = 0
+	t.charIndex = 0
+	t.paused = false
+	t.lastUpdate = time.Now()
+}
+
 // GetTypewriterText returns the current typewriter text with block cursor
 func (t *TypewriterTicker) GetTypewriterText(width int) string {
 	now := time.Now()
@@ -388,14 +506,7 @@ func (t *TypewriterTicker) GetTypewriterText(width int) string {
 		if now.Before(t.pauseUntil) {
 			// Still paused - show complete message
 			if len(t.roasts) > 0 {
-				message := t.roasts[t.roastIndex]
-				if len(message) <= width {
-					// Center the message
-					padding := (width - len(message)) / 2
-					return strings.Repeat(" ", padding) + message + strings.Repeat(" ", width-len(message)-padding)
-				}
-				// Truncate if too long
-				return message[:width]
+				return centerRunes([]rune(t.roasts[t.roastIndex]), width)
 			}
 			return strings.Repeat(" ", width)
 		}
@@ -412,7 +523,7 @@ func (t *TypewriterTicker) GetTypewriterText(width int) string {
 			return strings.Repeat(" ", width)
 		}
 
-		currentMessage := t.roasts[t.roastIndex]
+		currentMessage := []rune(t.roasts[t.roastIndex])
 
 		// Check if message is complete
 		if t.charIndex >= len(currentMessage) {
@@ -420,11 +531,7 @@ func (t *TypewriterTicker) GetTypewriterText(width int) string {
 			t.paused = true
 			t.pauseUntil = now.Add(t.messageDelay)
 			// Return complete message (will be displayed during pause)
-			if len(currentMessage) <= width {
-				padding := (width - len(currentMessage)) / 2
-				return strings.Repeat(" ", padding) + currentMessage + strings.Repeat(" ", width-len(currentMessage)-padding)
-			}
-			return currentMessage[:width]
+			return centerRunes(currentMessage, width)
 		}
 
 		// Type next character
@@ -437,22 +544,19 @@ func (t *TypewriterTicker) GetTypewriterText(width int) string {
 		return strings.Repeat(" ", width)
 	}
 
-	currentMessage := t.roasts[t.roastIndex]
-	typedText := currentMessage[:t.charIndex]
+	// charIndex counts runes, so typing never splits a multi-byte character
+	currentMessage := []rune(t.roasts[t.roastIndex])
+	typedText := currentMessage[:min(t.charIndex, len(currentMessage))]
 
 	// Add block cursor (█)
-	result := typedText + "█"
-
-	// Center it if it fits
-	if len(result) <= width {
-		padding := (width - len(result)) / 2
-		return strings.Repeat(" ", padding) + result + strings.Repeat(" ", width-len(result)-padding)
-	}
+	return centerRunes(append(typedText, '█'), width)
+}
 
-	// If too long, truncate (shouldn't happen with proper width)
-	if len(result) > width {
-		return result[:width]
+// centerRunes centers text in width characters, truncating it if it doesn't fit
+func centerRunes(text []rune, width int) string {
+	if len(text) > width {
+		return string(text[:max(width, 0)])
 	}
-
-	return result
+	padding := (width - len(text)) / 2
+	return strings.Repeat(" ", padding) + string(text) + strings.Repeat(" ", width-len(text)-padding)
 }
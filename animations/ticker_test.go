@@ -0,0 +1,120 @@
+package animations
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestLoadRoasts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tips.json")
+	data := `{"tips": ["Press Q to quit", "Try -theme nord"], "quotes": "Stay hungry │ Stay foolish"}`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	roasts, err := LoadRoasts(path)
+	if err != nil {
+		t.Fatalf("LoadRoasts failed: %v", err)
+	}
+	if got := roasts["tips"]; got != "Press Q to quit │ Try -theme nord" {
+		t.Errorf("tips = %q", got)
+	}
+	if got := roasts["quotes"]; got != "Stay hungry │ Stay foolish" {
+		t.Errorf("quotes = %q", got)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"tips": 3}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadRoasts(path); err == nil {
+		t.Error("LoadRoasts accepted a number value")
+	}
+}
+
+func TestRoastingTickerWithRoasts(t *testing.T) {
+	ticker := NewRoastingTickerWithRoasts(map[string]string{"tips": "Tip: press Q │ Tip: try nord"})
+	if len(ticker.roasts) != 0 {
+		t.Errorf("ticker without a key has roasts %q", ticker.roasts)
+	}
+
+	ticker.UpdateWM("tips")
+	slices.Sort(ticker.roasts)
+	// Custom tables keep their first phrase intact
+	if want := []string{"Tip: press Q", "Tip: try nord"}; !slices.Equal(ticker.roasts, want) {
+		t.Errorf("roasts = %q, want %q", ticker.roasts, want)
+	}
+
+	ticker.SetDefaultRoast("Nothing to see here")
+	ticker.UpdateWM("unknown")
+	if want := []string{"Nothing to see here"}; !slices.Equal(ticker.roasts, want) {
+		t.Errorf("roasts for unknown key = %q, want %q", ticker.roasts, want)
+	}
+}
+
+// multiByteRoast mixes 3-byte and 4-byte runes that byte slicing would split
+const multiByteRoast = "hot │ take 🔥"
+
+func TestRoastingTicker_MultiByteRunes(t *testing.T) {
+	const width = 10
+	ticker := NewRoastingTickerWithRoasts(nil)
+	ticker.roasts = []string{multiByteRoast}
+
+	var frames []string
+	for offset := 0; offset < utf8.RuneCountInString(multiByteRoast)+width; offset++ {
+		ticker.offset = offset
+		ticker.lastUpdate = time.Now() // Hold the offset for this frame
+		frame := ticker.GetScrollingText(width)
+		if !utf8.ValidString(frame) {
+			t.Fatalf("offset %d: invalid UTF-8 %q", offset, frame)
+		}
+		if n := utf8.RuneCountInString(frame); n != width {
+			t.Fatalf("offset %d: %q is %d runes, want %d", offset, frame, n, width)
+		}
+		frames = append(frames, frame)
+	}
+
+	if want := "   hot │ t"; frames[7] != want {
+		t.Errorf("frame 7 = %q, want %q", frames[7], want)
+	}
+}
+
+func TestTypewriterTicker_MultiByteRunes(t *testing.T) {
+	const width = 20
+	ticker := NewTypewriterTickerWithRoasts(nil)
+	ticker.roasts = []string{multiByteRoast}
+
+	// Type the whole message one rune at a time
+	for i := 0; i <= utf8.RuneCountInString(multiByteRoast); i++ {
+		ticker.lastUpdate = time.Now().Add(-time.Second)
+		frame := ticker.GetTypewriterText(width)
+		if !utf8.ValidString(frame) {
+			t.Fatalf("step %d: invalid UTF-8 %q", i, frame)
+		}
+		if n := utf8.RuneCountInString(frame); n != width {
+			t.Fatalf("step %d: %q is %d runes, want %d", i, frame, n, width)
+		}
+	}
+
+	// The complete message is centered while paused
+	if !ticker.paused {
+		t.Fatal("ticker didn't pause after typing the whole message")
+	}
+	frame := ticker.GetTypewriterText(width)
+	padding := (width - utf8.RuneCountInString(multiByteRoast)) / 2
+	if want := strings.Repeat(" ", padding) + multiByteRoast; !strings.HasPrefix(frame, want) {
+		t.Errorf("paused frame = %q, want it to start with %q", frame, want)
+	}
+	if n := utf8.RuneCountInString(frame); n != width {
+		t.Errorf("paused frame is %d runes, want %d", n, width)
+	}
+
+	// Too long messages are cut on a rune boundary
+	if got := ticker.GetTypewriterText(8); got != "hot │ ta" {
+		t.Errorf("truncated frame = %q", got)
+	}
+}
@@ -0,0 +1,56 @@
+package animations
+
+import "github.com/mattn/go-runewidth"
+
+// widths measures runes with East Asian ambiguous characters, like box
+// drawing and block elements, as narrow whatever the locale, the way ASCII
+// art using them is drawn
+var widths = &runewidth.Condition{EastAsianWidth: false}
+
+// runeWidth returns how many cells a rune takes on screen: 2 for full-width
+// glyphs like CJK and most emoji, 1 for everything else. Zero-width runes
+// such as combining marks still get a cell of their own, since every rune of
+// text is placed in its own cell.
+func runeWidth(r rune) int {
+	if widths.RuneWidth(r) == 2 {
+		return 2
+	}
+	return 1
+}
+
+// displayWidth returns how many cells s takes on screen, rune by rune as
+// text effects place it
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// putCell draws cell at x in row. A full-width glyph also covers the cell to
+// its right with WideFill, and is left out if that cell is past the end of
+// the row. Glyphs it partly overwrites are blanked, so the row always takes
+// exactly len(row) columns on screen.
+func putCell(row []Cell, x int, cell Cell) {
+	if x < 0 || x >= len(row) {
+		return
+	}
+	width := runeWidth(cell.Rune)
+	if x+width > len(row) {
+		return
+	}
+
+	// Blank the halves of wide glyphs this one cuts through
+	if row[x].Rune == WideFill && x > 0 {
+		row[x-1] = Cell{Rune: ' '}
+	}
+	if end := x + width; end < len(row) && row[end].Rune == WideFill {
+		row[end] = Cell{Rune: ' '}
+	}
+
+	row[x] = cell
+	if width == 2 {
+		row[x+1] = Cell{Rune: WideFill}
+	}
+}
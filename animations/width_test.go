@@ -0,0 +1,68 @@
+package animations
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"hello", 5},
+		{"é│█", 3},
+		{"日本", 4},
+		{"hi 🔥", 5},
+	}
+	for _, tt := range tests {
+		if got := displayWidth(tt.text); got != tt.want {
+			t.Errorf("displayWidth(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestPutCell_WideGlyphs(t *testing.T) {
+	row := newCellGrid(4, 1)[0]
+	putCell(row, 0, Cell{Rune: '日'})
+	putCell(row, 2, Cell{Rune: '本'})
+	if row[1].Rune != WideFill || row[3].Rune != WideFill {
+		t.Fatalf("wide glyphs not followed by WideFill: %q", string([]rune{row[0].Rune, row[1].Rune, row[2].Rune, row[3].Rune}))
+	}
+
+	// A narrow glyph over the right half of 日 blanks its left half
+	putCell(row, 1, Cell{Rune: 'x'})
+	if row[0].Rune != ' ' || row[1].Rune != 'x' {
+		t.Errorf("cut wide glyph left as %q%q", row[0].Rune, row[1].Rune)
+	}
+
+	// A wide glyph that doesn't fit at the end of the row is left out
+	putCell(row, 3, Cell{Rune: '語'})
+	if row[3].Rune != WideFill {
+		t.Errorf("wide glyph drawn past the end of the row")
+	}
+}
+
+func TestDecryptEffect_CentersEmoji(t *testing.T) {
+	// "hi 🔥" is 5 columns wide, so it starts at column 3 of 11
+	effect := NewDecryptEffect(DecryptConfig{
+		Width:            11,
+		Height:           1,
+		Text:             "hi 🔥",
+		TypingSpeed:      1,
+		CiphertextColors: []string{"#00ff00"},
+	})
+
+	want := []int{3, 4, 5, 6}
+	for i, char := range effect.chars {
+		if char.x != want[i] {
+			t.Errorf("char %d (%q): got column %d, want %d", i, char.original, char.x, want[i])
+		}
+		effect.chars[i].visible = true
+	}
+
+	row := effect.RenderCells()[0]
+	if row[6].Rune != '🔥' || row[7].Rune != WideFill {
+		t.Errorf("emoji drawn as %q%q, want it followed by WideFill", row[6].Rune, row[7].Rune)
+	}
+	if row[8].Rune != ' ' {
+		t.Errorf("cell after the emoji is %q, want it blank", row[8].Rune)
+	}
+}
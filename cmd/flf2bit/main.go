@@ -3,11 +3,13 @@ package main
 import (
 	"bufio"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // BitFont represents the .bit font format
@@ -33,28 +35,68 @@ type FIGletFont struct {
 	Comments     []string
 }
 
+// convertOptions controls how glyph lines are cleaned up during conversion
+type convertOptions struct {
+	keepHardblank bool // Keep the hardblank character instead of turning it into a space
+	noTrim        bool // Keep trailing spaces on glyph lines
+}
+
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: flf2bit <figlet-font.flf> [output.bit]")
-		fmt.Println("Converts FIGlet .flf fonts to .bit JSON format")
+	keepHardblank := flag.Bool("keep-hardblank", false, "Keep the font's hardblank character instead of converting it to a space")
+	noTrim := flag.Bool("no-trim", false, "Keep trailing spaces on glyph lines")
+	reverse := flag.Bool("reverse", false, "Convert a .bit font to a FIGlet .flf font instead")
+	flag.Usage = func() {
+		fmt.Println("Usage: flf2bit [options] <figlet-font.flf> [output.bit]")
+		fmt.Println("       flf2bit -reverse <font.bit> [output.flf]")
+		fmt.Println("Converts FIGlet .flf fonts to .bit JSON format and back")
+		fmt.Println()
+		fmt.Println("Options:")
+		fmt.Println("  -keep-hardblank  Keep the hardblank character instead of a space")
+		fmt.Println("  -no-trim         Keep trailing spaces on glyph lines")
+		fmt.Println("  -reverse         Convert .bit to .flf for use with figlet/toilet")
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
 		os.Exit(1)
 	}
 
-	inputPath := os.Args[1]
+	inputPath := flag.Arg(0)
 	outputPath := ""
-	if len(os.Args) > 2 {
-		outputPath = os.Args[2]
+	if flag.NArg() > 1 {
+		outputPath = flag.Arg(1)
 	} else {
 		// Auto-generate output name
 		base := filepath.Base(inputPath)
 		name := strings.TrimSuffix(base, filepath.Ext(base))
 		outputPath = name + ".bit"
+		if *reverse {
+			outputPath = name + ".flf"
+		}
 	}
 
 	fmt.Printf("Converting %s to %s...\n", inputPath, outputPath)
 
+	if *reverse {
+		font, err := loadBitFont(inputPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading .bit font: %v\n", err)
+			os.Exit(1)
+		}
+		if err := os.WriteFile(outputPath, []byte(formatFIGletFont(font)), 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing FIGlet font: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Successfully converted! %d characters\n", len(font.Characters))
+		return
+	}
+
 	// Parse FIGlet font
-	font, err := parseFIGletFont(inputPath)
+	font, err := parseFIGletFont(inputPath, convertOptions{
+		keepHardblank: *keepHardblank,
+		noTrim:        *noTrim,
+	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error parsing FIGlet font: %v\n", err)
 		os.Exit(1)
@@ -69,7 +111,7 @@ func main() {
 	fmt.Printf("Successfully converted! %d characters\n", len(font.Characters))
 }
 
-func parseFIGletFont(path string) (*BitFont, error) {
+func parseFIGletFont(path string, opts convertOptions) (*BitFont, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, err
@@ -123,21 +165,76 @@ func parseFIGletFont(path string) (*BitFont, error) {
 		char := string(rune(ascii))
 		lines, err := readCharacter(scanner, meta)
 		if err != nil {
-			// If we can't read a character, skip it
-			continue
+			// Truncated font, keep what was read
+			return bitFont, nil
 		}
 
 		if len(lines) > 0 {
-			// Clean up lines (remove hardblank, trim trailing spaces)
-			cleaned := make([]string, len(lines))
-			for i, line := range lines {
-				cleaned[i] = strings.ReplaceAll(line, string(meta.Hardblank), " ")
-			}
-			bitFont.Characters[char] = cleaned
+			bitFont.Characters[char] = cleanLines(lines, meta.Hardblank, opts)
+		}
+	}
+
+	// FIGlet 2 fonts follow ASCII with the German characters; fonts that
+	// don't draw them leave the glyphs empty
+	for _, r := range deutschChars {
+		lines, err := readCharacter(scanner, meta)
+		if err != nil {
+			return bitFont, nil
+		}
+		if !isEmptyGlyph(lines) {
+			bitFont.Characters[string(r)] = cleanLines(lines, meta.Hardblank, opts)
+		}
+	}
+
+	// Code-tagged characters run to the end of the file, each glyph preceded
+	// by a line like "0x00C4  LATIN CAPITAL LETTER A WITH DIAERESIS"
+	for scanner.Scan() {
+		tag := strings.TrimSpace(scanner.Text())
+		if tag == "" {
+			continue
+		}
+
+		lines, err := readCharacter(scanner, meta)
+		if err != nil {
+			break
 		}
+
+		// Negative codes are only reachable through FIGlet control files
+		code, ok := parseCodeTag(tag)
+		if !ok || code < 0 || !utf8.ValidRune(rune(code)) {
+			continue
+		}
+		bitFont.Characters[string(rune(code))] = cleanLines(lines, meta.Hardblank, opts)
 	}
 
-	return bitFont, nil
+	return bitFont, scanner.Err()
+}
+
+// deutschChars are the glyphs every FIGlet 2 font defines after ASCII 126
+var deutschChars = []rune{'Ä', 'Ö', 'Ü', 'ä', 'ö', 'ü', 'ß'}
+
+// isEmptyGlyph reports whether every line of a glyph is empty
+func isEmptyGlyph(lines []string) bool {
+	for _, line := range lines {
+		if line != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseCodeTag reads the character code at the start of a code tag line.
+// Codes may be decimal, hex with 0x or octal with a leading 0.
+func parseCodeTag(tag string) (int64, bool) {
+	fields := strings.Fields(tag)
+	if len(fields) == 0 {
+		return 0, false
+	}
+	code, err := strconv.ParseInt(fields[0], 0, 64)
+	if err != nil {
+		return 0, false
+	}
+	return code, true
 }
 
 func parseHeader(header string) (*FIGletFont, error) {
@@ -151,10 +248,10 @@ func parseHeader(header string) (*FIGletFont, error) {
 		return nil, fmt.Errorf("not a FIGlet font file")
 	}
 
-	// Extract hardblank from signature
+	// The hardblank follows the "flf2a" signature, e.g. '$' in "flf2a$"
 	hardblank := ' '
-	if len(signature) > 4 {
-		hardblank = rune(signature[4])
+	if len(signature) > 5 {
+		hardblank = []rune(signature)[5]
 	}
 
 	meta := &FIGletFont{
@@ -178,6 +275,15 @@ func parseHeader(header string) (*FIGletFont, error) {
 	if len(parts) > 5 {
 		meta.CommentLines, _ = strconv.Atoi(parts[5])
 	}
+	if len(parts) > 6 {
+		meta.PrintDir, _ = strconv.Atoi(parts[6])
+	}
+	if len(parts) > 7 {
+		meta.FullLayout, _ = strconv.Atoi(parts[7])
+	}
+	if len(parts) > 8 {
+		meta.CodetagCount, _ = strconv.Atoi(parts[8])
+	}
 
 	return meta, nil
 }
@@ -190,17 +296,45 @@ func readCharacter(scanner *bufio.Scanner, meta *FIGletFont) ([]string, error) {
 			return nil, fmt.Errorf("unexpected EOF reading character")
 		}
 
-		line := scanner.Text()
+		line := strings.TrimRight(scanner.Text(), "\r")
+		lines = append(lines, trimEndmarks(line, i == meta.Height-1))
+	}
 
-		// Remove end markers (@ or @@)
-		line = strings.TrimRight(line, "@")
+	return lines, nil
+}
 
-		// Convert to visual representation
-		// FIGlet uses space and hardblank, we want actual characters
-		lines = append(lines, line)
+// trimEndmarks removes the endmark from a glyph line. Every line ends with
+// one endmark and the glyph's last line with two, so only those are removed
+// and art that uses the endmark character itself (usually @) survives.
+func trimEndmarks(line string, last bool) string {
+	runes := []rune(line)
+	if len(runes) == 0 {
+		return line
 	}
 
-	return lines, nil
+	endmark := runes[len(runes)-1]
+	runes = runes[:len(runes)-1]
+	if last && len(runes) > 0 && runes[len(runes)-1] == endmark {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes)
+}
+
+// cleanLines trims trailing padding and turns hardblanks into spaces.
+// Padding is trimmed before hardblanks are replaced, so the spaces a font
+// marks as meaningful are kept.
+func cleanLines(lines []string, hardblank rune, opts convertOptions) []string {
+	cleaned := make([]string, len(lines))
+	for i, line := range lines {
+		if !opts.noTrim {
+			line = strings.TrimRight(line, " ")
+		}
+		if !opts.keepHardblank {
+			line = strings.ReplaceAll(line, string(hardblank), " ")
+		}
+		cleaned[i] = line
+	}
+	return cleaned
 }
 
 func writeBitFont(path string, font *BitFont) error {
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testFont defines space, '!' drawn with '@' and '"' with trailing padding
+const testFont = `flf2a$ 2 1 4 0 1
+test font by Tester
+$$@
+$$@@
+ @@@
+@ @@@
+a  @
+b $ @@
+`
+
+func writeTestFont(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.flf")
+	if err := os.WriteFile(path, []byte(testFont), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestParseFIGletFont(t *testing.T) {
+	tests := []struct {
+		name string
+		opts convertOptions
+		want map[string][]string
+	}{
+		{
+			name: "default",
+			want: map[string][]string{
+				" ":  {"  ", "  "},
+				"!":  {" @@", "@ @"},
+				"\"": {"a", "b  "},
+			},
+		},
+		{
+			name: "keep hardblank",
+			opts: convertOptions{keepHardblank: true},
+			want: map[string][]string{
+				" ":  {"$$", "$$"},
+				"!":  {" @@", "@ @"},
+				"\"": {"a", "b $"},
+			},
+		},
+		{
+			name: "no trim",
+			opts: convertOptions{noTrim: true},
+			want: map[string][]string{
+				" ":  {"  ", "  "},
+				"!":  {" @@", "@ @"},
+				"\"": {"a  ", "b   "},
+			},
+		},
+	}
+
+	path := writeTestFont(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			font, err := parseFIGletFont(path, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(font.Characters, tt.want) {
+				t.Errorf("characters = %q, want %q", font.Characters, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFIGletFont_CodeTagged(t *testing.T) {
+	// One-line glyphs: every ASCII character draws itself, the German
+	// characters are left empty except Ä, then two code-tagged glyphs follow
+	var font strings.Builder
+	font.WriteString("flf2a$ 1 1 3 0 0 0 0 2\n")
+	for ascii := 32; ascii <= 126; ascii++ {
+		font.WriteString(string(rune(ascii)) + "#\n")
+	}
+	font.WriteString("A:@@\n")
+	for i := 0; i < 6; i++ {
+		font.WriteString("@@\n")
+	}
+	font.WriteString("0x2588  FULL BLOCK\n##@@\n")
+	font.WriteString("196\nAE@@\n")
+
+	path := filepath.Join(t.TempDir(), "tagged.flf")
+	if err := os.WriteFile(path, []byte(font.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := parseFIGletFont(path, convertOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 95 ASCII glyphs, Ä, and the full block; the decimal tag redefines Ä
+	if len(parsed.Characters) != 97 {
+		t.Errorf("got %d characters, want 97", len(parsed.Characters))
+	}
+	want := map[string]string{"@": "@", "Ä": "AE", "█": "##", "ö": ""}
+	for char, line := range want {
+		lines, ok := parsed.Characters[char]
+		if line == "" {
+			if ok {
+				t.Errorf("%q: got %q, want no glyph", char, lines)
+			}
+			continue
+		}
+		if !ok || len(lines) != 1 || lines[0] != line {
+			t.Errorf("%q: got %q, want [%q]", char, lines, line)
+		}
+	}
+}
+
+func TestFormatFIGletFont_RoundTrip(t *testing.T) {
+	original, err := parseFIGletFont(writeTestFont(t), convertOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	original.Characters["█"] = []string{"##", "@@"}
+	original.Characters["x"] = []string{"x@"}
+
+	path := filepath.Join(t.TempDir(), "roundtrip.flf")
+	if err := os.WriteFile(path, []byte(formatFIGletFont(original)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	converted, err := parseFIGletFont(path, convertOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Glyphs come back padded to the font height and their own width, so
+	// compare them without trailing spaces
+	trimmed := func(lines []string) []string {
+		out := make([]string, 2)
+		for i, line := range lines {
+			out[i] = strings.TrimRight(line, " ")
+		}
+		return out
+	}
+	for char, lines := range original.Characters {
+		if char == " " {
+			continue
+		}
+		got, ok := converted.Characters[char]
+		if !ok {
+			t.Errorf("%q missing after round trip", char)
+			continue
+		}
+		if !reflect.DeepEqual(trimmed(got), trimmed(lines)) {
+			t.Errorf("%q: got %q, want %q", char, got, lines)
+		}
+	}
+	if space := converted.Characters[" "]; !reflect.DeepEqual(space, []string{"  ", "  "}) {
+		t.Errorf("space = %q, want two columns of hardblanks", space)
+	}
+}
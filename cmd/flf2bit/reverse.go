@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// hardblankCandidates are tried in order until one isn't used by any glyph
+const hardblankCandidates = "$#%&~^"
+
+// loadBitFont reads a .bit JSON font
+func loadBitFont(path string) (*BitFont, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var font BitFont
+	if err := json.Unmarshal(data, &font); err != nil {
+		return nil, err
+	}
+	if len(font.Characters) == 0 {
+		return nil, fmt.Errorf("font has no characters")
+	}
+	return &font, nil
+}
+
+// formatFIGletFont renders a .bit font as a FIGlet .flf font. Glyphs are
+// padded to a common height and to their own width, and the font uses
+// full-width layout so figlet draws them exactly as in the BIT editor.
+// ASCII 32-126 and the German characters are required by the format and
+// written empty when missing; every other character becomes a code-tagged glyph.
+func formatFIGletFont(font *BitFont) string {
+	height := 1
+	for _, lines := range font.Characters {
+		height = max(height, len(lines))
+	}
+
+	hardblank := pickHardblank(font)
+
+	// Pad every glyph to its full height and width before measuring the font
+	glyphs := make(map[rune][]string, len(font.Characters))
+	maxLength := 0
+	for char, lines := range font.Characters {
+		r, size := utf8.DecodeRuneInString(char)
+		if r == utf8.RuneError || size != len(char) {
+			continue
+		}
+		glyphs[r] = padGlyph(lines, height)
+		if len(glyphs[r]) > 0 {
+			maxLength = max(maxLength, utf8.RuneCountInString(glyphs[r][0]))
+		}
+	}
+
+	// Space needs hardblanks so it keeps its width; give it a default if it has none
+	if space := glyphs[' ']; len(space) == 0 || utf8.RuneCountInString(space[0]) == 0 {
+		glyphs[' '] = padGlyph([]string{"  "}, height)
+		maxLength = max(maxLength, 2)
+	}
+	for i, line := range glyphs[' '] {
+		glyphs[' '][i] = strings.ReplaceAll(line, " ", string(hardblank))
+	}
+
+	required := make([]rune, 0, 95+len(deutschChars))
+	for ascii := rune(32); ascii <= 126; ascii++ {
+		required = append(required, ascii)
+	}
+	required = append(required, deutschChars...)
+
+	isRequired := make(map[rune]bool, len(required))
+	for _, r := range required {
+		isRequired[r] = true
+	}
+	var tagged []rune
+	for r := range glyphs {
+		if !isRequired[r] {
+			tagged = append(tagged, r)
+		}
+	}
+	sort.Slice(tagged, func(i, j int) bool { return tagged[i] < tagged[j] })
+
+	comments := []string{
+		fmt.Sprintf("%s by %s", font.Name, font.Author),
+		font.License,
+		"Converted from .bit by flf2bit -reverse",
+	}
+
+	var out strings.Builder
+	// Header: signature+hardblank, height, baseline, max length, old layout
+	// (-1 = full width), comment lines, print direction, full layout, code tags
+	fmt.Fprintf(&out, "flf2a%c %d %d %d -1 %d 0 0 %d\n",
+		hardblank, height, height, maxLength+2, len(comments), len(tagged))
+	for _, comment := range comments {
+		out.WriteString(comment + "\n")
+	}
+
+	for _, r := range required {
+		writeGlyph(&out, glyphs[r], height)
+	}
+	for _, r := range tagged {
+		fmt.Fprintf(&out, "0x%04X\n", r)
+		writeGlyph(&out, glyphs[r], height)
+	}
+
+	return out.String()
+}
+
+// pickHardblank returns a hardblank character that no glyph uses
+func pickHardblank(font *BitFont) rune {
+	for _, candidate := range hardblankCandidates {
+		used := false
+		for _, lines := range font.Characters {
+			for _, line := range lines {
+				if strings.ContainsRune(line, candidate) {
+					used = true
+				}
+			}
+		}
+		if !used {
+			return candidate
+		}
+	}
+	return '\x7f'
+}
+
+// padGlyph pads lines to height rows of equal width, adding rows at the bottom
+func padGlyph(lines []string, height int) []string {
+	width := 0
+	for _, line := range lines {
+		width = max(width, utf8.RuneCountInString(line))
+	}
+
+	padded := make([]string, height)
+	for i := range padded {
+		line := ""
+		if i < len(lines) {
+			line = lines[i]
+		}
+		padded[i] = line + strings.Repeat(" ", width-utf8.RuneCountInString(line))
+	}
+	return padded
+}
+
+// writeGlyph writes a glyph with '@' endmarks, doubled on the last row.
+// Rows that end in '@' use '#' instead, since figlet strips every trailing endmark.
+func writeGlyph(out *strings.Builder, lines []string, height int) {
+	if len(lines) == 0 {
+		lines = make([]string, height)
+	}
+	for i, line := range lines {
+		endmark := "@"
+		if strings.HasSuffix(line, "@") {
+			endmark = "#"
+		}
+		if i == len(lines)-1 {
+			endmark += endmark
+		}
+		out.WriteString(line + endmark + "\n")
+	}
+}
@@ -1,10 +1,14 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"runtime"
 	"strings"
 	"time"
 
@@ -69,16 +73,46 @@ type model struct {
 	spinner          spinner.Model
 	errors           []string
 	uninstallMode    bool
-	selectedOption   int // 0 = Install, 1 = Uninstall
+	updateMode       bool
+	upToDate         bool   // Update found the installed binary already current
+	selectedOption   int    // Index into options()
+	version          string // Version of the source tree about to be installed
+	commit           string // Short git commit of the source tree, if known
+	prefix           string // Install prefix, binaries go in prefix/bin
+	installed        installedBinary
+	shadow           string // Another syscgo PATH finds before the one in binDir
+	needsRoot        bool   // binDir isn't writable by the current user
+}
+
+// installedBinary is an existing syscgo found on the system
+type installedBinary struct {
+	path    string // Empty when syscgo isn't installed
+	version string
+	commit  string
 }
 
 type taskCompleteMsg struct {
-	index   int
-	success bool
-	error   string
+	index    int
+	success  bool
+	error    string
+	finished bool // Task succeeded and the remaining tasks are unnecessary
 }
 
+// errUpToDate ends an update early when the installed binary already
+// matches the source tree
+var errUpToDate = errors.New("already up to date")
+
+// Welcome screen options
+const (
+	optionInstall   = "install"
+	optionUpdate    = "update"
+	optionUninstall = "uninstall"
+)
+
 func newModel() model {
+	version, commit := sourceVersion(getProjectRoot())
+	prefix := installPrefix()
+	binDir := filepath.Join(prefix, "bin")
 	s := spinner.New()
 	s.Style = lipgloss.NewStyle().Foreground(Secondary)
 	s.Spinner = spinner.Dot
@@ -89,7 +123,22 @@ func newModel() model {
 		spinner:          s,
 		errors:           []string{},
 		selectedOption:   0,
+		version:          version,
+		commit:           commit,
+		prefix:           prefix,
+		installed:        findInstalled(binDir),
+		shadow:           shadowingBinary(binDir),
+		needsRoot:        os.Geteuid() != 0 && !canWrite(binDir),
+	}
+}
+
+// options lists the welcome screen choices, offering Update only when
+// syscgo is already installed and the build would run here
+func (m model) options() []string {
+	if m.installed.path != "" && !crossBuild() {
+		return []string{optionInstall, optionUpdate, optionUninstall}
 	}
+	return []string{optionInstall, optionUninstall}
 }
 
 func (m model) Init() tea.Cmd {
@@ -115,12 +164,14 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selectedOption--
 			}
 		case "down", "j":
-			if m.step == stepWelcome && m.selectedOption < 1 {
+			if m.step == stepWelcome && m.selectedOption < len(m.options())-1 {
 				m.selectedOption++
 			}
 		case "enter":
 			if m.step == stepWelcome {
-				m.uninstallMode = m.selectedOption == 1
+				option := m.options()[m.selectedOption]
+				m.uninstallMode = option == optionUninstall
+				m.updateMode = option == optionUpdate
 				m.initTasks()
 				m.step = stepInstalling
 				m.currentTaskIndex = 0
@@ -138,6 +189,14 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update task status
 		if msg.success {
 			m.tasks[msg.index].status = statusComplete
+			if msg.finished {
+				m.upToDate = true
+				for i := msg.index + 1; i < len(m.tasks); i++ {
+					m.tasks[i].status = statusSkipped
+				}
+				m.step = stepComplete
+				return m, nil
+			}
 		} else {
 			if m.tasks[msg.index].optional {
 				m.tasks[msg.index].status = statusSkipped
@@ -173,19 +232,35 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m *model) initTasks() {
 	if m.uninstallMode {
 		m.tasks = []installTask{
-			{name: "Check privileges", description: "Checking root access", execute: checkPrivileges, status: statusPending},
-			{name: "Remove syscgo", description: "Removing /usr/local/bin/syscgo", execute: removeSyscgoBinary, status: statusPending},
-			{name: "Remove syscgo-tui", description: "Removing /usr/local/bin/syscgo-tui", execute: removeTuiBinary, status: statusPending},
-			{name: "Remove assets", description: "Removing /usr/local/share/syscgo", execute: removeAssets, status: statusPending},
+			{name: "Check privileges", description: "Checking write access to " + m.prefix, execute: checkPrivileges, status: statusPending},
+			{name: "Remove syscgo", description: "Removing " + filepath.Join(m.binDir(), "syscgo"), execute: removeSyscgoBinary, status: statusPending},
+			{name: "Remove syscgo-tui", description: "Removing " + filepath.Join(m.binDir(), "syscgo-tui"), execute: removeTuiBinary, status: statusPending},
+			{name: "Remove assets", description: "Removing " + m.shareDir(), execute: removeAssets, status: statusPending},
+		}
+	} else if crossBuild() {
+		// A binary for another platform can't run here, so it is only built
+		m.tasks = []installTask{
+			{name: "Build syscgo", description: "Building syscgo binary for " + targetPlatform(), execute: buildBinary, status: statusPending},
+			{name: "Build syscgo-tui", description: "Building syscgo-tui binary for " + targetPlatform(), execute: buildTuiBinary, status: statusPending},
+		}
+	} else if m.updateMode {
+		m.tasks = []installTask{
+			{name: "Check privileges", description: "Checking write access to " + m.prefix, execute: checkPrivileges, status: statusPending},
+			{name: "Check installed version", description: "Comparing " + m.installed.path + " with " + m.displayVersion(), execute: checkInstalledVersion, status: statusPending},
+			{name: "Build syscgo", description: "Building syscgo binary for " + targetPlatform(), execute: buildBinary, status: statusPending},
+			{name: "Build syscgo-tui", description: "Building syscgo-tui binary for " + targetPlatform(), execute: buildTuiBinary, status: statusPending},
+			{name: "Install assets", description: "Installing assets to " + m.shareDir(), execute: installAssets, status: statusPending},
+			{name: "Install syscgo", description: "Replacing syscgo in " + m.binDir(), execute: installBinary, status: statusPending},
+			{name: "Install syscgo-tui", description: "Replacing syscgo-tui in " + m.binDir(), execute: installTuiBinary, status: statusPending},
 		}
 	} else {
 		m.tasks = []installTask{
-			{name: "Check privileges", description: "Checking root access", execute: checkPrivileges, status: statusPending},
-			{name: "Build syscgo", description: "Building syscgo binary", execute: buildBinary, status: statusPending},
-			{name: "Build syscgo-tui", description: "Building syscgo-tui binary", execute: buildTuiBinary, status: statusPending},
-			{name: "Install assets", description: "Installing assets to /usr/local/share/syscgo", execute: installAssets, status: statusPending},
-			{name: "Install syscgo", description: "Installing syscgo to /usr/local/bin", execute: installBinary, status: statusPending},
-			{name: "Install syscgo-tui", description: "Installing syscgo-tui to /usr/local/bin", execute: installTuiBinary, status: statusPending},
+			{name: "Check privileges", description: "Checking write access to " + m.prefix, execute: checkPrivileges, status: statusPending},
+			{name: "Build syscgo", description: "Building syscgo binary for " + targetPlatform(), execute: buildBinary, status: statusPending},
+			{name: "Build syscgo-tui", description: "Building syscgo-tui binary for " + targetPlatform(), execute: buildTuiBinary, status: statusPending},
+			{name: "Install assets", description: "Installing assets to " + m.shareDir(), execute: installAssets, status: statusPending},
+			{name: "Install syscgo", description: "Installing syscgo to " + m.binDir(), execute: installBinary, status: statusPending},
+			{name: "Install syscgo-tui", description: "Installing syscgo-tui to " + m.binDir(), execute: installTuiBinary, status: statusPending},
 		}
 	}
 }
@@ -252,23 +327,37 @@ func (m model) renderWelcome() string {
 
 	b.WriteString("Select an option:\n\n")
 
-	// Install option
-	installPrefix := "  "
-	if m.selectedOption == 0 {
-		installPrefix = lipgloss.NewStyle().Foreground(Primary).Render("▸ ")
-	}
-	b.WriteString(installPrefix + "Install syscgo\n")
-	b.WriteString("    Builds binary and installs system-wide to /usr/local/bin\n\n")
+	for i, option := range m.options() {
+		prefix := "  "
+		if m.selectedOption == i {
+			prefix = lipgloss.NewStyle().Foreground(Primary).Render("▸ ")
+		}
 
-	// Uninstall option
-	uninstallPrefix := "  "
-	if m.selectedOption == 1 {
-		uninstallPrefix = lipgloss.NewStyle().Foreground(Primary).Render("▸ ")
+		switch option {
+		case optionInstall:
+			b.WriteString(prefix + "Install syscgo " + m.displayVersion() + "\n")
+			if crossBuild() {
+				b.WriteString("    Builds binaries for " + targetPlatform() + " into " + getProjectRoot() + " without installing\n\n")
+			} else {
+				b.WriteString("    Builds binaries for " + targetPlatform() + " and installs to " + m.binDir() + "\n\n")
+			}
+		case optionUpdate:
+			b.WriteString(prefix + "Update syscgo " + m.installed.displayVersion() + " → " + m.displayVersion() + "\n")
+			b.WriteString("    Found " + m.installed.path + "; rebuilds only if the version differs\n\n")
+		case optionUninstall:
+			b.WriteString(prefix + "Uninstall syscgo\n")
+			b.WriteString("    Removes syscgo from your system\n\n")
+		}
 	}
-	b.WriteString(uninstallPrefix + "Uninstall syscgo\n")
-	b.WriteString("    Removes syscgo from your system\n\n")
 
-	b.WriteString(lipgloss.NewStyle().Foreground(FgMuted).Render("Requires root privileges"))
+	var notes []string
+	if m.needsRoot && !crossBuild() {
+		notes = append(notes, lipgloss.NewStyle().Foreground(FgMuted).Render("Requires root privileges"))
+	}
+	if warning := m.shadowWarning(); warning != "" {
+		notes = append(notes, lipgloss.NewStyle().Foreground(WarningColor).Render(warning))
+	}
+	b.WriteString(strings.Join(notes, "\n\n"))
 
 	return b.String()
 }
@@ -329,19 +418,41 @@ func (m model) renderComplete() string {
 			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Bold(true).Render("✓ Uninstallation complete!"))
 			b.WriteString("\n\n")
 			b.WriteString(lipgloss.NewStyle().Foreground(FgSecondary).Render("syscgo and syscgo-tui have been removed from your system."))
+		} else if m.upToDate {
+			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Bold(true).Render("✓ Already up to date"))
+			b.WriteString("\n\n")
+			b.WriteString(lipgloss.NewStyle().Foreground(FgSecondary).Render("syscgo " + m.installed.displayVersion() + " at " + m.installed.path + " matches the source tree; nothing was rebuilt."))
+		} else if crossBuild() {
+			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Bold(true).Render("✓ Build complete!"))
+			b.WriteString("\n\n")
+			b.WriteString(lipgloss.NewStyle().Foreground(FgSecondary).Render("Built binaries for " + targetPlatform() + ":"))
+			b.WriteString("\n")
+			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  • " + filepath.Join(getProjectRoot(), "syscgo")))
+			b.WriteString("\n")
+			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  • " + filepath.Join(getProjectRoot(), "syscgo-tui")))
+			b.WriteString("\n\n")
+			b.WriteString(lipgloss.NewStyle().Foreground(FgMuted).Render("Copy them to a " + targetPlatform() + " machine to install them there."))
 		} else {
-			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Bold(true).Render("✓ Installation complete!"))
+			title := "✓ Installation complete!"
+			if m.updateMode {
+				title = "✓ Update complete!"
+			}
+			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Bold(true).Render(title))
 			b.WriteString("\n\n")
 			b.WriteString(lipgloss.NewStyle().Foreground(FgSecondary).Render("Installed binaries:"))
 			b.WriteString("\n")
-			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  • /usr/local/bin/syscgo"))
+			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  • " + filepath.Join(m.binDir(), "syscgo")))
 			b.WriteString("\n")
-			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  • /usr/local/bin/syscgo-tui"))
+			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  • " + filepath.Join(m.binDir(), "syscgo-tui")))
 			b.WriteString("\n\n")
 			b.WriteString(lipgloss.NewStyle().Foreground(FgSecondary).Render("Installed assets:"))
 			b.WriteString("\n")
-			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  • /usr/local/share/syscgo/"))
+			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  • " + m.shareDir() + "/"))
 			b.WriteString("\n\n")
+			if warning := m.shadowWarning(); warning != "" {
+				b.WriteString(lipgloss.NewStyle().Foreground(WarningColor).Render(warning))
+				b.WriteString("\n\n")
+			}
 			b.WriteString(lipgloss.NewStyle().Foreground(FgSecondary).Render("Try them out:"))
 			b.WriteString("\n")
 			b.WriteString(lipgloss.NewStyle().Foreground(Accent).Render("  syscgo -effect fire -theme dracula"))
@@ -375,6 +486,13 @@ func executeTask(index int, m *model) tea.Cmd {
 
 		err := m.tasks[index].execute(m)
 
+		if errors.Is(err, errUpToDate) {
+			return taskCompleteMsg{
+				index:    index,
+				success:  true,
+				finished: true,
+			}
+		}
 		if err != nil {
 			return taskCompleteMsg{
 				index:   index,
@@ -393,28 +511,62 @@ func executeTask(index int, m *model) tea.Cmd {
 // Task functions
 
 func checkPrivileges(m *model) error {
-	if os.Geteuid() != 0 {
-		return fmt.Errorf("installer must be run with sudo or as root")
+	if os.Geteuid() == 0 || canWrite(m.prefix) {
+		return nil
 	}
-	return nil
+	return fmt.Errorf("installing to %s requires sudo or root; set %s=$HOME/.local to install for this user only", m.prefix, prefixEnv)
 }
 
-func buildBinary(m *model) error {
-	cmd := exec.Command("go", "build", "-o", "syscgo", "./cmd/syscgo")
-	cmd.Dir = getProjectRoot()
-	output, err := cmd.CombinedOutput()
+// canWrite reports whether dir, or the nearest existing parent it would be
+// created under, is writable by the current user
+func canWrite(dir string) bool {
+	for {
+		if _, err := os.Stat(dir); err == nil {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return false
+		}
+		dir = parent
+	}
+	f, err := os.CreateTemp(dir, ".syscgo-install-*")
 	if err != nil {
-		return fmt.Errorf("build failed: %s", string(output))
+		return false
+	}
+	f.Close()
+	os.Remove(f.Name())
+	return true
+}
+
+func checkInstalledVersion(m *model) error {
+	version, commit := queryVersion(m.installed.path)
+	installed := installedBinary{path: m.installed.path, version: version, commit: commit}
+	if installed.sameBuild(m.version, m.commit) {
+		return errUpToDate
 	}
 	return nil
 }
 
+func buildBinary(m *model) error {
+	ldflags := fmt.Sprintf("-s -w -X main.version=%s -X main.commit=%s -X main.date=%s",
+		m.version, m.commit, time.Now().UTC().Format(time.RFC3339))
+	return goBuild("syscgo", "./cmd/syscgo", ldflags)
+}
+
 func buildTuiBinary(m *model) error {
-	cmd := exec.Command("go", "build", "-o", "syscgo-tui", "./cmd/syscgo-tui")
+	return goBuild("syscgo-tui", "./cmd/syscgo-tui", "-s -w")
+}
+
+// goBuild builds pkg into output at the project root, stripped and for the
+// GOOS/GOARCH in the environment so cross-building works
+func goBuild(output, pkg, ldflags string) error {
+	cmd := exec.Command("go", "build", "-trimpath", "-ldflags", ldflags, "-o", output, pkg)
 	cmd.Dir = getProjectRoot()
-	output, err := cmd.CombinedOutput()
+	cmd.Env = append(os.Environ(), "GOOS="+targetOS(), "GOARCH="+targetArch())
+	out, err := cmd.CombinedOutput()
 	if err != nil {
-		return fmt.Errorf("build failed: %s", string(output))
+		return fmt.Errorf("build failed: %s", string(out))
 	}
 	return nil
 }
@@ -422,7 +574,7 @@ func buildTuiBinary(m *model) error {
 func installAssets(m *model) error {
 	projectRoot := getProjectRoot()
 	srcPath := filepath.Join(projectRoot, "assets")
-	dstPath := "/usr/local/share/syscgo"
+	dstPath := m.shareDir()
 
 	// Create destination directory
 	err := os.MkdirAll(dstPath, 0755)
@@ -442,17 +594,14 @@ func installAssets(m *model) error {
 func installBinary(m *model) error {
 	projectRoot := getProjectRoot()
 	srcPath := filepath.Join(projectRoot, "syscgo")
-	dstPath := "/usr/local/bin/syscgo"
+	dstPath := filepath.Join(m.binDir(), "syscgo")
 
-	// Read the source file
-	data, err := os.ReadFile(srcPath)
-	if err != nil {
-		return fmt.Errorf("failed to read binary: %v", err)
+	// Create the bin directory under a fresh prefix
+	if err := os.MkdirAll(m.binDir(), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
 	}
 
-	// Write to destination
-	err = os.WriteFile(dstPath, data, 0755)
-	if err != nil {
+	if err := installFile(srcPath, dstPath, 0755); err != nil {
 		return fmt.Errorf("failed to install binary: %v", err)
 	}
 
@@ -462,17 +611,14 @@ func installBinary(m *model) error {
 func installTuiBinary(m *model) error {
 	projectRoot := getProjectRoot()
 	srcPath := filepath.Join(projectRoot, "syscgo-tui")
-	dstPath := "/usr/local/bin/syscgo-tui"
+	dstPath := filepath.Join(m.binDir(), "syscgo-tui")
 
-	// Read the source file
-	data, err := os.ReadFile(srcPath)
-	if err != nil {
-		return fmt.Errorf("failed to read binary: %v", err)
+	// Create the bin directory under a fresh prefix
+	if err := os.MkdirAll(m.binDir(), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
 	}
 
-	// Write to destination
-	err = os.WriteFile(dstPath, data, 0755)
-	if err != nil {
+	if err := installFile(srcPath, dstPath, 0755); err != nil {
 		return fmt.Errorf("failed to install binary: %v", err)
 	}
 
@@ -480,7 +626,7 @@ func installTuiBinary(m *model) error {
 }
 
 func removeSyscgoBinary(m *model) error {
-	err := os.Remove("/usr/local/bin/syscgo")
+	err := os.Remove(filepath.Join(m.binDir(), "syscgo"))
 	if err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to remove binary: %v", err)
 	}
@@ -488,7 +634,7 @@ func removeSyscgoBinary(m *model) error {
 }
 
 func removeTuiBinary(m *model) error {
-	err := os.Remove("/usr/local/bin/syscgo-tui")
+	err := os.Remove(filepath.Join(m.binDir(), "syscgo-tui"))
 	if err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to remove binary: %v", err)
 	}
@@ -496,7 +642,7 @@ func removeTuiBinary(m *model) error {
 }
 
 func removeAssets(m *model) error {
-	err := os.RemoveAll("/usr/local/share/syscgo")
+	err := os.RemoveAll(m.shareDir())
 	if err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to remove assets: %v", err)
 	}
@@ -546,27 +692,211 @@ func copyDir(src, dst string) error {
 	return nil
 }
 
-// copyFile copies a single file
+// copyFile copies a single file, keeping its permissions
 func copyFile(src, dst string) error {
-	// Read source file
-	data, err := os.ReadFile(src)
+	srcInfo, err := os.Stat(src)
 	if err != nil {
 		return err
 	}
+	return installFile(src, dst, srcInfo.Mode())
+}
 
-	// Get source file permissions
-	srcInfo, err := os.Stat(src)
+// installFile replaces dst with a copy of src. The copy is streamed into a
+// temp file next to dst and renamed over it, so a crash mid-copy never
+// leaves a truncated file at dst.
+func installFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
 	if err != nil {
 		return err
 	}
+	defer in.Close()
 
-	// Write to destination
-	err = os.WriteFile(dst, data, srcInfo.Mode())
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+".tmp-*")
 	if err != nil {
 		return err
 	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
 
-	return nil
+	if _, err := io.Copy(tmp, in); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), dst)
+}
+
+var versionPattern = regexp.MustCompile(`(?m)^\s*version\s*=\s*"([^"]+)"`)
+
+// sourceVersion returns the syscgo version in the source tree at root, and
+// the short git commit when root is a git checkout
+func sourceVersion(root string) (version, commit string) {
+	version = "unknown"
+	if data, err := os.ReadFile(filepath.Join(root, "cmd", "syscgo", "version.go")); err == nil {
+		if match := versionPattern.FindSubmatch(data); match != nil {
+			version = string(match[1])
+		}
+	}
+
+	cmd := exec.Command("git", "rev-parse", "--short", "HEAD")
+	cmd.Dir = root
+	if out, err := cmd.Output(); err == nil {
+		commit = strings.TrimSpace(string(out))
+	}
+	return version, commit
+}
+
+// displayVersion formats the version for the welcome screen
+func (m model) displayVersion() string {
+	if m.commit == "" {
+		return m.version
+	}
+	return m.version + " (" + m.commit + ")"
+}
+
+// findInstalled looks for an existing syscgo in binDir, where the installer
+// writes, and asks it for its version
+func findInstalled(binDir string) installedBinary {
+	path := filepath.Join(binDir, "syscgo")
+	if _, err := os.Stat(path); err != nil {
+		return installedBinary{}
+	}
+	version, commit := queryVersion(path)
+	return installedBinary{path: path, version: version, commit: commit}
+}
+
+// shadowingBinary returns the syscgo PATH finds when it isn't the one in
+// binDir, e.g. an old copy in ~/go/bin, or "" when there is none
+func shadowingBinary(binDir string) string {
+	path, err := exec.LookPath("syscgo")
+	if err != nil {
+		return ""
+	}
+	found, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+	if own, err := os.Stat(filepath.Join(binDir, "syscgo")); err == nil && os.SameFile(found, own) {
+		return ""
+	}
+	return path
+}
+
+// shadowWarning explains that running syscgo will start another copy than
+// the one the installer manages, or returns "" when PATH finds that one
+func (m model) shadowWarning() string {
+	if m.shadow == "" {
+		return ""
+	}
+	return "Warning: " + m.shadow + " comes first in PATH, so syscgo runs it instead of " + filepath.Join(m.binDir(), "syscgo")
+}
+
+// queryVersion runs syscgo -version and parses the version and commit
+// lines, returning "unknown" and "" for anything it doesn't report
+func queryVersion(path string) (version, commit string) {
+	version = "unknown"
+	out, err := exec.Command(path, "-version").Output()
+	if err != nil {
+		return version, ""
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if v, ok := strings.CutPrefix(line, "syscgo version "); ok {
+			version = v
+		} else if c, ok := strings.CutPrefix(line, "commit:"); ok {
+			c, _, _ = strings.Cut(strings.TrimSpace(c), " ")
+			if c != "unknown" {
+				commit = c
+			}
+		}
+	}
+	return version, commit
+}
+
+// displayVersion formats the installed version for the welcome screen
+func (b installedBinary) displayVersion() string {
+	if b.commit == "" {
+		return b.version
+	}
+	return b.version + " (" + b.commit + ")"
+}
+
+// sameBuild reports whether the installed binary was built from the source
+// tree. Commits may be abbreviated to different lengths, and a binary that
+// doesn't report its commit only matches a tree that isn't a git checkout.
+func (b installedBinary) sameBuild(version, commit string) bool {
+	if b.version != version || (b.commit == "") != (commit == "") {
+		return false
+	}
+	return strings.HasPrefix(b.commit, commit) || strings.HasPrefix(commit, b.commit)
+}
+
+// Install prefix defaults, overridden by the SYSCGO_PREFIX environment variable
+const (
+	prefixEnv     = "SYSCGO_PREFIX"
+	defaultPrefix = "/usr/local"
+)
+
+// installPrefix returns the install prefix from SYSCGO_PREFIX, expanding a
+// leading ~, or /usr/local when unset
+func installPrefix() string {
+	prefix := os.Getenv(prefixEnv)
+	if prefix == "" {
+		return defaultPrefix
+	}
+	if prefix == "~" || strings.HasPrefix(prefix, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			prefix = filepath.Join(home, prefix[1:])
+		}
+	}
+	if abs, err := filepath.Abs(prefix); err == nil {
+		prefix = abs
+	}
+	return prefix
+}
+
+func (m model) binDir() string {
+	return filepath.Join(m.prefix, "bin")
+}
+
+func (m model) shareDir() string {
+	return filepath.Join(m.prefix, "share", "syscgo")
+}
+
+// targetOS returns GOOS from the environment, or the installer's own OS
+func targetOS() string {
+	if goos := os.Getenv("GOOS"); goos != "" {
+		return goos
+	}
+	return runtime.GOOS
+}
+
+// targetArch returns GOARCH from the environment, or the installer's own arch
+func targetArch() string {
+	if goarch := os.Getenv("GOARCH"); goarch != "" {
+		return goarch
+	}
+	return runtime.GOARCH
+}
+
+func targetPlatform() string {
+	return targetOS() + "/" + targetArch()
+}
+
+// crossBuild reports whether GOOS/GOARCH ask for binaries for another
+// platform, which are built but neither installed nor run here
+func crossBuild() bool {
+	return targetOS() != runtime.GOOS || targetArch() != runtime.GOARCH
 }
 
 func getProjectRoot() string {
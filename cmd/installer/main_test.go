@@ -0,0 +1,85 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestInstallFile_ReplacesDst(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "new")
+	dst := filepath.Join(dir, "syscgo")
+	if err := os.WriteFile(src, []byte("new binary"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dst, []byte("old binary, longer than the new one"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := installFile(src, dst, 0o755); err != nil {
+		t.Fatalf("installFile: %v", err)
+	}
+
+	data, err := os.ReadFile(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "new binary" {
+		t.Errorf("dst = %q, want %q", data, "new binary")
+	}
+	info, err := os.Stat(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o755 {
+		t.Errorf("dst mode = %v, want 0755", info.Mode().Perm())
+	}
+	if leftovers, _ := filepath.Glob(filepath.Join(dir, ".*.tmp-*")); len(leftovers) > 0 {
+		t.Errorf("temp files left behind: %v", leftovers)
+	}
+}
+
+func TestShadowingBinary(t *testing.T) {
+	first, second := t.TempDir(), t.TempDir()
+	for _, dir := range []string{first, second} {
+		if err := os.WriteFile(filepath.Join(dir, "syscgo"), []byte("#!/bin/sh\n"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", first+string(os.PathListSeparator)+second)
+
+	if got, want := shadowingBinary(second), filepath.Join(first, "syscgo"); got != want {
+		t.Errorf("shadowingBinary(second) = %q, want %q", got, want)
+	}
+	if got := shadowingBinary(first); got != "" {
+		t.Errorf("shadowingBinary(first) = %q, want none since PATH finds it", got)
+	}
+
+	// Only binDir counts as installed, whatever PATH finds
+	if got := findInstalled(t.TempDir()); got.path != "" {
+		t.Errorf("findInstalled on an empty binDir found %q", got.path)
+	}
+}
+
+// Binaries built for another platform are left in the project root
+func TestInitTasks_CrossBuild(t *testing.T) {
+	goos := "windows"
+	if runtime.GOOS == goos {
+		goos = "linux"
+	}
+	t.Setenv("GOOS", goos)
+
+	m := model{prefix: t.TempDir()}
+	m.initTasks()
+	for _, task := range m.tasks {
+		if !strings.HasPrefix(task.name, "Build ") {
+			t.Errorf("cross build runs task %q", task.name)
+		}
+	}
+	if len(m.tasks) != 2 {
+		t.Errorf("cross build has %d tasks, want the 2 builds", len(m.tasks))
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+	"github.com/Nomadcxx/sysc-Go/render"
+)
+
+// frameFileName is the name of the nth frame written by -out-dir, counting from 1
+func frameFileName(n int) string {
+	return fmt.Sprintf("frame-%04d.txt", n)
+}
+
+// writeFrames renders opts.outFrames frames of effect as fast as it can and
+// writes each one, raw ANSI and without cursor movement, to its own file in
+// opts.outDir. Effects that finish early stop writing once they are done.
+func writeFrames(effect frameEffect, opts runOptions) {
+	if err := os.MkdirAll(opts.outDir, 0755); err != nil {
+		fatalf("Error: Could not create %s: %v\n", opts.outDir, err)
+	}
+
+	// Same renderer choice as the terminal, except -diff: a frame file has
+	// no previous frame on screen to patch, so it gets full frames
+	var ansi *render.ANSIRenderer
+	cellEffect, hasCells := effect.(animations.CellRenderer)
+	if (opts.diff || opts.fast || opts.bg != "") && hasCells {
+		ansi = render.NewANSIRenderer()
+		ansi.Background = opts.bg
+	}
+	writer, streams := effect.(io.WriterTo)
+	finishing, _ := effect.(finishingEffect)
+
+	for frame := 1; frame <= opts.outFrames; frame++ {
+		select {
+		case <-opts.quit:
+			return
+		default:
+		}
+
+		effect.Update()
+
+		path := filepath.Join(opts.outDir, frameFileName(frame))
+		file, err := os.Create(path)
+		if err != nil {
+			fatalf("Error: Could not write %s: %v\n", path, err)
+		}
+		out := bufio.NewWriter(file)
+		switch {
+		case ansi != nil:
+			_, err = out.WriteString(ansi.Render(cellEffect.RenderCells()))
+		case streams && opts.bg == "":
+			_, err = writer.WriteTo(out)
+		default:
+			_, err = out.WriteString(render.FillBackground(effect.Render(), opts.bg))
+		}
+		if err == nil {
+			err = out.Flush()
+		}
+		if err != nil {
+			file.Close()
+			fatalf("Error: Could not write %s: %v\n", path, err)
+		}
+		if err := file.Close(); err != nil {
+			fatalf("Error: Could not write %s: %v\n", path, err)
+		}
+		if finishing != nil && finishing.Done() {
+			return
+		}
+	}
+}
@@ -1,21 +1,29 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"math/rand"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"runtime/pprof"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
+	"unicode/utf8"
 
 	"github.com/Nomadcxx/sysc-Go/animations"
+	"github.com/Nomadcxx/sysc-Go/internal/effects"
+	"github.com/Nomadcxx/sysc-Go/render"
 	"golang.org/x/term"
 )
 
-const version = "1.0.2"
-
 const banner = `▄▀▀▀▀ █   █ ▄▀▀▀▀ ▄▀▀▀▀    ▄▀    ▄▀
  ▀▀▀▄ ▀▀▀▀█  ▀▀▀▄ █      ▄▀    ▄▀
 ▀▀▀▀  ▀▀▀▀▀ ▀▀▀▀   ▀▀▀▀ ▀     ▀
@@ -23,7 +31,6 @@ const banner = `▄▀▀▀▀ █   █ ▄▀▀▀▀ ▄▀▀▀▀    ▄
 Terminal Animation Library
 `
 
-// wrapText wraps text to fit within the specified width
 // findAssetFile searches for an asset file in multiple locations
 // Priority order: user writable directories first, then system read-only paths
 func findAssetFile(filename string) string {
@@ -86,8 +93,7 @@ func readTextFile(file string) string {
 			}
 		}
 
-		fmt.Printf("Error: Could not read file %s and could not find fallback SYSC.txt\n", file)
-		os.Exit(1)
+		fatalf("Error: Could not read file %s and could not find fallback SYSC.txt\n", file)
 	}
 
 	// No file provided, use SYSC.txt
@@ -97,15 +103,37 @@ func readTextFile(file string) string {
 		if readErr == nil {
 			return string(data)
 		}
-		fmt.Printf("Error: Could not read SYSC.txt from %s\n", fallbackPath)
-		os.Exit(1)
+		fatalf("Error: Could not read SYSC.txt from %s\n", fallbackPath)
 	}
 
-	fmt.Println("Error: Could not find SYSC.txt in any asset location")
-	os.Exit(1)
+	fatalf("Error: Could not find SYSC.txt in any asset location\n")
 	return ""
 }
 
+// textAssetEffects are the effects that show SYSC.txt when no -file is given
+var textAssetEffects = []string{"fire-text", "matrix-art", "rain-art", "beam-text", "ring-text", "dvd"}
+
+// textAvailable reports whether readTextFile can find text for file, either
+// the file itself or the SYSC.txt fallback, rather than exiting
+func textAvailable(file string) bool {
+	paths := []string{findAssetFile("SYSC.txt")}
+	if file != "" {
+		paths = append(paths, file)
+	}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if _, err := os.ReadFile(path); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// wrapText wraps text to fit within the specified width, breaking lines
+// at spaces and splitting words longer than a line. Widths count runes so
+// multi-byte characters are never cut in half.
 func wrapText(text string, width int) string {
 	if width <= 0 {
 		width = 80
@@ -122,79 +150,478 @@ func wrapText(text string, width int) string {
 		}
 
 		// If line fits, keep it
-		if len(line) <= width {
+		if utf8.RuneCountInString(line) <= width {
 			wrappedLines = append(wrappedLines, line)
 			continue
 		}
 
 		// Wrap long lines
 		words := strings.Fields(line)
-		currentLine := ""
+		var currentLine []rune
 
 		for _, word := range words {
+			runes := []rune(word)
+
 			// If word itself is longer than width, break it
-			if len(word) > width {
-				if currentLine != "" {
-					wrappedLines = append(wrappedLines, currentLine)
-					currentLine = ""
+			if len(runes) > width {
+				if len(currentLine) > 0 {
+					wrappedLines = append(wrappedLines, string(currentLine))
 				}
 				// Split long word
-				for len(word) > width {
-					wrappedLines = append(wrappedLines, word[:width])
-					word = word[width:]
+				for len(runes) > width {
+					wrappedLines = append(wrappedLines, string(runes[:width]))
+					runes = runes[width:]
 				}
-				currentLine = word
+				currentLine = runes
 				continue
 			}
 
-			// Try adding word to current line
-			testLine := currentLine
-			if testLine != "" {
-				testLine += " "
-			}
-			testLine += word
-
-			if len(testLine) <= width {
-				currentLine = testLine
+			// Add word to current line if it fits after a space
+			if len(currentLine) == 0 {
+				currentLine = runes
+			} else if len(currentLine)+1+len(runes) <= width {
+				currentLine = append(append(currentLine, ' '), runes...)
 			} else {
 				// Start new line with this word
-				if currentLine != "" {
-					wrappedLines = append(wrappedLines, currentLine)
-				}
-				currentLine = word
+				wrappedLines = append(wrappedLines, string(currentLine))
+				currentLine = runes
 			}
 		}
 
 		// Add remaining line
-		if currentLine != "" {
-			wrappedLines = append(wrappedLines, currentLine)
+		if len(currentLine) > 0 {
+			wrappedLines = append(wrappedLines, string(currentLine))
 		}
 	}
 
 	return strings.Join(wrappedLines, "\n")
 }
 
-// setupKeyboardInterrupt sets up signal handling for Ctrl+C
-// Returns a channel that will receive true when user wants to exit
-func setupKeyboardInterrupt() chan bool {
-	quit := make(chan bool, 1)
+// flagPassed reports whether the named flag was given on the command line
+func flagPassed(name string) bool {
+	passed := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			passed = true
+		}
+	})
+	return passed
+}
+
+// parseSpeedRange parses a -speed value of the form "min,max" or a single
+// speed. An empty value selects the effect's default speeds.
+func parseSpeedRange(value string) ([2]float64, error) {
+	if value == "" {
+		return [2]float64{}, nil
+	}
+
+	parts := strings.Split(value, ",")
+	if len(parts) > 2 {
+		return [2]float64{}, fmt.Errorf("expected min,max")
+	}
+
+	var speeds [2]float64
+	for i, part := range parts {
+		speed, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return [2]float64{}, fmt.Errorf("failed to parse speed: %w", err)
+		}
+		if speed <= 0 {
+			return [2]float64{}, fmt.Errorf("speed must be positive")
+		}
+		speeds[i] = speed
+	}
+	if len(parts) == 1 {
+		speeds[1] = speeds[0]
+	}
+	if speeds[1] < speeds[0] {
+		return [2]float64{}, fmt.Errorf("min is greater than max")
+	}
+
+	return speeds, nil
+}
+
+// runOptions holds the command line settings passed to every effect runner
+type runOptions struct {
+	width     int
+	height    int
+	theme     animations.Theme
+	file      string
+	auto      bool
+	display   bool
+	wrap      bool                 // Reflow lines wider than the terminal
+	mirror    bool                 // Flip text art horizontally
+	noIntro   bool                 // Start ring-text/blackhole almost at once, intro on the first loop only
+	align     animations.TextAlign // Text effect horizontal placement
+	margin    int                  // Columns text effects keep clear at the aligned edge
+	frames    int
+	diff      bool          // Redraw only changed cells
+	fast      bool          // Draw full frames with raw ANSI codes instead of lipgloss
+	easing    string        // Pour easing function
+	direction string        // Pour direction
+	trail     int           // Matrix/fireworks afterglow length, 0 = off
+	glyphs    string        // Matrix glyph set
+	density   float64       // Matrix/rain density, 0 = effect default
+	speed     [2]float64    // Matrix/rain speed range in cells per frame, zero = effect default
+	command   string        // Shell command scrolled by the command effect
+	interval  time.Duration // Time between runs of the command
+	maxFish   int           // Aquarium fish cap, 0 = effect default
+	seed      int64         // Random seed, 0 = seeded from the clock
+	bold      int           // Fire/fireworks colors drawn bold, 0 = none
+	shape     string        // Fireworks burst shape
+	gravity   float64       // Fireworks fall speed multiplier, 0 = effect default
+	count     int           // Most fireworks bursts at once, 0 = no cap
+	particles int           // Particles per fireworks burst, 0 = effect default
+	fill      bool          // Fire as solid background blocks
+	splash    bool          // Rain splashes and puddles on the bottom row
+	wind      float64       // Fire lean in cells per row
+	windSway  float64       // Fire wind oscillation amplitude
+	bg        string        // Background hex color behind every cell, empty = terminal default
+	scale     int           // Cell effects run at 1/scale size and are drawn in scale x scale blocks
+	outDir    string        // Write frames to files here instead of the terminal, empty = terminal
+	outFrames int           // Frames written to outDir
+
+	quit <-chan struct{} // Closed on Ctrl+C or SIGTERM
+	keys <-chan byte     // Keys pressed in -step mode, nil otherwise
+
+	transition *screensaverTransition // Blends -screensaver effects together, nil otherwise
+	clip       bool                   // Cut off text too large for the terminal instead of exiting
+}
 
-	// Use signal handling instead of raw mode to avoid breaking output formatting
+// fitText wraps text from -file to the terminal width unless -wrap=false
+// asked to keep ASCII art as-is. Lines that already fit are never changed,
+// and the built-in SYSC.txt art used without -file is never wrapped.
+// With -mirror the text is then flipped horizontally.
+func (opts runOptions) fitText(text string) string {
+	if opts.wrap && opts.file != "" {
+		text = wrapText(text, opts.width-2*opts.margin)
+	}
+	if opts.mirror {
+		text = animations.MirrorText(text)
+	}
+	return text
+}
+
+// effectRunners maps effect names to their CLI runners
+var effectRunners = map[string]func(opts runOptions){
+	"fire":       runFire,
+	"fire-text":  runFireText,
+	"matrix":     runMatrix,
+	"matrix-art": runMatrixArt,
+	"fireworks":  runFireworks,
+	"rain":       runRain,
+	"rain-art":   runRainArt,
+	"pour":       runPour,
+	"print":      runPrint,
+	"beams":      runBeams,
+	"beam-text":  runBeamText,
+	"ring-text":  runRingText,
+	"blackhole":  runBlackhole,
+	// WIP: blackhole-particles is currently broken (terminal scrolling issue)
+	// "blackhole-particles": func(opts runOptions) { opts.file = ""; runBlackhole(opts) },
+	"aquarium":  runAquarium,
+	"life":      runLife,
+	"starfield": runStarfield,
+	"dvd":       runDVD,
+	"boot":      runBoot,
+	"command":   runCommand,
+}
+
+// availableEffects returns the registered effects the CLI can run, in registry order
+func availableEffects() []string {
+	var names []string
+	for _, name := range animations.GetEffectNames() {
+		if _, ok := effectRunners[name]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// availableThemes returns the built-in theme names, in registry order
+func availableThemes() []string {
+	var names []string
+	for _, theme := range animations.ThemeRegistry {
+		names = append(names, theme.Name)
+	}
+	return names
+}
+
+// randomTheme picks a built-in theme for -theme random. A nonzero seed
+// always picks the same theme.
+func randomTheme(seed int64) string {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	themes := availableThemes()
+	return themes[rand.New(rand.NewSource(seed)).Intn(len(themes))]
+}
+
+// printList prints names one per line, or as a JSON array
+func printList(names []string, asJSON bool) {
+	if asJSON {
+		data, err := json.Marshal(names)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+		return
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+}
+
+// setupKeyboardInterrupt sets up signal handling for Ctrl+C and SIGTERM
+// Returns a channel that is closed when the user wants to exit
+func setupKeyboardInterrupt() <-chan struct{} {
+	quit := make(chan struct{})
+
+	// Use signal handling instead of raw mode to avoid breaking output formatting.
+	// The handler stays installed so the run loop can always restore the terminal.
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
-		defer signal.Stop(sigChan) // Cleanup signal handler
+		<-sigChan
+		close(quit)
+	}()
+
+	return quit
+}
+
+// terminalActive is set while the alternate screen is in use
+var terminalActive bool
+
+// rawState is the terminal state to restore after -step mode's raw input, nil if not raw
+var rawState *term.State
+
+// Keys handled in -step mode
+const (
+	keyCtrlC = 3
+	keyStep  = ' '
+	keyPause = 'p'
+	keyQuit  = 'q'
+)
+
+// readKeys puts the terminal in raw mode and returns the keys pressed.
+// Raw mode stops Ctrl+C from raising SIGINT, so the run loop handles it as a key.
+func readKeys() (<-chan byte, error) {
+	state, err := term.MakeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return nil, err
+	}
+	rawState = state
+
+	keys := make(chan byte)
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			if _, err := os.Stdin.Read(buf); err != nil {
+				return
+			}
+			keys <- buf[0]
+		}
+	}()
+	return keys, nil
+}
+
+// enterTerminal switches to the alternate screen buffer and hides the cursor
+// so the user's scrollback is left untouched
+func enterTerminal() {
+	fmt.Print("\033[?1049h") // Alternate screen buffer
+	fmt.Print("\033[2J")     // Clear screen
+	moveHome(os.Stdout)
+	fmt.Print("\033[?25l") // Hide cursor
+	terminalActive = true
+}
+
+// restoreTerminal resets colors, clears the alternate screen, shows the cursor
+// and returns to the main screen. Safe to call more than once.
+func restoreTerminal() {
+	if !terminalActive {
+		return
+	}
+	if rawState != nil {
+		term.Restore(int(os.Stdin.Fd()), rawState)
+		rawState = nil
+	}
+	fmt.Print("\033[0m\033[2J\033[?25h\033[?1049l")
+	os.Stdout.Sync()
+	terminalActive = false
+}
+
+// fatalf restores the terminal, prints an error to stderr and exits
+func fatalf(format string, args ...any) {
+	restoreTerminal()
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Exit(1)
+}
+
+// frameEffect is the part of an effect the run loop needs
+type frameEffect interface {
+	Update()
+	Render() string
+}
+
+// finishingEffect is implemented by effects that can end, like matrix-art
+// and rain-art in display mode; the run loop stops once Done reports true
+type finishingEffect interface {
+	Done() bool
+}
+
+// moveHome moves the cursor to the top-left corner so the next frame
+// repaints in place. Every effect goes through it so the escape can't be mistyped.
+func moveHome(w io.Writer) {
+	fmt.Fprint(w, "\033[H")
+}
+
+// printFrame writes a rendered frame, adding the carriage returns that raw
+// mode no longer adds after each newline
+func printFrame(frame string) {
+	if rawState != nil {
+		frame = strings.ReplaceAll(frame, "\n", "\r\n")
+	}
+	fmt.Print(frame)
+}
+
+// running is always ready, so selecting on it never blocks
+var running = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+// animate runs the effect until the frame budget is spent (0 = forever) or the user quits.
+// In -step mode it starts paused: space draws one frame and p toggles playing.
+func animate(effect frameEffect, opts runOptions, delay time.Duration) {
+	// Diff and fast rendering need raw cells; effects without them fall back
+	// to their own Render. A background also draws cells with raw ANSI codes
+	// so it reaches every blank cell.
+	// Scaling redraws cells, so effects that only render strings run at full size
+	if scalable, ok := effect.(interface {
+		frameEffect
+		animations.CellRenderer
+		animations.Resizable
+	}); ok && opts.scale > 1 && !opts.auto {
+		effect = animations.NewScaledEffect(scalable, opts.scale, opts.width, opts.height)
+	}
+
+	if opts.outDir != "" {
+		writeFrames(effect, opts)
+		return
+	}
+
+	var diff *render.DiffRenderer
+	var fast *render.ANSIRenderer
+	cellEffect, hasCells := effect.(animations.CellRenderer)
+	if opts.diff && hasCells {
+		diff = render.NewDiffRenderer()
+		diff.Background = opts.bg
+	} else if (opts.fast || opts.bg != "") && hasCells {
+		fast = render.NewANSIRenderer()
+		fast.Background = opts.bg
+	}
+
+	// Follow terminal resizes when the effect supports it
+	// (auto-sized canvases keep the size of their text)
+	resize := make(chan os.Signal, 1)
+	if _, ok := effect.(animations.Resizable); ok && !opts.auto {
+		signal.Notify(resize, syscall.SIGWINCH)
+		defer signal.Stop(resize)
+	}
+
+	// Effects that can stream their frames write them straight into a
+	// buffer flushed once per frame. Raw mode needs a carriage return added
+	// to every newline, which printFrame does on whole strings.
+	out := bufio.NewWriterSize(os.Stdout, 64*1024)
+	writer, streams := effect.(io.WriterTo)
+
+	draw := func() {
+		if diff != nil {
+			printFrame(diff.Render(cellEffect.RenderCells()))
+		} else if fast != nil {
+			moveHome(os.Stdout)
+			printFrame(fast.Render(cellEffect.RenderCells()))
+		} else if streams && opts.bg == "" && rawState == nil {
+			moveHome(out)
+			_, err := writer.WriteTo(out)
+			if err == nil {
+				err = out.Flush()
+			}
+			if err != nil {
+				fatalf("Error: Could not write frame: %v\n", err)
+			}
+		} else {
+			moveHome(os.Stdout)
+			printFrame(render.FillBackground(effect.Render(), opts.bg))
+		}
+		os.Stdout.Sync() // Flush output buffer immediately
+	}
+
+	// Screensaver effects blend in from the previous effect's last frame
+	if opts.transition != nil {
+		if !opts.transition.blendIn(effect, cellEffect, opts, delay) {
+			return
+		}
+		if diff != nil {
+			diff.Reset()
+		}
+		defer opts.transition.keep(cellEffect)
+	}
+
+	finishing, _ := effect.(finishingEffect)
+
+	paused := opts.keys != nil
+	frame := 0
+	for opts.frames == 0 || frame < opts.frames {
+		// Check for user exit, terminal resize or a step key. While paused
+		// this waits until a key says to draw the next frame.
+		next := running
+		if paused {
+			next = nil
+		}
 		select {
-		case <-sigChan:
-			quit <- true
-		case <-quit:
-			// Parent exited normally, cleanup
+		case <-opts.quit:
 			return
+		case <-resize:
+			if width, height, err := term.GetSize(int(os.Stdout.Fd())); err == nil && width > 0 && height > 0 {
+				effect.(animations.Resizable).Resize(width, height)
+				fmt.Print("\033[2J") // Clear leftovers from the old size
+				if diff != nil {
+					diff.Reset()
+				}
+			}
+			if paused {
+				draw() // Show the current frame at the new size without advancing
+				continue
+			}
+		case key := <-opts.keys:
+			switch key {
+			case keyQuit, keyCtrlC:
+				return
+			case keyStep:
+				paused = true
+			case keyPause:
+				paused = !paused
+				continue
+			default:
+				continue
+			}
+		case <-next:
 		}
-	}()
 
-	return quit
+		effect.Update()
+		draw()
+		if finishing != nil && finishing.Done() {
+			return
+		}
+		if !paused {
+			time.Sleep(delay)
+		}
+		frame++
+	}
 }
 
 func showHelp() {
@@ -208,39 +635,129 @@ func showHelp() {
 	fmt.Println()
 	fmt.Println("Options:")
 	fmt.Println("  -effect   string   Animation effect (default: fire)")
-	fmt.Println("  -theme    string   Color theme (default: dracula)")
-	fmt.Println("  -duration int      Duration in seconds, 0=infinite (default: 10)")
+	fmt.Println("  -theme    string   Color theme, or random to pick one (default: dracula)")
+	fmt.Println("  -theme-file string JSON theme file overriding -theme colors")
+	fmt.Println("  -duration int      Duration in seconds, 0=infinite (default: per effect, infinite for ambient loops)")
+	fmt.Println("  -frames   int      Number of frames to run, overrides -duration")
+	fmt.Println("  -out-dir  string   Write -frames frames to DIR/frame-0001.txt etc. instead of the terminal")
 	fmt.Println("  -file     string   Text file for text-based effects")
 	fmt.Println("  -auto              Auto-size canvas (beam-text only)")
-	fmt.Println("  -display           Hold at final state (beam-text only)")
+	fmt.Println("  -align    string   Text effect alignment: left, center or right (default: center)")
+	fmt.Println("  -margin   int      Columns text effects keep clear at the aligned edge")
+	fmt.Println("  -wrap              Wrap text wider than the terminal; -wrap=false keeps ASCII art as-is (default: true)")
+	fmt.Println("  -mirror            Flip text art horizontally, swapping / and \\, ( and ), < and > etc.")
+	fmt.Println("  -no-intro          Cut the static intro of ring-text and blackhole short, and skip it when looping")
+	fmt.Println("  -display           Complete once: beam-text holds, matrix-art/rain-art reveal the art and exit")
+	fmt.Println("  -diff              Redraw only changed cells (less flicker over SSH)")
+	fmt.Println("  -fast              Draw full frames with raw ANSI codes (smaller, faster output)")
+	fmt.Println("  -direction string  Pour direction (default: down)")
+	fmt.Println("  -density  float    Matrix/rain column density, e.g. 0.05 sparse, 0.5 busy")
+	fmt.Println("  -speed    string   Matrix/rain speed in cells/frame: min,max or one value; command scroll speed")
+	fmt.Println("  -command  string   Shell command whose output the command effect scrolls (default: date)")
+	fmt.Println("  -interval duration Time between runs of -command, e.g. 1s or 1m (default: 5s)")
+	fmt.Println("  -max-fish int      Aquarium fish cap (default: 30)")
+	fmt.Println("  -trail    int      Matrix/fireworks afterglow length, 0=off (default: 0)")
+	fmt.Println("  -glyphs   string   Matrix glyph set: mixed, ascii, katakana or binary (default: mixed)")
+	fmt.Println("  -easing   string   Pour easing function (default: easeIn)")
+	fmt.Println("  -seed     int      Random seed for reproducible output, 0=random")
+	fmt.Println("  -bold     int      Draw the N brightest fire/fireworks colors bold")
+	fmt.Println("  -shape    string   Fireworks burst shape (default: circle)")
+	fmt.Println("  -gravity  float    Fireworks fall speed, e.g. 0.5 lingers, 2 snaps (default: 1)")
+	fmt.Println("  -count    int      Most fireworks bursts on screen at once, up to 50 (default: no cap)")
+	fmt.Println("  -particles int     Particles per fireworks burst, up to 200 (default: 25)")
+	fmt.Println("  -fill              Draw fire as solid colored blocks")
+	fmt.Println("  -splash            Rain splashes on the bottom row and pools into a puddle")
+	fmt.Println("  -wind     float    Fire lean in cells per row, negative=left")
+	fmt.Println("  -wind-sway float   Fire wind swing amplitude for a slow back-and-forth")
+	fmt.Println("  -no-color          Draw glyphs only, without color (NO_COLOR=1 does the same)")
+	fmt.Println("  -bg       string   Background color behind every cell, e.g. #1e1e2e")
+	fmt.Println("  -scale    int      Run at 1/N size and draw NxN blocks, for big terminals (default: 1)")
+	fmt.Println("  -step              Start paused; space steps one frame, p plays/pauses, q quits")
+	fmt.Println("  -screensaver       Cycle random effects and themes, 10-30s each; any key exits")
+	fmt.Println("  -transition string Screensaver blend between effects: dissolve, wipe, none (default: dissolve)")
+	fmt.Println("  -list-effects      Print available effects, one per line")
+	fmt.Println("  -list-themes       Print available themes, one per line")
+	fmt.Println("  -json              Print -list-effects/-list-themes as a JSON array")
+	fmt.Println("  -version           Print version, git commit, and build date")
+	fmt.Println("  -quiet             Don't print which theme -theme random picked")
 	fmt.Println()
 	fmt.Println("Effects:")
-	fmt.Println("  fire, fire-text, matrix, matrix-art, rain, rain-art, fireworks")
-	fmt.Println("  pour, print, beams, beam-text, ring-text, blackhole, aquarium")
+	fmt.Printf("  %s\n", strings.Join(availableEffects(), ", "))
 	fmt.Println()
 	fmt.Println("Themes:")
-	fmt.Println("  dracula, gruvbox, nord, tokyo-night, catppuccin, material")
-	fmt.Println("  solarized, monochrome, transishardjob, rama, eldritch, dark")
+	fmt.Printf("  %s\n", strings.Join(availableThemes(), ", "))
+	fmt.Println()
+	fmt.Println("Directions (pour):")
+	fmt.Printf("  %s\n", strings.Join(animations.PourDirections, ", "))
+	fmt.Println()
+	fmt.Println("Easings (pour):")
+	fmt.Printf("  %s\n", strings.Join(animations.PourEasings, ", "))
+	fmt.Println()
+	fmt.Println("Shapes (fireworks):")
+	fmt.Printf("  %s\n", strings.Join(animations.FireworksShapes, ", "))
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  syscgo -effect fire -theme nord -duration 30")
 	fmt.Println("  syscgo -effect fire-text -file SYSC.txt -theme dracula -duration 0")
-	fmt.Println("  syscgo -effect aquarium -theme dracula -duration 0")
+	fmt.Println("  syscgo -effect aquarium -theme dracula")
 	fmt.Println("  syscgo -effect beam-text -file art.txt -auto -display -theme nord")
+	fmt.Println("  syscgo -effect fire -theme-file mytheme.json")
+	fmt.Println("  syscgo -effect pour -file art.txt -easing easeOutBounce")
+	fmt.Println("  syscgo -effect command -command uptime -interval 10s")
 	fmt.Println()
 	fmt.Println("For more info: https://github.com/Nomadcxx/sysc-Go")
 }
 
 func main() {
-	effect := flag.String("effect", "fire", "Animation effect (fire, matrix, rain, fireworks, decrypt)")
-	theme := flag.String("theme", "dracula", "Color theme")
-	duration := flag.Int("duration", 10, "Duration in seconds (0 = infinite)")
-	file := flag.String("file", "", "Text file for text-based effects (decrypt, pour, print, beam-text)")
+	effect := flag.String("effect", "fire", "Animation effect, see -list-effects")
+	theme := flag.String("theme", "dracula", "Color theme, or random for a random built-in theme")
+	themeFile := flag.String("theme-file", "", "JSON theme file overriding the colors of -theme")
+	duration := flag.Int("duration", 0, "Duration in seconds (0 = infinite, default depends on the effect)")
+	frameCount := flag.Int("frames", 0, "Number of frames to run, overriding -duration (0 = use -duration)")
+	outDir := flag.String("out-dir", "", "Write each frame as raw ANSI to a numbered file in this directory instead of the terminal")
+	file := flag.String("file", "", "Text file for text-based effects (pour, print, beam-text, ring-text)")
 	auto := flag.Bool("auto", false, "Auto-size canvas to fit text (beam-text only)")
-	display := flag.Bool("display", false, "Display mode: complete once and hold (beam-text only)")
+	align := flag.String("align", "center", "Text effect alignment ("+strings.Join(animations.TextAligns, ", ")+")")
+	margin := flag.Int("margin", 0, "Columns text effects keep clear at the aligned edge")
+	wrap := flag.Bool("wrap", true, "Wrap text lines wider than the terminal (use -wrap=false for ASCII art)")
+	mirror := flag.Bool("mirror", false, "Flip text art horizontally, mirroring directional glyphs like / ( < [")
+	noIntro := flag.Bool("no-intro", false, "Start ring-text and blackhole almost at once, and skip the static intro when looping")
+	display := flag.Bool("display", false, "Display mode: complete once (beam-text holds, matrix-art/rain-art reveal the art and exit)")
+	diff := flag.Bool("diff", false, "Redraw only changed cells each frame")
+	fast := flag.Bool("fast", false, "Draw frames with raw ANSI codes instead of lipgloss")
+	direction := flag.String("direction", "down", "Pour direction ("+strings.Join(animations.PourDirections, ", ")+")")
+	density := flag.Float64("density", 0, "Fraction of columns with matrix streaks or rain drops (0 = default)")
+	speed := flag.String("speed", "", "Matrix/rain speed in cells per frame, as min,max or a single value; command scroll speed in columns per frame")
+	command := flag.String("command", "date", "Shell command whose output the command effect scrolls")
+	interval := flag.Duration("interval", 5*time.Second, "Time between runs of -command")
+	maxFish := flag.Int("max-fish", 0, "Most small fish in the aquarium at once (0 = default 30)")
+	trail := flag.Int("trail", 0, "Matrix/fireworks afterglow length in cells (0 = off)")
+	glyphs := flag.String("glyphs", "mixed", "Matrix glyph set ("+strings.Join(animations.MatrixGlyphSets, ", ")+")")
+	wind := flag.Float64("wind", 0, "Fire lean in cells per row (negative = left, positive = right)")
+	windSway := flag.Float64("wind-sway", 0, "Fire wind that slowly swings back and forth by this many cells per row")
+	fill := flag.Bool("fill", false, "Draw fire as solid background-colored blocks")
+	splash := flag.Bool("splash", false, "Rain drops splash on the bottom row and collect in a shimmering puddle")
+	bold := flag.Int("bold", 0, "Draw the N brightest fire/fireworks palette colors bold")
+	gravity := flag.Float64("gravity", 0, "Fireworks fall speed multiplier (0 = default 1)")
+	count := flag.Int("count", 0, "Most fireworks bursts on screen at once, up to 50 (0 = no cap)")
+	particles := flag.Int("particles", 0, "Particles per fireworks burst, up to 200 (0 = default 25)")
+	shape := flag.String("shape", "circle", "Fireworks burst shape ("+strings.Join(animations.FireworksShapes, ", ")+")")
+	seed := flag.Int64("seed", 0, "Random seed for reproducible output (0 = random)")
+	noColor := flag.Bool("no-color", false, "Draw glyphs only, without color (also set by the NO_COLOR environment variable)")
+	bg := flag.String("bg", "", "Background #RRGGBB color filled behind every cell (default: terminal background)")
+	scale := flag.Int("scale", 1, "Run cell effects at 1/N of the terminal size, drawing each cell as an NxN block")
+	step := flag.Bool("step", false, "Start paused and step frames with space (p plays/pauses, q quits)")
+	screensaver := flag.Bool("screensaver", false, "Cycle random effects and themes until a key is pressed")
+	transition := flag.String("transition", "dissolve", "Screensaver transition between effects ("+strings.Join(animations.Transitions, ", ")+")")
+	easing := flag.String("easing", "easeIn", "Pour easing function ("+strings.Join(animations.PourEasings, ", ")+")")
 	help := flag.Bool("h", false, "Show help")
 	flag.BoolVar(help, "help", false, "Show help")
-	showVersion := flag.Bool("version", false, "Show version")
+	showVersion := flag.Bool("version", false, "Show version, commit, and build date")
+	listEffects := flag.Bool("list-effects", false, "Print available effects, one per line")
+	listThemes := flag.Bool("list-themes", false, "Print available themes, one per line")
+	listJSON := flag.Bool("json", false, "Print -list-effects/-list-themes as a JSON array")
+	profile := flag.String("profile", "", "Write a CPU profile of the run to this file")
+	quiet := flag.Bool("quiet", false, "Don't print informational messages such as the theme -theme random picked")
 
 	flag.Usage = showHelp
 	flag.Parse()
@@ -251,1025 +768,433 @@ func main() {
 	}
 
 	if *showVersion {
-		fmt.Printf("syscgo version %s\n", version)
+		fmt.Print(readBuildInfo())
 		return
 	}
 
-	// Get terminal size
-	width, height, err := term.GetSize(int(os.Stdout.Fd()))
-	if err != nil {
-		width, height = 80, 24
+	if *listEffects {
+		printList(availableEffects(), *listJSON)
+		return
 	}
 
-	// Setup terminal
-	fmt.Print("\033[2J\033[H")   // Clear screen
-	fmt.Print("\033[?25l")       // Hide cursor
-	defer fmt.Print("\033[?25h") // Show cursor on exit
-
-	// Calculate frame count (0 = infinite)
-	frames := 0
-	if *duration > 0 {
-		frames = *duration * 20 // 20 fps
+	if *listThemes {
+		printList(availableThemes(), *listJSON)
+		return
 	}
 
-	switch *effect {
-	case "fire":
-		runFire(width, height, *theme, frames)
-	case "fire-text":
-		runFireText(width, height, *theme, *file, frames)
-	case "matrix":
-		runMatrix(width, height, *theme, frames)
-	case "matrix-art":
-		runMatrixArt(width, height, *theme, *file, frames)
-	case "fireworks":
-		runFireworks(width, height, *theme, frames)
-	case "rain":
-		runRain(width, height, *theme, frames)
-	case "rain-art":
-		runRainArt(width, height, *theme, *file, frames)
-	case "pour":
-		runPour(width, height, *theme, *file, frames)
-	case "print":
-		runPrint(width, height, *theme, *file, frames)
-	case "beams":
-		runBeams(width, height, *theme, frames)
-	case "beam-text":
-		runBeamText(width, height, *theme, *file, *auto, *display, frames)
-	case "ring-text":
-		runRingText(width, height, *theme, *file, frames)
-	case "blackhole":
-		runBlackhole(width, height, *theme, *file, frames)
-	// WIP: blackhole-particles is currently broken (terminal scrolling issue)
-	// case "blackhole-particles":
-	// 	runBlackhole(width, height, *theme, "", frames)
-	case "aquarium":
-		runAquarium(width, height, *theme, frames)
-	default:
+	run, ok := effectRunners[*effect]
+	if !ok {
 		fmt.Printf("Unknown effect: %s\n", *effect)
-		fmt.Println("Available: fire, fire-text, matrix, rain, fireworks, pour, print, beams, beam-text, ring-text, blackhole, aquarium")
+		fmt.Printf("Available: %s\n", strings.Join(availableEffects(), ", "))
 		os.Exit(1)
 	}
-}
-
-func runFire(width, height int, theme string, frames int) {
-	palette := animations.GetFirePalette(theme)
-	fire := animations.NewFireEffect(width, height, palette)
 
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
-
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
+	// Pick a random built-in theme, reproducible with -seed
+	if *theme == "random" {
+		*theme = randomTheme(*seed)
+		if !*quiet {
+			fmt.Fprintf(os.Stderr, "Using theme: %s\n", *theme)
 		}
-
-		fire.Update()
-		output := fire.Render()
-
-		fmt.Print("\033[H") // Move cursor to top
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
 	}
-}
 
-func runFireText(width, height int, theme string, file string, frames int) {
-	// Get theme palette for fire
-	palette := animations.GetFirePalette(theme)
-
-	// Read text from file or use default SYSC.txt
-	text := readTextFile(file)
-
-	// Create fire-text effect
-	fireText := animations.NewFireTextEffect(width, height, palette, text)
-
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
-
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
+	// Resolve theme colors; a theme file overrides the built-in theme field by field
+	colors, ok := animations.GetTheme(*theme)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Warning: Unknown theme %q, using %s\n", *theme, colors.Name)
+	}
+	if *themeFile != "" {
+		loaded, err := animations.LoadTheme(*themeFile)
+		if err != nil {
+			fmt.Printf("Error: Could not load theme %s: %v\n", *themeFile, err)
+			os.Exit(1)
 		}
-
-		fireText.Update()
-		output := fireText.Render()
-
-		fmt.Print("\033[H") // Move cursor to top
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
+		colors = loaded.WithDefaults(colors)
 	}
-}
 
-func runMatrix(width, height int, theme string, frames int) {
-	palette := animations.GetMatrixPalette(theme)
-	matrix := animations.NewMatrixEffect(width, height, palette)
+	if !animations.IsValidPourDirection(*direction) {
+		fmt.Fprintf(os.Stderr, "Warning: Unknown direction %q, using down\n", *direction)
+		*direction = "down"
+	}
 
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
+	textAlign, ok := animations.ParseTextAlign(*align)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Warning: Unknown alignment %q, using center\n", *align)
+	}
 
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
+	if !animations.IsValidGlyphSet(*glyphs) {
+		fmt.Fprintf(os.Stderr, "Warning: Unknown glyph set %q, using mixed\n", *glyphs)
+		*glyphs = "mixed"
+	}
 
-		matrix.Update()
-		output := matrix.Render()
+	if !animations.IsValidBurstShape(*shape) {
+		fmt.Fprintf(os.Stderr, "Warning: Unknown shape %q, using circle\n", *shape)
+		*shape = "circle"
+	}
 
-		fmt.Print("\033[H") // Move cursor to top
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
+	if !animations.IsValidTransition(*transition) {
+		fmt.Fprintf(os.Stderr, "Warning: Unknown transition %q, using dissolve\n", *transition)
+		*transition = "dissolve"
 	}
-}
 
-func runMatrixArt(width, height int, theme string, file string, frames int) {
-	// Get theme palette for matrix
-	palette := animations.GetMatrixPalette(theme)
+	if !animations.IsValidEasing(*easing) {
+		fmt.Fprintf(os.Stderr, "Warning: Unknown easing %q, using easeIn\n", *easing)
+		*easing = "easeIn"
+	}
 
-	// Read text from file or use default SYSC.txt
-	text := readTextFile(file)
+	speedRange, err := parseSpeedRange(*speed)
+	if err != nil {
+		fmt.Printf("Error: Invalid -speed %q: %v\n", *speed, err)
+		os.Exit(1)
+	}
 
-	// Create matrix-art effect
-	matrixArt := animations.NewMatrixArtEffect(width, height, palette, text)
+	if *noColor {
+		animations.SetColorProfile(animations.NoColor)
+	}
 
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
+	if *bg != "" && !animations.IsHexColor(*bg) {
+		fmt.Printf("Error: Invalid -bg %q: want a #RRGGBB color\n", *bg)
+		os.Exit(1)
+	}
 
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
+	// Profile the whole run for maintainers measuring render performance
+	if *profile != "" {
+		out, err := os.Create(*profile)
+		if err != nil {
+			fmt.Printf("Error: Could not create profile %s: %v\n", *profile, err)
+			os.Exit(1)
 		}
+		defer out.Close()
+		if err := pprof.StartCPUProfile(out); err != nil {
+			fmt.Printf("Error: Could not start profiling: %v\n", err)
+			os.Exit(1)
+		}
+		defer pprof.StopCPUProfile()
+	}
 
-		matrixArt.Update()
-		output := matrixArt.Render()
-
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
+	// Writing frames to files needs a fixed frame budget and no terminal input
+	if *outDir != "" {
+		if *frameCount <= 0 {
+			fmt.Println("Error: -out-dir needs -frames N")
+			os.Exit(1)
+		}
+		if *step || *screensaver {
+			fmt.Println("Error: -out-dir can't be combined with -step or -screensaver")
+			os.Exit(1)
+		}
 	}
-}
 
-func runFireworks(width, height int, theme string, frames int) {
-	palette := animations.GetFireworksPalette(theme)
-	fireworks := animations.NewFireworksEffect(width, height, palette)
+	// Get terminal size
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		width, height = 80, 24
+	}
 
+	// Setup terminal; the interrupt handler is installed first so Ctrl+C
+	// always ends the run loop and lets the terminal be restored.
+	// Frame files are written without touching the terminal.
 	quit := setupKeyboardInterrupt()
-	defer close(quit)
+	if *outDir == "" {
+		enterTerminal()
+		defer restoreTerminal()
+	}
 
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
+	// Calculate frame count (0 = infinite). Without -duration the effect's own
+	// default applies, so ambient effects loop and reveals get time to finish.
+	if !flagPassed("duration") {
+		*duration = animations.GetEffectMetadata(*effect).DefaultDuration
+	}
+	frames := 0
+	if *duration > 0 {
+		frames = *duration * 20 // 20 fps
+	}
+	if *frameCount > 0 {
+		frames = *frameCount
+	}
 
-		fireworks.Update()
-		output := fireworks.Render()
+	// Step mode reads single keys and runs until quit, however long stepping takes.
+	// The screensaver reads keys too, to exit on any of them.
+	var keys <-chan byte
+	if *step || *screensaver {
+		keys, err = readKeys()
+		if err != nil {
+			fatalf("Error: -step and -screensaver need an interactive terminal: %v\n", err)
+		}
+		frames = 0
+	}
 
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
+	opts := runOptions{
+		width:     width,
+		height:    height,
+		theme:     colors,
+		file:      *file,
+		auto:      *auto,
+		display:   *display,
+		wrap:      *wrap,
+		mirror:    *mirror,
+		noIntro:   *noIntro,
+		align:     textAlign,
+		margin:    max(*margin, 0),
+		frames:    frames,
+		diff:      *diff,
+		fast:      *fast,
+		easing:    *easing,
+		direction: *direction,
+		trail:     *trail,
+		glyphs:    *glyphs,
+		density:   *density,
+		speed:     speedRange,
+		command:   *command,
+		interval:  *interval,
+		maxFish:   *maxFish,
+		seed:      *seed,
+		bold:      *bold,
+		shape:     *shape,
+		gravity:   *gravity,
+		count:     *count,
+		particles: *particles,
+		fill:      *fill,
+		splash:    *splash,
+		wind:      *wind,
+		windSway:  *windSway,
+		bg:        *bg,
+		scale:     *scale,
+		outDir:    *outDir,
+		outFrames: frames,
+		quit:      quit,
+		keys:      keys,
 	}
+	if *screensaver {
+		runScreensaver(opts, keys, *transition)
+		return
+	}
+	run(opts)
 }
 
-func runRain(width, height int, theme string, frames int) {
-	palette := animations.GetRainPalette(theme)
-	rain := animations.NewRainEffect(width, height, palette)
-
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
+// newEffect builds the named effect from the command line options, showing
+// text. It exits with the error when the effect can't be built, e.g. when
+// ring-text or blackhole text doesn't fit the terminal.
+func (opts runOptions) newEffect(name, text string) animations.Animation {
+	effect, err := effects.New(name, opts.theme, effects.Settings{
+		Width:     opts.width,
+		Height:    opts.height,
+		Text:      text,
+		Seed:      opts.seed,
+		Align:     opts.align,
+		Margin:    opts.margin,
+		Auto:      opts.auto,
+		Display:   opts.display,
+		NoIntro:   opts.noIntro,
+		Bold:      opts.bold,
+		Fill:      opts.fill,
+		Wind:      opts.wind,
+		WindSway:  opts.windSway,
+		Trail:     opts.trail,
+		Glyphs:    opts.glyphs,
+		Density:   opts.density,
+		Speed:     opts.speed,
+		Splash:    opts.splash,
+		Shape:     opts.shape,
+		Gravity:   opts.gravity,
+		Count:     opts.count,
+		Particles: opts.particles,
+		Direction: opts.direction,
+		Easing:    opts.easing,
+		MaxFish:   opts.maxFish,
+		Command:   opts.command,
+		Interval:  opts.interval,
+		Clip:      opts.clip,
+	})
+	if errors.Is(err, animations.ErrTextTooLarge) {
+		fatalf("Error: %s: %v\nUse a larger terminal or a smaller -file\n", name, err)
+	}
+	if err != nil {
+		fatalf("Error: %s: %v\n", name, err)
+	}
+	return effect
+}
 
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
+func runFire(opts runOptions) {
+	animate(opts.newEffect("fire", ""), opts, 50*time.Millisecond)
+}
 
-		rain.Update()
-		output := rain.Render()
+func runFireText(opts runOptions) {
+	// Read text from file or use default SYSC.txt
+	text := opts.fitText(readTextFile(opts.file))
 
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
-	}
+	animate(opts.newEffect("fire-text", text), opts, 50*time.Millisecond)
 }
 
-func runRainArt(width, height int, theme string, file string, frames int) {
-	// Get theme palette for rain
-	palette := animations.GetRainPalette(theme)
+func runMatrix(opts runOptions) {
+	animate(opts.newEffect("matrix", ""), opts, 50*time.Millisecond)
+}
 
+func runMatrixArt(opts runOptions) {
 	// Read text from file or use default SYSC.txt
-	text := readTextFile(file)
+	text := opts.fitText(readTextFile(opts.file))
 
-	// Create rain-art effect
-	rainArt := animations.NewRainArtEffect(width, height, palette, text)
-
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
+	// Display mode reveals the art and exits after the hold, however long
+	// -duration is
+	if opts.display {
+		opts.frames = 0
+	}
 
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
+	animate(opts.newEffect("matrix-art", text), opts, 50*time.Millisecond)
+}
 
-		rainArt.Update()
-		output := rainArt.Render()
+func runFireworks(opts runOptions) {
+	animate(opts.newEffect("fireworks", ""), opts, 50*time.Millisecond)
+}
 
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
-	}
+func runRain(opts runOptions) {
+	animate(opts.newEffect("rain", ""), opts, 50*time.Millisecond)
 }
 
-func runPour(width, height int, theme string, file string, frames int) {
-	// Get theme colors for pour effect
-	var gradientStops []string
-
-	switch theme {
-	case "dracula":
-		gradientStops = []string{"#ff79c6", "#bd93f9", "#ffffff"}
-	case "gruvbox":
-		gradientStops = []string{"#fe8019", "#fabd2f", "#ffffff"}
-	case "nord":
-		gradientStops = []string{"#88c0d0", "#81a1c1", "#ffffff"}
-	case "tokyo-night":
-		gradientStops = []string{"#9ece6a", "#e0af68", "#ffffff"}
-	case "catppuccin":
-		gradientStops = []string{"#cba6f7", "#f5c2e7", "#ffffff"}
-	case "material":
-		gradientStops = []string{"#03dac6", "#bb86fc", "#ffffff"}
-	case "solarized":
-		gradientStops = []string{"#268bd2", "#2aa198", "#ffffff"}
-	case "monochrome":
-		gradientStops = []string{"#808080", "#c0c0c0", "#ffffff"}
-	case "transishardjob":
-		gradientStops = []string{"#55cdfc", "#f7a8b8", "#ffffff"}
-	case "rama":
-		gradientStops = []string{"#ef233c", "#d90429", "#edf2f4"}
-	case "eldritch":
-		gradientStops = []string{"#37f499", "#04d1f9", "#ebfafa"}
-	case "dark":
-		gradientStops = []string{"#ffffff", "#cccccc", "#ffffff"}
-	default:
-		gradientStops = []string{"#8A008A", "#00D1FF", "#FFFFFF"}
+func runRainArt(opts runOptions) {
+	// Read text from file or use default SYSC.txt
+	text := opts.fitText(readTextFile(opts.file))
+
+	// Display mode reveals the art and exits after the hold, however long
+	// -duration is
+	if opts.display {
+		opts.frames = 0
 	}
 
+	animate(opts.newEffect("rain-art", text), opts, 50*time.Millisecond)
+}
+
+func runPour(opts runOptions) {
 	// Read text from file or use default
 	text := "POUR EFFECT\nDEMO TEXT\nTHIRD LINE"
-	if file != "" {
-		data, err := os.ReadFile(file)
+	if opts.file != "" {
+		data, err := os.ReadFile(opts.file)
 		if err == nil {
 			text = string(data)
 		}
 	}
 
-	// Don't wrap text - ASCII art needs to be preserved as-is
 	// The pour effect will handle centering
+	text = opts.fitText(text)
 
-	// Create pour effect with sample text centered in terminal
-	config := animations.PourConfig{
-		Width:                  width,
-		Height:                 height,
-		Text:                   text,
-		PourDirection:          "down",
-		PourSpeed:              3,
-		MovementSpeed:          0.2,
-		EasingFunction:         "easeIn", // Options: "easeIn", "easeOut", "easeInOut"
-		Gap:                    1,
-		StartingColor:          "#ffffff",
-		FinalGradientStops:     gradientStops,
-		FinalGradientSteps:     12,
-		FinalGradientFrames:    5,
-		FinalGradientDirection: "horizontal",
-		Auto:                   false, // CLI uses full terminal width/height
-		Display:                false, // CLI loops continuously
-		HoldFrames:             100,   // ~5 seconds at 20fps
-	}
-
-	pour := animations.NewPourEffect(config)
-
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
-
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
-
-		pour.Update()
-		output := pour.Render()
-
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
-	}
+	animate(opts.newEffect("pour", text), opts, 50*time.Millisecond)
 }
 
-func runPrint(width, height int, theme string, file string, frames int) {
-	// Get theme colors for print effect
-	var gradientStops []string
-
-	switch theme {
-	case "dracula":
-		gradientStops = []string{"#ff79c6", "#bd93f9", "#8be9fd"}
-	case "gruvbox":
-		gradientStops = []string{"#fe8019", "#fabd2f", "#b8bb26"}
-	case "nord":
-		gradientStops = []string{"#88c0d0", "#81a1c1", "#5e81ac"}
-	case "tokyo-night":
-		gradientStops = []string{"#9ece6a", "#e0af68", "#bb9af7"}
-	case "catppuccin":
-		gradientStops = []string{"#cba6f7", "#f5c2e7", "#f5e0dc"}
-	case "material":
-		gradientStops = []string{"#03dac6", "#bb86fc", "#cf6679"}
-	case "solarized":
-		gradientStops = []string{"#268bd2", "#2aa198", "#859900"}
-	case "monochrome":
-		gradientStops = []string{"#808080", "#c0c0c0", "#ffffff"}
-	case "transishardjob":
-		gradientStops = []string{"#55cdfc", "#f7a8b8", "#ffffff"}
-	case "rama":
-		gradientStops = []string{"#ef233c", "#d90429", "#edf2f4"}
-	case "eldritch":
-		gradientStops = []string{"#37f499", "#04d1f9", "#ebfafa"}
-	case "dark":
-		gradientStops = []string{"#ffffff", "#cccccc", "#ffffff"}
-	default:
-		gradientStops = []string{"#8A008A", "#00D1FF", "#FFFFFF"}
-	}
-
+func runPrint(opts runOptions) {
 	// Read text from file or use default
 	text := "PRINT EFFECT\nDEMO TEXT\nTHIRD LINE"
-	if file != "" {
-		data, err := os.ReadFile(file)
+	if opts.file != "" {
+		data, err := os.ReadFile(opts.file)
 		if err == nil {
 			text = string(data)
 		}
 	}
 
-	// Don't wrap text - ASCII art needs to be preserved as-is
 	// The print effect will handle centering
+	text = opts.fitText(text)
 
-	// Create print effect configuration
-	config := animations.PrintConfig{
-		Width:           width,
-		Height:          height,
-		Text:            text,
-		FramesPerChar:   1, // Print every frame for smooth animation
-		PrintSpeed:      2, // 2 characters per update
-		PrintHeadSymbol: "█",
-		TrailSymbols:    []string{"░", "▒", "▓"},
-		GradientStops:   gradientStops,
-		Auto:            false, // CLI uses full terminal width/height
-		Display:         false, // CLI loops continuously
-		HoldFrames:      100,   // ~5 seconds at 20fps
-	}
-
-	print := animations.NewPrintEffect(config)
-
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
-
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
-
-		print.Update()
-		output := print.Render()
-
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(30 * time.Millisecond)
-		frame++
-	}
+	animate(opts.newEffect("print", text), opts, 30*time.Millisecond)
 }
 
-func runBeams(width, height int, theme string, frames int) {
-	// Get theme colors for beams background effect
-	var beamGradientStops []string
-	var finalGradientStops []string
-
-	switch theme {
-	case "dracula":
-		beamGradientStops = []string{"#ffffff", "#8be9fd", "#bd93f9"}
-		finalGradientStops = []string{"#6272a4", "#bd93f9", "#f8f8f2"}
-	case "gruvbox":
-		beamGradientStops = []string{"#ffffff", "#fabd2f", "#fe8019"}
-		finalGradientStops = []string{"#504945", "#fabd2f", "#ebdbb2"}
-	case "nord":
-		beamGradientStops = []string{"#ffffff", "#88c0d0", "#81a1c1"}
-		finalGradientStops = []string{"#434c5e", "#88c0d0", "#eceff4"}
-	case "tokyo-night":
-		beamGradientStops = []string{"#ffffff", "#7dcfff", "#bb9af7"}
-		finalGradientStops = []string{"#414868", "#7aa2f7", "#c0caf5"}
-	case "catppuccin":
-		beamGradientStops = []string{"#ffffff", "#89dceb", "#cba6f7"}
-		finalGradientStops = []string{"#45475a", "#cba6f7", "#cdd6f4"}
-	case "material":
-		beamGradientStops = []string{"#ffffff", "#89ddff", "#bb86fc"}
-		finalGradientStops = []string{"#546e7a", "#89ddff", "#eceff1"}
-	case "solarized":
-		beamGradientStops = []string{"#ffffff", "#2aa198", "#268bd2"}
-		finalGradientStops = []string{"#586e75", "#2aa198", "#fdf6e3"}
-	case "monochrome":
-		beamGradientStops = []string{"#ffffff", "#c0c0c0", "#808080"}
-		finalGradientStops = []string{"#3a3a3a", "#9a9a9a", "#ffffff"}
-	case "transishardjob":
-		beamGradientStops = []string{"#ffffff", "#55cdfc", "#f7a8b8"}
-		finalGradientStops = []string{"#55cdfc", "#f7a8b8", "#ffffff"}
-	case "rama":
-		beamGradientStops = []string{"#ffffff", "#ef233c", "#d90429"}
-		finalGradientStops = []string{"#8d99ae", "#ef233c", "#edf2f4"}
-	case "eldritch":
-		beamGradientStops = []string{"#ffffff", "#37f499", "#04d1f9"}
-		finalGradientStops = []string{"#7081d0", "#37f499", "#ebfafa"}
-	case "dark":
-		beamGradientStops = []string{"#ffffff", "#cccccc", "#999999"}
-		finalGradientStops = []string{"#333333", "#ffffff", "#ffffff"}
-	default:
-		beamGradientStops = []string{"#ffffff", "#00D1FF", "#8A008A"}
-		finalGradientStops = []string{"#4A4A4A", "#00D1FF", "#FFFFFF"}
-	}
-
-	// Create beams background effect configuration
-	config := animations.BeamsConfig{
-		Width:                width,
-		Height:               height,
-		BeamRowSymbols:       []rune{'▂', '▁', '_'},
-		BeamColumnSymbols:    []rune{'▌', '▍', '▎', '▏'},
-		BeamDelay:            2,
-		BeamRowSpeedRange:    [2]int{20, 80},
-		BeamColumnSpeedRange: [2]int{15, 30},
-		BeamGradientStops:    beamGradientStops,
-		BeamGradientSteps:    5,
-		BeamGradientFrames:   1,
-		FinalGradientStops:   finalGradientStops,
-		FinalGradientSteps:   8,
-		FinalGradientFrames:  1,
-		FinalWipeSpeed:       3,
-	}
-
-	beams := animations.NewBeamsEffect(config)
-
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
-
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
-
-		beams.Update()
-		output := beams.Render()
-
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
-	}
+func runBeams(opts runOptions) {
+	animate(opts.newEffect("beams", ""), opts, 50*time.Millisecond)
 }
 
-func runBeamText(width, height int, theme string, file string, auto bool, display bool, frames int) {
-	// Get theme colors for beam text effect
-	var beamGradientStops []string
-	var finalGradientStops []string
-
-	switch theme {
-	case "dracula":
-		beamGradientStops = []string{"#ffffff", "#8be9fd", "#bd93f9"}
-		finalGradientStops = []string{"#6272a4", "#bd93f9", "#f8f8f2"}
-	case "gruvbox":
-		beamGradientStops = []string{"#ffffff", "#fabd2f", "#fe8019"}
-		finalGradientStops = []string{"#504945", "#fabd2f", "#ebdbb2"}
-	case "nord":
-		beamGradientStops = []string{"#ffffff", "#88c0d0", "#81a1c1"}
-		finalGradientStops = []string{"#434c5e", "#88c0d0", "#eceff4"}
-	case "tokyo-night":
-		beamGradientStops = []string{"#ffffff", "#7dcfff", "#bb9af7"}
-		finalGradientStops = []string{"#414868", "#7aa2f7", "#c0caf5"}
-	case "catppuccin":
-		beamGradientStops = []string{"#ffffff", "#89dceb", "#cba6f7"}
-		finalGradientStops = []string{"#45475a", "#cba6f7", "#cdd6f4"}
-	case "material":
-		beamGradientStops = []string{"#ffffff", "#89ddff", "#bb86fc"}
-		finalGradientStops = []string{"#546e7a", "#89ddff", "#eceff1"}
-	case "solarized":
-		beamGradientStops = []string{"#ffffff", "#2aa198", "#268bd2"}
-		finalGradientStops = []string{"#586e75", "#2aa198", "#fdf6e3"}
-	case "monochrome":
-		beamGradientStops = []string{"#ffffff", "#c0c0c0", "#808080"}
-		finalGradientStops = []string{"#3a3a3a", "#9a9a9a", "#ffffff"}
-	case "transishardjob":
-		beamGradientStops = []string{"#ffffff", "#55cdfc", "#f7a8b8"}
-		finalGradientStops = []string{"#55cdfc", "#f7a8b8", "#ffffff"}
-	case "rama":
-		beamGradientStops = []string{"#ffffff", "#ef233c", "#d90429"}
-		finalGradientStops = []string{"#8d99ae", "#ef233c", "#edf2f4"}
-	case "eldritch":
-		beamGradientStops = []string{"#ffffff", "#37f499", "#04d1f9"}
-		finalGradientStops = []string{"#7081d0", "#37f499", "#ebfafa"}
-	case "dark":
-		beamGradientStops = []string{"#ffffff", "#cccccc", "#999999"}
-		finalGradientStops = []string{"#333333", "#ffffff", "#ffffff"}
-	default:
-		beamGradientStops = []string{"#ffffff", "#00D1FF", "#8A008A"}
-		finalGradientStops = []string{"#4A4A4A", "#00D1FF", "#FFFFFF"}
-	}
-
+func runBeamText(opts runOptions) {
 	// Read text from file
-	text := readTextFile(file)
+	text := readTextFile(opts.file)
 	if text == "" {
-		fmt.Println("beam-text effect requires -file flag")
-		os.Exit(1)
+		fatalf("beam-text effect requires -file flag\n")
 	}
 
-	// Don't wrap text - ASCII art needs to be preserved as-is
-	// The beam-text effect will handle sizing based on auto flag
-
-	// Create beam text effect configuration
-	config := animations.BeamTextConfig{
-		Width:                width,
-		Height:               height,
-		Text:                 text,
-		Auto:                 auto,
-		Display:              display,
-		BeamRowSymbols:       []rune{'▂', '▁', '_'},
-		BeamColumnSymbols:    []rune{'▌', '▍', '▎', '▏'},
-		BeamDelay:            2,
-		BeamRowSpeedRange:    [2]int{20, 80},
-		BeamColumnSpeedRange: [2]int{15, 30},
-		BeamGradientStops:    beamGradientStops,
-		BeamGradientSteps:    5,
-		BeamGradientFrames:   1,
-		FinalGradientStops:   finalGradientStops,
-		FinalGradientSteps:   8,
-		FinalGradientFrames:  1,
-		FinalWipeSpeed:       3,
-	}
-
-	beamText := animations.NewBeamTextEffect(config)
-
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
+	// The beam-text effect will handle sizing based on auto flag;
+	// an auto-sized canvas grows to fit the text, so it is never wrapped
+	if opts.auto {
+		opts.wrap = false
+	}
+	text = opts.fitText(text)
 
 	// When display mode is enabled, ignore duration and run until completion
 	// This allows the multi-phase beam-text animation to reach its final "hold" state
-	effectiveFrames := frames
-	if display {
-		effectiveFrames = 0
+	if opts.display {
+		opts.frames = 0
 	}
 
-	frame := 0
-	for effectiveFrames == 0 || frame < effectiveFrames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
-
-		beamText.Update()
-		output := beamText.Render()
-
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
-	}
+	animate(opts.newEffect("beam-text", text), opts, 50*time.Millisecond)
 }
 
-func runRingText(width, height int, theme string, file string, frames int) {
-	// Get theme colors for ring text effect
-	var ringColors []string
-	var finalGradientStops []string
-
-	switch theme {
-	case "dracula":
-		ringColors = []string{"#bd93f9", "#ff79c6", "#f1fa8c", "#8be9fd", "#50fa7b", "#ffb86c"}
-		finalGradientStops = []string{"#6272a4", "#bd93f9", "#f8f8f2"}
-	case "gruvbox":
-		ringColors = []string{"#fabd2f", "#fe8019", "#b8bb26", "#83a598", "#d3869b", "#fb4934"}
-		finalGradientStops = []string{"#504945", "#fabd2f", "#ebdbb2"}
-	case "nord":
-		ringColors = []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb", "#b48ead", "#a3be8c"}
-		finalGradientStops = []string{"#434c5e", "#88c0d0", "#eceff4"}
-	case "tokyo-night":
-		ringColors = []string{"#7dcfff", "#bb9af7", "#9ece6a", "#7aa2f7", "#ff9e64", "#f7768e"}
-		finalGradientStops = []string{"#414868", "#7aa2f7", "#c0caf5"}
-	case "catppuccin":
-		ringColors = []string{"#cba6f7", "#f5c2e7", "#a6e3a1", "#89b4fa", "#f38ba8", "#fab387"}
-		finalGradientStops = []string{"#45475a", "#cba6f7", "#cdd6f4"}
-	case "material":
-		ringColors = []string{"#bb86fc", "#03dac6", "#cf6679", "#89ddff", "#ffcb6b", "#c3e88d"}
-		finalGradientStops = []string{"#546e7a", "#89ddff", "#eceff1"}
-	case "solarized":
-		ringColors = []string{"#268bd2", "#2aa198", "#859900", "#cb4b16", "#d33682", "#6c71c4"}
-		finalGradientStops = []string{"#586e75", "#2aa198", "#fdf6e3"}
-	case "monochrome":
-		ringColors = []string{"#ffffff", "#e0e0e0", "#c0c0c0", "#a0a0a0", "#808080", "#606060"}
-		finalGradientStops = []string{"#3a3a3a", "#9a9a9a", "#ffffff"}
-	case "transishardjob":
-		ringColors = []string{"#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc", "#ffffff"}
-		finalGradientStops = []string{"#55cdfc", "#f7a8b8", "#ffffff"}
-	case "rama":
-		ringColors = []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4", "#ef233c", "#d90429"}
-		finalGradientStops = []string{"#8d99ae", "#ef233c", "#edf2f4"}
-	case "eldritch":
-		ringColors = []string{"#37f499", "#04d1f9", "#a48cf2", "#f265b5", "#f16c75", "#f7c67f"}
-		finalGradientStops = []string{"#7081d0", "#37f499", "#ebfafa"}
-	case "dark":
-		ringColors = []string{"#ffffff", "#cccccc", "#999999", "#666666", "#999999", "#ffffff"}
-		finalGradientStops = []string{"#333333", "#ffffff", "#ffffff"}
-	default:
-		ringColors = []string{"#bd93f9", "#ff79c6", "#f1fa8c", "#8be9fd", "#50fa7b", "#ffb86c"}
-		finalGradientStops = []string{"#4A4A4A", "#00D1FF", "#FFFFFF"}
-	}
-
+func runRingText(opts runOptions) {
 	// Read text from file or use default SYSC.txt
-	text := readTextFile(file)
-
-	// Create ring text effect configuration (TTE-like parameters with theme-sensitive gradients)
-	config := animations.RingTextConfig{
-		Width:               width,
-		Height:              height,
-		Text:                text,
-		RingColors:          ringColors,
-		RingGap:             0.1,                      // Like TTE default
-		SpinSpeedRange:      [2]float64{0.025, 0.075}, // Min-max range like TTE (0.25-1.0 mapped to radians)
-		SpinDuration:        200,                      // Frames per spin rotation
-		DisperseDuration:    200,                      // Frames in dispersed state
-		SpinDisperseCycles:  3,                        // 3 cycles like TTE default
-		TransitionFrames:    60,                       // Transition between states (reduced for faster animation)
-		StaticFrames:        30,                       // Initial static display (reduced to start ring animation sooner)
-		FinalGradientStops:  finalGradientStops,
-		FinalGradientSteps:  12,
-		StaticGradientStops: ringColors,                    // Use ring colors for static gradient
-		StaticGradientDir:   animations.GradientHorizontal, // Left-to-right gradient
-	}
-
-	ringText := animations.NewRingTextEffect(config)
+	text := opts.fitText(readTextFile(opts.file))
 
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
-
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
-
-		ringText.Update()
-		output := ringText.Render()
-
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
-	}
+	animate(opts.newEffect("ring-text", text), opts, 50*time.Millisecond)
 }
 
-func runBlackhole(width, height int, theme string, file string, frames int) {
-	// Get theme colors for blackhole effect
-	var starColors []string
-	var blackholeColor string
-
-	switch theme {
-	case "dracula":
-		starColors = []string{"#bd93f9", "#ff79c6", "#f1fa8c", "#8be9fd", "#50fa7b", "#ffb86c"}
-		blackholeColor = "#f8f8f2"
-	case "gruvbox":
-		starColors = []string{"#fabd2f", "#fe8019", "#b8bb26", "#83a598", "#d3869b", "#fb4934"}
-		blackholeColor = "#ebdbb2"
-	case "nord":
-		starColors = []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb", "#b48ead", "#a3be8c"}
-		blackholeColor = "#eceff4"
-	case "tokyo-night":
-		starColors = []string{"#7dcfff", "#bb9af7", "#9ece6a", "#7aa2f7", "#f7768e", "#e0af68"}
-		blackholeColor = "#c0caf5"
-	case "catppuccin":
-		starColors = []string{"#cba6f7", "#f5c2e7", "#a6e3a1", "#89dceb", "#fab387", "#f38ba8"}
-		blackholeColor = "#cdd6f4"
-	case "material":
-		starColors = []string{"#bb86fc", "#03dac6", "#cf6679", "#89ddff", "#c3e88d", "#ffcb6b"}
-		blackholeColor = "#eceff1"
-	case "solarized":
-		starColors = []string{"#268bd2", "#2aa198", "#859900", "#cb4b16", "#6c71c4", "#b58900"}
-		blackholeColor = "#fdf6e3"
-	case "monochrome":
-		starColors = []string{"#ffffff", "#c0c0c0", "#808080", "#9a9a9a", "#bababa", "#dadada"}
-		blackholeColor = "#ffffff"
-	case "transishardjob":
-		starColors = []string{"#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc", "#ffffff"}
-		blackholeColor = "#ffffff"
-	case "rama":
-		starColors = []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4", "#ef233c", "#d90429"}
-		blackholeColor = "#edf2f4"
-	case "eldritch":
-		starColors = []string{"#37f499", "#04d1f9", "#a48cf2", "#f265b5", "#f16c75", "#f7c67f"}
-		blackholeColor = "#ebfafa"
-	case "dark":
-		starColors = []string{"#ffffff", "#cccccc", "#999999", "#666666", "#999999", "#ffffff"}
-		blackholeColor = "#ffffff"
-	default:
-		starColors = []string{"#ffffff", "#ffd700", "#ff6b6b", "#4ecdc4", "#95e1d3", "#f38181"}
-		blackholeColor = "#ffffff"
-	}
-
+func runBlackhole(opts runOptions) {
 	// Read text from file
 	// If file is empty string, use empty text (triggers particle generation)
 	// Otherwise read from file or use default assets/SYSC.txt
 	var text string
 
-	if file == "" {
+	if opts.file == "" {
 		// Empty file means generate random particles (no text)
 		text = ""
 	} else {
-		// Try to read from provided file
-		data, readErr := os.ReadFile(file)
-		if readErr == nil {
-			text = string(data)
-		} else {
-			// Fall back to assets/SYSC.txt
-			data, readErr = os.ReadFile("assets/SYSC.txt")
-			if readErr == nil {
-				text = string(data)
-				fmt.Printf("Warning: Could not read %s, using assets/SYSC.txt\n", file)
-				time.Sleep(1 * time.Second)
-			} else {
-				fmt.Printf("Error: Could not read file %s or assets/SYSC.txt\n", file)
-				os.Exit(1)
-			}
-		}
+		// Read from provided file, falling back to SYSC.txt
+		text = readTextFile(opts.file)
 	}
+	text = opts.fitText(text)
 
-	// Create blackhole effect configuration
-	config := animations.BlackholeConfig{
-		Width:               width,
-		Height:              height,
-		Text:                text,
-		BlackholeColor:      blackholeColor,
-		StarColors:          starColors,
-		FinalGradientStops:  starColors, // Use same gradient as start
-		FinalGradientSteps:  12,
-		FinalGradientDir:    animations.GradientHorizontal, // Match start direction
-		StaticGradientStops: starColors,
-		StaticGradientDir:   animations.GradientHorizontal,
-		FormingFrames:       10,
-		ConsumingFrames:     60,
-		CollapsingFrames:    50,
-		ExplodingFrames:     100,
-		ReturningFrames:     120,
-		StaticFrames:        30,
-	}
-
-	blackhole := animations.NewBlackholeEffect(config)
-
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
-
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
+	animate(opts.newEffect("blackhole", text), opts, 50*time.Millisecond)
+}
 
-		blackhole.Update()
-		output := blackhole.Render()
+func runAquarium(opts runOptions) {
+	animate(opts.newEffect("aquarium", ""), opts, 50*time.Millisecond)
+}
 
-		fmt.Print("\033[H")
-		fmt.Print(output)
-		os.Stdout.Sync() // Flush output buffer immediately
-		time.Sleep(50 * time.Millisecond)
-		frame++
+func runLife(opts runOptions) {
+	// Seed from -file when given, otherwise from random noise
+	text := ""
+	if opts.file != "" {
+		text = opts.fitText(readTextFile(opts.file))
 	}
+
+	animate(opts.newEffect("life", text), opts, 50*time.Millisecond)
 }
 
-func runAquarium(width, height int, theme string, frames int) {
-	// Theme-specific colors for aquarium
-	var fishColors []string
-	var waterColors []string
-	var seaweedColors []string
-	var bubbleColor string
-	var diverColor string
-	var boatColor string
-	var mermaidColor string
-	var anchorColor string
-
-	switch theme {
-	case "dracula":
-		fishColors = []string{"#ff79c6", "#bd93f9", "#8be9fd", "#50fa7b", "#ffb86c"}
-		waterColors = []string{"#6272a4", "#c2b280"}
-		seaweedColors = []string{"#44475a", "#50fa7b", "#8be9fd"}
-		bubbleColor = "#8be9fd"
-		diverColor = "#f8f8f2"
-		boatColor = "#ffb86c"
-		mermaidColor = "#ff79c6"
-		anchorColor = "#6272a4"
-	case "gruvbox":
-		fishColors = []string{"#fe8019", "#fabd2f", "#b8bb26", "#83a598", "#d3869b"}
-		waterColors = []string{"#458588", "#d79921"}
-		seaweedColors = []string{"#3c3836", "#98971a", "#b8bb26"}
-		bubbleColor = "#83a598"
-		diverColor = "#ebdbb2"
-		boatColor = "#fabd2f"
-		mermaidColor = "#d3869b"
-		anchorColor = "#504945"
-	case "nord":
-		fishColors = []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb", "#b48ead"}
-		waterColors = []string{"#5e81ac", "#d08770"}
-		seaweedColors = []string{"#2e3440", "#a3be8c", "#8fbcbb"}
-		bubbleColor = "#88c0d0"
-		diverColor = "#eceff4"
-		boatColor = "#d08770"
-		mermaidColor = "#b48ead"
-		anchorColor = "#4c566a"
-	case "tokyo-night":
-		fishColors = []string{"#7aa2f7", "#bb9af7", "#7dcfff", "#9ece6a", "#f7768e"}
-		waterColors = []string{"#7aa2f7", "#e0af68"}
-		seaweedColors = []string{"#1a1b26", "#9ece6a", "#7dcfff"}
-		bubbleColor = "#7dcfff"
-		diverColor = "#c0caf5"
-		boatColor = "#e0af68"
-		mermaidColor = "#bb9af7"
-		anchorColor = "#414868"
-	case "catppuccin":
-		fishColors = []string{"#f5c2e7", "#cba6f7", "#89dceb", "#a6e3a1", "#fab387"}
-		waterColors = []string{"#89b4fa", "#f9e2af"}
-		seaweedColors = []string{"#1e1e2e", "#a6e3a1", "#94e2d5"}
-		bubbleColor = "#89dceb"
-		diverColor = "#cdd6f4"
-		boatColor = "#fab387"
-		mermaidColor = "#f5c2e7"
-		anchorColor = "#45475a"
-	case "material":
-		fishColors = []string{"#82aaff", "#c792ea", "#89ddff", "#c3e88d", "#f78c6c"}
-		waterColors = []string{"#82aaff", "#ffcb6b"}
-		seaweedColors = []string{"#263238", "#c3e88d", "#89ddff"}
-		bubbleColor = "#89ddff"
-		diverColor = "#eceff1"
-		boatColor = "#ffcb6b"
-		mermaidColor = "#c792ea"
-		anchorColor = "#37474f"
-	case "solarized":
-		fishColors = []string{"#268bd2", "#2aa198", "#859900", "#cb4b16", "#6c71c4"}
-		waterColors = []string{"#268bd2", "#b58900"}
-		seaweedColors = []string{"#002b36", "#859900", "#2aa198"}
-		bubbleColor = "#2aa198"
-		diverColor = "#fdf6e3"
-		boatColor = "#cb4b16"
-		mermaidColor = "#d33682"
-		anchorColor = "#073642"
-	case "monochrome":
-		fishColors = []string{"#9a9a9a", "#bababa", "#dadada", "#c0c0c0", "#808080"}
-		waterColors = []string{"#5a5a5a", "#8a8a8a"}
-		seaweedColors = []string{"#1a1a1a", "#5a5a5a", "#7a7a7a"}
-		bubbleColor = "#c0c0c0"
-		diverColor = "#ffffff"
-		boatColor = "#9a9a9a"
-		mermaidColor = "#bababa"
-		anchorColor = "#3a3a3a"
-	case "transishardjob":
-		fishColors = []string{"#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc"}
-		waterColors = []string{"#55cdfc", "#f7a8b8"}
-		seaweedColors = []string{"#1a1a1a", "#55cdfc", "#f7a8b8"}
-		bubbleColor = "#ffffff"
-		diverColor = "#ffffff"
-		boatColor = "#f7a8b8"
-		mermaidColor = "#f7a8b8"
-		anchorColor = "#55cdfc"
-	case "rama":
-		fishColors = []string{"#ef233c", "#d90429", "#8d99ae", "#edf2f4", "#ef233c"}
-		waterColors = []string{"#8d99ae", "#ef233c"}
-		seaweedColors = []string{"#2b2d42", "#8d99ae", "#ef233c"}
-		bubbleColor = "#edf2f4"
-		diverColor = "#edf2f4"
-		boatColor = "#ef233c"
-		mermaidColor = "#d90429"
-		anchorColor = "#8d99ae"
-	case "eldritch":
-		fishColors = []string{"#37f499", "#04d1f9", "#a48cf2", "#f265b5", "#f16c75"}
-		waterColors = []string{"#7081d0", "#a48cf2"}
-		seaweedColors = []string{"#212337", "#37f499", "#04d1f9"}
-		bubbleColor = "#04d1f9"
-		diverColor = "#ebfafa"
-		boatColor = "#f7c67f"
-		mermaidColor = "#f265b5"
-		anchorColor = "#292e42"
-	case "dark":
-		fishColors = []string{"#ffffff", "#cccccc", "#999999", "#ffffff", "#cccccc"}
-		waterColors = []string{"#666666", "#999999"}
-		seaweedColors = []string{"#000000", "#333333", "#666666"}
-		bubbleColor = "#ffffff"
-		diverColor = "#ffffff"
-		boatColor = "#cccccc"
-		mermaidColor = "#ffffff"
-		anchorColor = "#333333"
-	default:
-		fishColors = []string{"#00ffff", "#ff00ff", "#ffff00", "#00ff00", "#ff8000"}
-		waterColors = []string{"#4a9eff", "#c2b280"}
-		seaweedColors = []string{"#001a1a", "#00ff00", "#00ffff"}
-		bubbleColor = "#00ffff"
-		diverColor = "#ffffff"
-		boatColor = "#ff8000"
-		mermaidColor = "#ff00ff"
-		anchorColor = "#808080"
-	}
-
-	config := animations.AquariumConfig{
-		Width:         width,
-		Height:        height,
-		FishColors:    fishColors,
-		WaterColors:   waterColors,
-		SeaweedColors: seaweedColors,
-		BubbleColor:   bubbleColor,
-		DiverColor:    diverColor,
-		BoatColor:     boatColor,
-		MermaidColor:  mermaidColor,
-		AnchorColor:   anchorColor,
-	}
-
-	aquarium := animations.NewAquariumEffect(config)
+func runStarfield(opts runOptions) {
+	animate(opts.newEffect("starfield", ""), opts, 50*time.Millisecond)
+}
 
-	quit := setupKeyboardInterrupt()
-	defer close(quit)
+func runCommand(opts runOptions) {
+	animate(opts.newEffect("command", ""), opts, 50*time.Millisecond)
+}
 
-	frame := 0
-	for frames == 0 || frame < frames {
-		// Check for user exit
-		select {
-		case <-quit:
-			return
-		default:
-		}
+func runDVD(opts runOptions) {
+	// Read text from file or use default SYSC.txt
+	text := opts.fitText(readTextFile(opts.file))
 
-		aquarium.Update()
-		output := aquarium.Render()
+	animate(opts.newEffect("dvd", text), opts, 50*time.Millisecond)
+}
 
-		fmt.Print("[H")
-		fmt.Print(output)
-		time.Sleep(50 * time.Millisecond)
-		frame++
+// defaultBootLog is typed by the boot effect when no -file is given
+const defaultBootLog = `[    0.000000] Booting sysc kernel
+[    0.004211] Command line: quiet splash
+[  OK  ] Started Journal Service.
+[  OK  ] Mounted /boot.
+[  OK  ] Reached target Local File Systems.
+         Starting Network Manager...
+[  OK  ] Started Network Manager.
+[FAILED] Failed to start Bluetooth service.
+[  OK  ] Reached target Network.
+[  OK  ] Started Terminal Animation Daemon.
+[  OK  ] Reached target Graphical Interface.
+
+sysc login: `
+
+func runBoot(opts runOptions) {
+	text := defaultBootLog
+	if opts.file != "" {
+		text = opts.fitText(readTextFile(opts.file))
 	}
+
+	animate(opts.newEffect("boot", text), opts, 50*time.Millisecond)
 }
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+func TestMoveHome(t *testing.T) {
+	var buf bytes.Buffer
+	moveHome(&buf)
+
+	if got := buf.String(); got != "\x1b[H" {
+		t.Errorf("moveHome wrote %q, want %q", got, "\x1b[H")
+	}
+}
+
+func TestWrapText_Runes(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  string
+	}{
+		{"héllo wörld", 11, "héllo wörld"},
+		{"héllo wörld", 8, "héllo\nwörld"},
+		{"│││││││", 3, "│││\n│││\n│"},
+		{"ab 日本語テキスト cd", 4, "ab\n日本語テ\nキスト\ncd"},
+	}
+
+	for _, tt := range tests {
+		if got := wrapText(tt.text, tt.width); got != tt.want {
+			t.Errorf("wrapText(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestBuildInfoString(t *testing.T) {
+	info := buildInfo{version: "1.2.3", commit: "0123456789abcdef", modified: true, goVer: "go1.24"}
+	want := "syscgo version 1.2.3\n" +
+		"  commit: 0123456789ab (modified)\n" +
+		"  built:  unknown\n" +
+		"  go:     go1.24\n"
+	if got := info.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+// Without -duration the CLI looks up each effect's default duration
+func TestEffectRunners_HaveMetadata(t *testing.T) {
+	for name := range effectRunners {
+		if animations.GetEffectMetadata(name) == nil {
+			t.Errorf("effect %q has a runner but no registry entry", name)
+		}
+	}
+	if d := animations.GetEffectMetadata("aquarium").DefaultDuration; d != 0 {
+		t.Errorf("aquarium DefaultDuration = %d, want 0 to loop", d)
+	}
+}
+
+func TestRandomTheme(t *testing.T) {
+	first := randomTheme(42)
+	if _, ok := animations.GetTheme(first); !ok {
+		t.Fatalf("randomTheme picked unknown theme %q", first)
+	}
+	if again := randomTheme(42); again != first {
+		t.Errorf("same seed picked %q, then %q", first, again)
+	}
+}
+
+func TestWriteFrames(t *testing.T) {
+	dir := t.TempDir()
+	effect := animations.NewStarfieldEffect(animations.StarfieldConfig{Width: 20, Height: 5, Seed: 1})
+	writeFrames(effect, runOptions{outDir: dir, outFrames: 3})
+
+	for n := 1; n <= 3; n++ {
+		data, err := os.ReadFile(filepath.Join(dir, frameFileName(n)))
+		if err != nil {
+			t.Fatalf("frame %d: %v", n, err)
+		}
+		if bytes.Contains(data, []byte("\x1b[H")) {
+			t.Errorf("frame %d moves the cursor", n)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, frameFileName(4))); err == nil {
+		t.Error("wrote more frames than asked for")
+	}
+}
+
+// With no -file and no SYSC.txt anywhere, the screensaver only picks
+// effects that don't need it
+func TestScreensaverEffects_NoAssets(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	if path := findAssetFile("SYSC.txt"); path != "" {
+		t.Skipf("SYSC.txt is installed at %s", path)
+	}
+	if textAvailable("") || textAvailable("missing.txt") {
+		t.Fatal("text reported available with no assets")
+	}
+
+	effects := screensaverEffects(false)
+	if len(effects) == 0 {
+		t.Fatal("no effects left to pick from")
+	}
+	for _, name := range effects {
+		if slices.Contains(textAssetEffects, name) {
+			t.Errorf("screensaver can pick %q, which needs SYSC.txt", name)
+		}
+	}
+
+	// A readable -file is text enough for every effect
+	if err := os.WriteFile("art.txt", []byte("SYSC"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !textAvailable("art.txt") {
+		t.Error("readable -file not reported available")
+	}
+	if got, want := len(screensaverEffects(true)), len(availableEffects()); got != want {
+		t.Errorf("screensaver picks from %d effects with text, want all %d", got, want)
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+	"github.com/Nomadcxx/sysc-Go/render"
+	"golang.org/x/term"
+)
+
+// Seconds each screensaver effect runs, picked at random in this range
+const (
+	screensaverMinSeconds = 10
+	screensaverMaxSeconds = 30
+)
+
+// transitionFrames is how many frames one screensaver effect takes to blend into the next
+const transitionFrames = 20
+
+// screensaverTransition carries the last frame of one screensaver effect
+// into the start of the next
+type screensaverTransition struct {
+	blend *animations.Transition
+	last  [][]animations.Cell // Final frame of the previous effect, nil to cut straight in
+}
+
+// blendIn advances effect to its first frame and blends the previous
+// effect's last frame into it. It returns false if the user quit meanwhile.
+func (t *screensaverTransition) blendIn(effect frameEffect, cells animations.CellRenderer, opts runOptions, delay time.Duration) bool {
+	if t.last == nil {
+		return true
+	}
+	if cells == nil {
+		fmt.Print("\033[0m\033[2J") // Nothing to blend into, so clear the previous effect
+		return true
+	}
+
+	effect.Update()
+	first := cells.RenderCells()
+	ansi := render.NewANSIRenderer()
+	ansi.Background = opts.bg
+	for i := 1; i <= transitionFrames; i++ {
+		select {
+		case <-opts.quit:
+			return false
+		default:
+		}
+		moveHome(os.Stdout)
+		printFrame(ansi.Render(t.blend.Blend(t.last, first, float64(i)/transitionFrames)))
+		os.Stdout.Sync()
+		time.Sleep(delay)
+	}
+	return true
+}
+
+// keep saves a copy of the frame an effect ended on. Effects without cells
+// leave nothing to blend from, so the next effect cuts straight in.
+func (t *screensaverTransition) keep(cells animations.CellRenderer) {
+	t.last = nil
+	if cells == nil {
+		return
+	}
+	for _, row := range cells.RenderCells() {
+		t.last = append(t.last, append([]animations.Cell(nil), row...))
+	}
+}
+
+// screensaverEffects returns the effects the screensaver picks from. Without
+// any text to show, the effects that need SYSC.txt are left out so the
+// screensaver never exits part-way through for want of it.
+func screensaverEffects(haveText bool) []string {
+	if haveText {
+		return availableEffects()
+	}
+	var names []string
+	for _, name := range availableEffects() {
+		if !slices.Contains(textAssetEffects, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// runScreensaver plays random effects with random themes, each for a random
+// duration, until any key is pressed or the process is interrupted. keys
+// are read in raw mode, so Ctrl+C arrives as a key too. Effects blend into
+// each other in the given transition style.
+func runScreensaver(opts runOptions, keys <-chan byte, transition string) {
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-keys:
+		case <-opts.quit:
+		}
+		close(stop)
+	}()
+	opts.quit = stop
+	opts.keys = nil
+	opts.clip = true // Art too large for the terminal is cut off rather than ending the screensaver
+
+	seed := opts.seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
+	if transition != "none" {
+		opts.transition = &screensaverTransition{blend: animations.NewTransition(transition, seed)}
+	}
+	haveText := textAvailable(opts.file)
+	if !haveText {
+		opts.file = "" // Effects with built-in text or no text use that instead
+	}
+	effects := screensaverEffects(haveText)
+	themes := availableThemes()
+
+	for {
+		name := effects[rng.Intn(len(effects))]
+		opts.theme, _ = animations.GetTheme(themes[rng.Intn(len(themes))])
+		seconds := screensaverMinSeconds + rng.Intn(screensaverMaxSeconds-screensaverMinSeconds+1)
+		opts.frames = seconds * 20 // 20 fps
+
+		// Pick up terminal resizes made while the previous effect ran
+		if width, height, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+			opts.width, opts.height = width, height
+		}
+
+		if opts.transition == nil || opts.transition.last == nil {
+			fmt.Print("\033[0m\033[2J") // Clear the previous effect
+		}
+		effectRunners[name](opts)
+
+		select {
+		case <-stop:
+			return
+		default:
+		}
+	}
+}
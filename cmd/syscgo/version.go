@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// Build metadata, overridable at build time with
+// -ldflags "-X main.version=... -X main.commit=... -X main.date=..."
+var (
+	version = "1.0.2"
+	commit  = ""
+	date    = ""
+)
+
+// buildInfo describes the running binary for -version
+type buildInfo struct {
+	version  string
+	module   string // Module version from the Go toolchain, "(devel)" for source builds
+	commit   string
+	date     string
+	modified bool
+	goVer    string
+}
+
+// readBuildInfo combines the -ldflags values with what the Go toolchain
+// embedded in the binary. Injected values win over embedded VCS stamps.
+func readBuildInfo() buildInfo {
+	info := buildInfo{version: version, commit: commit, date: date, goVer: runtime.Version()}
+
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	info.module = bi.Main.Version
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			if info.commit == "" {
+				info.commit = s.Value
+			}
+		case "vcs.time":
+			if info.date == "" {
+				info.date = s.Value
+			}
+		case "vcs.modified":
+			info.modified = s.Value == "true"
+		}
+	}
+	return info
+}
+
+// String formats the build info for -version, one field per line
+func (b buildInfo) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "syscgo version %s\n", b.version)
+	if b.module != "" {
+		fmt.Fprintf(&sb, "  module: %s\n", b.module)
+	}
+
+	commit := b.commit
+	if commit == "" {
+		commit = "unknown"
+	} else if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	if b.modified {
+		commit += " (modified)"
+	}
+	fmt.Fprintf(&sb, "  commit: %s\n", commit)
+
+	date := b.date
+	if date == "" {
+		date = "unknown"
+	}
+	fmt.Fprintf(&sb, "  built:  %s\n", date)
+	fmt.Fprintf(&sb, "  go:     %s\n", b.goVer)
+	return sb.String()
+}
@@ -0,0 +1,383 @@
+// Package effects builds registry effects by name with the tuning shared by
+// the syscgo command and the syscgo package, so both play every effect with
+// the same settings.
+package effects
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// Settings are the canvas and the knobs an effect is built with. Zero
+// values pick each effect's default.
+type Settings struct {
+	Width  int
+	Height int
+	Text   string // Text or ASCII art for text effects, seed pattern for life
+	Seed   int64  // Random seed, 0 = seeded from the clock
+
+	Align   animations.TextAlign // Text effect horizontal placement
+	Margin  int                  // Columns text effects keep clear at the aligned edge
+	Auto    bool                 // Auto-size the beam-text canvas to fit the text
+	Display bool                 // Complete once: beam-text and boot hold, matrix-art/rain-art reveal the art
+	NoIntro bool                 // Start ring-text/blackhole almost at once, intro on the first loop only
+	Clip    bool                 // Cut off ring-text/blackhole text too large for the canvas instead of failing
+
+	Bold      int        // Fire/fireworks colors drawn bold
+	Fill      bool       // Fire as solid background blocks
+	Wind      float64    // Fire lean in cells per row
+	WindSway  float64    // Fire wind oscillation amplitude
+	Trail     int        // Matrix/fireworks afterglow length, 0 = off
+	Glyphs    string     // Matrix glyph set
+	Density   float64    // Matrix/rain density
+	Speed     [2]float64 // Matrix/rain speed range in cells per frame; Speed[0] is the command scroll speed
+	Splash    bool       // Rain splashes and puddles on the bottom row
+	Shape     string     // Fireworks burst shape
+	Gravity   float64    // Fireworks fall speed multiplier
+	Count     int        // Most fireworks bursts at once
+	Particles int        // Particles per fireworks burst
+	Direction string     // Pour direction
+	Easing    string     // Pour easing function
+	MaxFish   int        // Aquarium fish cap
+
+	Command  string        // Shell command scrolled by the command effect
+	Interval time.Duration // Time between runs of the command
+}
+
+// staticFrames is how long ring-text and blackhole show the text before
+// animating: a short beat with NoIntro, otherwise 30 frames
+func (s Settings) staticFrames() int {
+	if s.NoIntro {
+		return 5
+	}
+	return 30
+}
+
+// ErrUnknownEffect is returned by New for names it has no builder for
+var ErrUnknownEffect = errors.New("unknown effect")
+
+// New builds the named effect in the colors of theme. Ring-text and
+// blackhole fail with an error wrapping animations.ErrTextTooLarge when the
+// text doesn't fit the canvas, unless s.Clip is set.
+func New(name string, theme animations.Theme, s Settings) (animations.Animation, error) {
+	build, ok := builders[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownEffect, name)
+	}
+	return build(theme, s)
+}
+
+// builders maps effect names to their constructors
+var builders = map[string]func(theme animations.Theme, s Settings) (animations.Animation, error){
+	"fire": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewFireEffectWithConfig(animations.FireConfig{
+			Width:    s.Width,
+			Height:   s.Height,
+			Palette:  theme.FirePalette,
+			Bold:     s.Bold,
+			FillMode: s.Fill,
+			Wind:     s.Wind,
+			WindSway: s.WindSway,
+			Seed:     s.Seed,
+		}), nil
+	},
+	"fire-text": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewFireTextEffectWithConfig(animations.FireTextConfig{
+			Width:   s.Width,
+			Height:  s.Height,
+			Palette: theme.FirePalette,
+			Text:    s.Text,
+			Align:   s.Align,
+			Margin:  s.Margin,
+			Seed:    s.Seed,
+		}), nil
+	},
+	"matrix": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewMatrixEffectWithConfig(animations.MatrixConfig{
+			Width:       s.Width,
+			Height:      s.Height,
+			Palette:     theme.MatrixPalette,
+			Glow:        s.Trail > 0,
+			TrailLength: s.Trail,
+			Density:     s.Density,
+			SpeedRange:  s.Speed,
+			GlyphSet:    s.Glyphs,
+			Seed:        s.Seed,
+		}), nil
+	},
+	"matrix-art": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		config := animations.MatrixArtConfig{
+			Width:   s.Width,
+			Height:  s.Height,
+			Palette: theme.MatrixPalette,
+			Text:    s.Text,
+			Seed:    s.Seed,
+		}
+		// Display mode reveals the art after 5 seconds and ends after a 2 second hold
+		if s.Display {
+			config.RevealFrames = 100
+			config.HoldFrames = 40
+			config.FinalGradientStops = theme.FinalGradientStops
+		}
+		return animations.NewMatrixArtEffectWithConfig(config), nil
+	},
+	"fireworks": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewFireworksEffectWithConfig(animations.FireworksConfig{
+			Width:             s.Width,
+			Height:            s.Height,
+			Palette:           theme.FireworksPalette,
+			Bold:              s.Bold,
+			BurstShape:        s.Shape,
+			Gravity:           s.Gravity,
+			TrailLength:       s.Trail,
+			MaxBursts:         s.Count,
+			ParticlesPerBurst: s.Particles,
+			Seed:              s.Seed,
+		}), nil
+	},
+	"rain": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewRainEffectWithConfig(animations.RainConfig{
+			Width:      s.Width,
+			Height:     s.Height,
+			Palette:    theme.RainPalette,
+			Density:    s.Density,
+			SpeedRange: s.Speed,
+			Splash:     s.Splash,
+			Seed:       s.Seed,
+		}), nil
+	},
+	"rain-art": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		config := animations.RainArtConfig{
+			Width:   s.Width,
+			Height:  s.Height,
+			Palette: theme.RainPalette,
+			Text:    s.Text,
+			Seed:    s.Seed,
+		}
+		// Display mode reveals the art after 5 seconds and ends after a 2 second hold
+		if s.Display {
+			config.RevealFrames = 100
+			config.HoldFrames = 40
+			config.FinalGradientStops = theme.FinalGradientStops
+		}
+		return animations.NewRainArtEffectWithConfig(config), nil
+	},
+	"pour": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewPourEffect(animations.PourConfig{
+			Width:                  s.Width,
+			Height:                 s.Height,
+			Text:                   s.Text,
+			PourDirection:          s.Direction,
+			PourSpeed:              3,
+			MovementSpeed:          0.2,
+			EasingFunction:         s.Easing,
+			Gap:                    1,
+			StartingColor:          "#ffffff",
+			FinalGradientStops:     theme.GradientStops,
+			FinalGradientSteps:     12,
+			FinalGradientFrames:    5,
+			FinalGradientDirection: "horizontal",
+			HoldFrames:             100, // ~5 seconds at 20fps
+			Align:                  s.Align,
+			Margin:                 s.Margin,
+			Seed:                   s.Seed,
+		}), nil
+	},
+	"print": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewPrintEffect(animations.PrintConfig{
+			Width:           s.Width,
+			Height:          s.Height,
+			Text:            s.Text,
+			FramesPerChar:   1, // Print every frame for smooth animation
+			PrintSpeed:      2, // 2 characters per update
+			PrintHeadSymbol: "█",
+			TrailSymbols:    []string{"░", "▒", "▓"},
+			GradientStops:   theme.PrintGradientStops,
+			HoldFrames:      100, // ~5 seconds at 20fps
+			Seed:            s.Seed,
+		}), nil
+	},
+	"beams": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewBeamsEffect(animations.BeamsConfig{
+			Width:                s.Width,
+			Height:               s.Height,
+			BeamRowSymbols:       []rune{'▂', '▁', '_'},
+			BeamColumnSymbols:    []rune{'▌', '▍', '▎', '▏'},
+			BeamDelay:            2,
+			BeamRowSpeedRange:    [2]int{20, 80},
+			BeamColumnSpeedRange: [2]int{15, 30},
+			BeamGradientStops:    theme.BeamGradientStops,
+			BeamGradientSteps:    5,
+			BeamGradientFrames:   1,
+			FinalGradientStops:   theme.FinalGradientStops,
+			FinalGradientSteps:   8,
+			FinalGradientFrames:  1,
+			FinalWipeSpeed:       3,
+			BackgroundMode:       true,
+			Seed:                 s.Seed,
+		}), nil
+	},
+	"beam-text": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewBeamTextEffect(animations.BeamTextConfig{
+			Width:                s.Width,
+			Height:               s.Height,
+			Text:                 s.Text,
+			Auto:                 s.Auto,
+			Display:              s.Display,
+			BeamRowSymbols:       []rune{'▂', '▁', '_'},
+			BeamColumnSymbols:    []rune{'▌', '▍', '▎', '▏'},
+			BeamDelay:            2,
+			BeamRowSpeedRange:    [2]int{20, 80},
+			BeamColumnSpeedRange: [2]int{15, 30},
+			BeamGradientStops:    theme.BeamGradientStops,
+			BeamGradientSteps:    5,
+			BeamGradientFrames:   1,
+			FinalGradientStops:   theme.FinalGradientStops,
+			FinalGradientSteps:   8,
+			FinalGradientFrames:  1,
+			FinalWipeSpeed:       3,
+			Align:                s.Align,
+			Margin:               s.Margin,
+			Seed:                 s.Seed,
+		}), nil
+	},
+	"ring-text": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		// TTE-like parameters with theme-sensitive gradients
+		config := animations.RingTextConfig{
+			Width:               s.Width,
+			Height:              s.Height,
+			Text:                s.Text,
+			RingColors:          theme.RingColors,
+			RingGap:             0.1,                      // Like TTE default
+			SpinSpeedRange:      [2]float64{0.025, 0.075}, // Min-max range like TTE (0.25-1.0 mapped to radians)
+			SpinDuration:        200,                      // Frames per spin rotation
+			DisperseDuration:    200,                      // Frames in dispersed state
+			SpinDisperseCycles:  3,                        // 3 cycles like TTE default
+			TransitionFrames:    60,                       // Transition between states (reduced for faster animation)
+			StaticFrames:        s.staticFrames(),         // Initial static display
+			IntroOnce:           s.NoIntro,
+			FinalGradientStops:  theme.FinalGradientStops,
+			FinalGradientSteps:  12,
+			StaticGradientStops: theme.RingColors,              // Use ring colors for static gradient
+			StaticGradientDir:   animations.GradientHorizontal, // Left-to-right gradient
+			Align:               s.Align,
+			Margin:              s.Margin,
+			Seed:                s.Seed,
+		}
+		if s.Clip {
+			return animations.NewRingTextEffect(config), nil
+		}
+		return animations.NewRingTextEffectChecked(config)
+	},
+	"blackhole": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		config := animations.BlackholeConfig{
+			Width:               s.Width,
+			Height:              s.Height,
+			Text:                s.Text,
+			BlackholeColor:      theme.BlackholeColor,
+			StarColors:          theme.StarColors,
+			FinalGradientStops:  theme.StarColors, // Use same gradient as start
+			FinalGradientSteps:  12,
+			FinalGradientDir:    animations.GradientHorizontal, // Match start direction
+			StaticGradientStops: theme.StarColors,
+			StaticGradientDir:   animations.GradientHorizontal,
+			FormingFrames:       10,
+			ConsumingFrames:     60,
+			CollapsingFrames:    50,
+			ExplodingFrames:     100,
+			ReturningFrames:     120,
+			StaticFrames:        s.staticFrames(),
+			IntroOnce:           s.NoIntro,
+			ShowBorder:          true,
+			Twinkle:             true,
+			CenterX:             -1, // Centered
+			CenterY:             -1,
+			Align:               s.Align,
+			Margin:              s.Margin,
+			Seed:                s.Seed,
+		}
+		if s.Clip {
+			return animations.NewBlackholeEffect(config), nil
+		}
+		return animations.NewBlackholeEffectChecked(config)
+	},
+	"aquarium": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewAquariumEffect(animations.AquariumConfig{
+			Width:         s.Width,
+			Height:        s.Height,
+			FishColors:    theme.AquariumFishColors,
+			WaterColors:   theme.AquariumWaterColors,
+			SeaweedColors: theme.AquariumSeaweedColors,
+			BubbleColor:   theme.AquariumBubbleColor,
+			DiverColor:    theme.AquariumDiverColor,
+			BoatColor:     theme.AquariumBoatColor,
+			MermaidColor:  theme.AquariumMermaidColor,
+			AnchorColor:   theme.AquariumAnchorColor,
+			ChestColor:    theme.AquariumChestColor,
+			MaxFish:       s.MaxFish,
+			Seed:          s.Seed,
+		}), nil
+	},
+	"decrypt": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewDecryptEffect(animations.DecryptConfig{
+			Width:                  s.Width,
+			Height:                 s.Height,
+			Text:                   s.Text,
+			TypingSpeed:            2,
+			CiphertextColors:       theme.MatrixPalette,
+			FinalGradientStops:     theme.FinalGradientStops,
+			FinalGradientSteps:     12,
+			FinalGradientDirection: "horizontal",
+			Loop:                   true,
+			Seed:                   s.Seed,
+		}), nil
+	},
+	"life": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewLifeEffect(animations.LifeConfig{
+			Width:         s.Width,
+			Height:        s.Height,
+			GradientStops: theme.GradientStops,
+			Text:          s.Text,
+			Seed:          s.Seed,
+		}), nil
+	},
+	"starfield": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewStarfieldEffect(animations.StarfieldConfig{
+			Width:      s.Width,
+			Height:     s.Height,
+			StarColors: theme.StarColors,
+			Seed:       s.Seed,
+		}), nil
+	},
+	"dvd": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewDVDEffect(animations.DVDConfig{
+			Width:  s.Width,
+			Height: s.Height,
+			Text:   s.Text,
+			Colors: theme.FireworksPalette,
+			Seed:   s.Seed,
+		}), nil
+	},
+	"boot": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewBootEffect(animations.BootConfig{
+			Width:   s.Width,
+			Height:  s.Height,
+			Text:    s.Text,
+			Display: s.Display,
+			Seed:    s.Seed,
+		}), nil
+	},
+	"command": func(theme animations.Theme, s Settings) (animations.Animation, error) {
+		return animations.NewCommandEffect(animations.CommandConfig{
+			Width:       s.Width,
+			Height:      s.Height,
+			Command:     s.Command,
+			Interval:    s.Interval,
+			ScrollSpeed: s.Speed[0],
+			Colors:      theme.FinalGradientStops,
+		}), nil
+	},
+}
@@ -0,0 +1,46 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// ANSIRenderer draws whole frames with raw SGR escape codes instead of
+// lipgloss. A color code is only emitted when the style changes, so runs of
+// same-colored cells share one escape and blank cells keep the current color.
+type ANSIRenderer struct {
+	Background string // Hex color drawn behind cells without their own, empty for the terminal default
+}
+
+// NewANSIRenderer creates a raw ANSI frame renderer
+func NewANSIRenderer() *ANSIRenderer {
+	return &ANSIRenderer{}
+}
+
+// Render returns cells as newline-separated rows, starting at the cursor.
+// The style is reset at the end of every row so backgrounds don't bleed
+// into the rest of the line.
+func (a *ANSIRenderer) Render(cells [][]animations.Cell) string {
+	var out strings.Builder
+	var style sgrState
+
+	for y, row := range cells {
+		if y > 0 {
+			out.WriteByte('\n')
+		}
+		for _, cell := range row {
+			if cell.Rune == animations.WideFill {
+				continue
+			}
+			if cell.Background == "" {
+				cell.Background = a.Background
+			}
+			style.apply(&out, cell)
+			out.WriteRune(cell.Rune)
+		}
+		style.reset(&out)
+	}
+
+	return out.String()
+}
@@ -0,0 +1,135 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// staticGrid is an effect that always draws the same cells
+type staticGrid [][]animations.Cell
+
+func (g staticGrid) Update()                          {}
+func (g staticGrid) Resize(width, height int)         {}
+func (g staticGrid) RenderCells() [][]animations.Cell { return g }
+
+// styledRune is a glyph as a terminal would draw it from SGR output
+type styledRune struct {
+	r                 rune
+	color, background string
+	bold              bool
+}
+
+// parseSGR replays output the way a terminal would, returning each row's
+// glyphs with the foreground, background and weight active when they were
+// written. Only SGR escapes are understood.
+func parseSGR(t *testing.T, output string) [][]styledRune {
+	t.Helper()
+	rows := [][]styledRune{nil}
+	var style styledRune
+	for i := 0; i < len(output); {
+		if strings.HasPrefix(output[i:], "\033[") {
+			end := strings.IndexByte(output[i:], 'm')
+			if end < 0 {
+				t.Fatalf("unterminated escape in %q", output[i:])
+			}
+			applySGR(t, &style, output[i+2:i+end])
+			i += end + 1
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(output[i:])
+		i += size
+		if r == '\n' {
+			rows = append(rows, nil)
+			continue
+		}
+		glyph := style
+		glyph.r = r
+		rows[len(rows)-1] = append(rows[len(rows)-1], glyph)
+	}
+	return rows
+}
+
+// applySGR updates style with the parameters of one SGR escape
+func applySGR(t *testing.T, style *styledRune, params string) {
+	t.Helper()
+	codes := strings.Split(params, ";")
+	rgb := func(i int) string {
+		if i+4 >= len(codes) || codes[i+1] != "2" {
+			t.Fatalf("unsupported color in SGR %q", params)
+		}
+		var c [3]int
+		for k := range c {
+			c[k], _ = strconv.Atoi(codes[i+2+k])
+		}
+		return fmt.Sprintf("#%02x%02x%02x", c[0], c[1], c[2])
+	}
+	for i := 0; i < len(codes); i++ {
+		switch codes[i] {
+		case "", "0":
+			*style = styledRune{}
+		case "1":
+			style.bold = true
+		case "22":
+			style.bold = false
+		case "39":
+			style.color = ""
+		case "49":
+			style.background = ""
+		case "38":
+			style.color = rgb(i)
+			i += 4
+		case "48":
+			style.background = rgb(i)
+			i += 4
+		default:
+			t.Fatalf("unsupported SGR code %q in %q", codes[i], params)
+		}
+	}
+}
+
+func TestANSIRenderer_MatchesLipgloss(t *testing.T) {
+	defer animations.SetColorProfile(animations.CurrentColorProfile())
+	animations.SetColorProfile(animations.TrueColor)
+
+	grid := staticGrid{
+		{{Rune: 'a', Color: "#ff0000"}, {Rune: 'b', Color: "#ff0000"}, {Rune: ' '}, {Rune: 'c', Color: "#00ff00", Bold: true}},
+		{{Rune: ' ', Background: "#0000ff"}, {Rune: 'd', Color: "#ffffff", Background: "#0000ff"}, {Rune: 'e'}, {Rune: 'f', Color: "#123456"}},
+		{{Rune: '世', Color: "#abcdef", Bold: true}, {Rune: animations.WideFill}, {Rune: 'g', Background: "#222222"}, {Rune: ' '}},
+	}
+
+	// The lipgloss path is what effects' own Render uses
+	lipgloss := parseSGR(t, animations.NewScaledEffect(grid, 1, 4, 3).Render())
+	ansi := parseSGR(t, NewANSIRenderer().Render(grid))
+
+	for name, rows := range map[string][][]styledRune{"lipgloss": lipgloss, "ansi": ansi} {
+		if len(rows) != len(grid) {
+			t.Fatalf("%s drew %d rows, want %d", name, len(rows), len(grid))
+		}
+		for y, row := range grid {
+			var want []animations.Cell
+			for _, cell := range row {
+				if cell.Rune != animations.WideFill {
+					want = append(want, cell)
+				}
+			}
+			if len(rows[y]) != len(want) {
+				t.Fatalf("%s row %d has %d glyphs, want %d", name, y, len(rows[y]), len(want))
+			}
+			for x, cell := range want {
+				got := rows[y][x]
+				if got.r != cell.Rune || got.background != cell.Background {
+					t.Errorf("%s cell %d,%d = %q on %q, want %q on %q", name, y, x, got.r, got.background, cell.Rune, cell.Background)
+				}
+				// Blank cells show no foreground, so only glyphs need theirs
+				if cell.Rune != ' ' && (got.color != cell.Color || got.bold != cell.Bold) {
+					t.Errorf("%s cell %d,%d %q is %q bold=%v, want %q bold=%v", name, y, x, cell.Rune, got.color, got.bold, cell.Color, cell.Bold)
+				}
+			}
+		}
+	}
+}
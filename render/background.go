@@ -0,0 +1,54 @@
+package render
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// sgrPattern matches an SGR escape sequence and captures its parameters
+var sgrPattern = regexp.MustCompile(`\x1b\[([0-9;]*)m`)
+
+// FillBackground paints a rendered frame over a background color, for effects
+// that only render to a string. The background is set at the start of every
+// line, restored after any escape that resets it and extended to the end of
+// the line. Cell-based output should set Background on the renderer instead.
+func FillBackground(frame, hex string) string {
+	if _, _, _, ok := parseHex(hex); !ok || animations.CurrentColorProfile() == animations.NoColor {
+		return frame
+	}
+	bg := backgroundCode(hex)
+
+	lines := strings.Split(frame, "\n")
+	for i, line := range lines {
+		line = sgrPattern.ReplaceAllStringFunc(line, func(seq string) string {
+			if resetsBackground(sgrPattern.FindStringSubmatch(seq)[1]) {
+				return seq + bg
+			}
+			return seq
+		})
+		// Erase to the end of the line so short lines are filled too
+		lines[i] = bg + line + "\033[K\033[0m"
+	}
+	return strings.Join(lines, "\n")
+}
+
+// resetsBackground reports whether SGR parameters return the background to the
+// terminal default, skipping the arguments of extended colors like 38;2;0;0;0
+func resetsBackground(params string) bool {
+	fields := strings.Split(params, ";")
+	for i := 0; i < len(fields); i++ {
+		switch fields[i] {
+		case "", "0", "49":
+			return true
+		case "38", "48", "58":
+			if i+1 < len(fields) && fields[i+1] == "5" {
+				i += 2
+			} else if i+1 < len(fields) && fields[i+1] == "2" {
+				i += 4
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+package render
+
+import "testing"
+
+func TestFillBackground(t *testing.T) {
+	const bg = "\x1b[48;2;255;255;255m"
+	frame := "\x1b[38;2;0;0;0mab\x1b[0m c\n\x1b[1;38;2;255;0;0mx\x1b[m"
+
+	want := bg + "\x1b[38;2;0;0;0mab\x1b[0m" + bg + " c\x1b[K\x1b[0m\n" +
+		bg + "\x1b[1;38;2;255;0;0mx\x1b[m" + bg + "\x1b[K\x1b[0m"
+	if got := FillBackground(frame, "#ffffff"); got != want {
+		t.Errorf("FillBackground =\n%q\nwant\n%q", got, want)
+	}
+
+	if got := FillBackground(frame, ""); got != frame {
+		t.Errorf("FillBackground without a color changed the frame to %q", got)
+	}
+}
@@ -0,0 +1,176 @@
+// Package render provides terminal renderers that consume the raw cell
+// grids produced by animations.CellRenderer.
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// DiffRenderer keeps the previous frame and emits only the escape sequences
+// needed to update the cells that changed since then.
+// The first frame, and any frame after a size change or Reset, is drawn in full.
+type DiffRenderer struct {
+	Background string // Hex color drawn behind cells without their own, empty for the terminal default
+
+	prev [][]animations.Cell
+}
+
+// NewDiffRenderer creates a diff renderer with no previous frame
+func NewDiffRenderer() *DiffRenderer {
+	return &DiffRenderer{}
+}
+
+// Reset forgets the previous frame so the next Render repaints everything
+func (d *DiffRenderer) Reset() {
+	d.prev = nil
+}
+
+// Render returns the output that turns the previously rendered frame into cells
+func (d *DiffRenderer) Render(cells [][]animations.Cell) string {
+	var out strings.Builder
+
+	full := !d.sameSize(cells)
+	if full {
+		out.WriteString("\033[2J")
+	}
+
+	// Track the terminal's cursor and style so moves and style changes are
+	// only emitted when needed
+	cursorY, cursorX := -1, -1
+	var style sgrState
+
+	for y, row := range cells {
+		for x, cell := range row {
+			if cell.Rune == animations.WideFill || (!full && d.prev[y][x] == cell) {
+				continue
+			}
+
+			if cursorY != y || cursorX != x {
+				// ANSI cursor positions are 1-based
+				fmt.Fprintf(&out, "\033[%d;%dH", y+1, x+1)
+			}
+
+			if cell.Background == "" {
+				cell.Background = d.Background
+			}
+			style.apply(&out, cell)
+			out.WriteRune(cell.Rune)
+			cursorY, cursorX = y, x+1
+		}
+	}
+
+	style.reset(&out)
+
+	d.store(cells)
+	return out.String()
+}
+
+// sameSize reports whether cells has the same dimensions as the previous frame
+func (d *DiffRenderer) sameSize(cells [][]animations.Cell) bool {
+	if d.prev == nil || len(d.prev) != len(cells) {
+		return false
+	}
+	for y := range cells {
+		if len(d.prev[y]) != len(cells[y]) {
+			return false
+		}
+	}
+	return true
+}
+
+// store copies cells into the previous frame buffer, reusing it when possible.
+// Effects may reuse their grid between frames, so it can't be kept by reference.
+func (d *DiffRenderer) store(cells [][]animations.Cell) {
+	if !d.sameSize(cells) {
+		d.prev = make([][]animations.Cell, len(cells))
+		for y := range cells {
+			d.prev[y] = make([]animations.Cell, len(cells[y]))
+		}
+	}
+	for y := range cells {
+		copy(d.prev[y], cells[y])
+	}
+}
+
+// sgrState is the style the terminal is currently drawing with
+type sgrState struct {
+	color      string
+	background string
+	bold       bool
+}
+
+// apply writes the SGR codes needed to draw cell, skipping any part of the
+// style that is already active. Blank cells only need their background, and
+// nothing is written under the NoColor profile.
+func (s *sgrState) apply(out *strings.Builder, cell animations.Cell) {
+	cell = animations.CurrentColorProfile().Apply(cell)
+	color, bold := cell.Color, cell.Bold
+	if cell.Rune == ' ' {
+		color, bold = s.color, s.bold
+	}
+	if bold != s.bold {
+		out.WriteString(boldCode(bold))
+		s.bold = bold
+	}
+	if color != s.color {
+		out.WriteString(colorCode(color))
+		s.color = color
+	}
+	if cell.Background != s.background {
+		out.WriteString(backgroundCode(cell.Background))
+		s.background = cell.Background
+	}
+}
+
+// reset returns the terminal to its default style if anything is set
+func (s *sgrState) reset(out *strings.Builder) {
+	if *s != (sgrState{}) {
+		out.WriteString("\033[0m")
+		*s = sgrState{}
+	}
+}
+
+// colorCode returns the SGR sequence that sets the foreground to a hex color,
+// or resets it to the terminal default for an empty color
+func colorCode(hex string) string {
+	r, g, b, ok := parseHex(hex)
+	if !ok {
+		return "\033[39m"
+	}
+	return fmt.Sprintf("\033[38;2;%d;%d;%dm", r, g, b)
+}
+
+// backgroundCode returns the SGR sequence that sets the background to a hex color,
+// or resets it to the terminal default for an empty color
+func backgroundCode(hex string) string {
+	r, g, b, ok := parseHex(hex)
+	if !ok {
+		return "\033[49m"
+	}
+	return fmt.Sprintf("\033[48;2;%d;%d;%dm", r, g, b)
+}
+
+// boldCode returns the SGR sequence that turns bold on or off
+func boldCode(bold bool) string {
+	if bold {
+		return "\033[1m"
+	}
+	return "\033[22m"
+}
+
+// parseHex parses a #RRGGBB color
+func parseHex(hex string) (uint8, uint8, uint8, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return uint8(value >> 16), uint8(value >> 8), uint8(value), true
+}
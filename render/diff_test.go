@@ -0,0 +1,76 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// textGrid builds a grid of uncolored cells from rows of text
+func textGrid(rows ...string) [][]animations.Cell {
+	cells := make([][]animations.Cell, len(rows))
+	for y, row := range rows {
+		for _, r := range row {
+			cells[y] = append(cells[y], animations.Cell{Rune: r})
+		}
+	}
+	return cells
+}
+
+func TestDiffRenderer(t *testing.T) {
+	defer animations.SetColorProfile(animations.CurrentColorProfile())
+	animations.SetColorProfile(animations.TrueColor)
+
+	d := NewDiffRenderer()
+	if got, want := d.Render(textGrid("ab", "cd")), "\033[2J\033[1;1Hab\033[2;1Hcd"; got != want {
+		t.Errorf("first frame = %q, want %q", got, want)
+	}
+
+	// An unchanged frame needs no output at all
+	if got := d.Render(textGrid("ab", "cd")); got != "" {
+		t.Errorf("unchanged frame = %q, want nothing", got)
+	}
+
+	// One changed cell is one cursor move and the cell in its color
+	frame := textGrid("ab", "cd")
+	frame[1][1] = animations.Cell{Rune: 'x', Color: "#ff0000"}
+	if got, want := d.Render(frame), "\033[2;2H\033[38;2;255;0;0mx\033[0m"; got != want {
+		t.Errorf("one changed cell = %q, want %q", got, want)
+	}
+
+	// A new size repaints everything
+	if got, want := d.Render(textGrid("abc")), "\033[2J\033[1;1Habc"; got != want {
+		t.Errorf("resized frame = %q, want %q", got, want)
+	}
+
+	// Reset forgets the previous frame
+	d.Reset()
+	if got, want := d.Render(textGrid("abc")), "\033[2J\033[1;1Habc"; got != want {
+		t.Errorf("frame after Reset = %q, want %q", got, want)
+	}
+}
+
+func TestDiffRenderer_WideRunes(t *testing.T) {
+	defer animations.SetColorProfile(animations.CurrentColorProfile())
+	animations.SetColorProfile(animations.TrueColor)
+
+	// A wide rune covers its WideFill cell, so the fill is never written and
+	// the cursor is moved past both before the next cell
+	wide := func(r rune) [][]animations.Cell {
+		return [][]animations.Cell{{{Rune: r}, {Rune: animations.WideFill}, {Rune: 'a'}}}
+	}
+	d := NewDiffRenderer()
+	if got, want := d.Render(wide('世')), "\033[2J\033[1;1H世\033[1;3Ha"; got != want {
+		t.Errorf("first frame = %q, want %q", got, want)
+	}
+
+	// Changing only the wide rune redraws it alone
+	if got, want := d.Render(wide('界')), "\033[1;1H界"; got != want {
+		t.Errorf("changed wide rune = %q, want %q", got, want)
+	}
+
+	// Replacing the wide rune with two narrow ones draws both
+	if got, want := d.Render(textGrid("xya")), "\033[1;1Hxy"; got != want {
+		t.Errorf("wide rune replaced = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,52 @@
+package syscgo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+	"github.com/Nomadcxx/sysc-Go/internal/effects"
+)
+
+// New creates the named effect in the colors of the named built-in theme,
+// sized and seeded by opts; the Effect, Theme and playback fields of opts
+// are ignored. Text effects need opts.Text and fail with ErrNoText
+// without it; ring-text and blackhole fail with an error wrapping
+// animations.ErrTextTooLarge when it doesn't fit the canvas.
+func New(effectName, theme string, opts Options) (Animation, error) {
+	opts = opts.withDefaults()
+
+	meta := animations.GetEffectMetadata(effectName)
+	if meta == nil {
+		return nil, fmt.Errorf("%w %q", ErrUnknownEffect, effectName)
+	}
+	colors, ok := animations.GetTheme(theme)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownTheme, theme)
+	}
+	if meta.RequiresText && opts.Text == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNoText, effectName)
+	}
+
+	settings := effects.Settings{
+		Width:  opts.Width,
+		Height: opts.Height,
+		Text:   opts.Text,
+		Seed:   opts.Seed,
+	}
+	// The command effect runs its text as a shell command
+	if effectName == "command" {
+		settings.Command = opts.Text
+		if settings.Command == "" {
+			settings.Command = "date"
+		}
+	}
+	anim, err := effects.New(effectName, colors, settings)
+	if errors.Is(err, effects.ErrUnknownEffect) {
+		return nil, fmt.Errorf("%w %q", ErrUnknownEffect, effectName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("syscgo: %s: %w", effectName, err)
+	}
+	return anim, nil
+}
@@ -0,0 +1,162 @@
+// Package syscgo plays sysc-Go effects with one call. New builds any effect
+// in the registry from its name and a theme name, and Run plays one into an
+// io.Writer at a steady frame rate until it is told to stop:
+//
+//	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+//	defer stop()
+//	err := syscgo.Run(ctx, os.Stdout, syscgo.Options{
+//		Effect:    "matrix",
+//		Theme:     "nord",
+//		Duration:  10 * time.Second,
+//		AltScreen: true,
+//	})
+//
+// For full control over an effect's settings use the animations package
+// directly; syscgo builds effects with the same settings as the syscgo
+// command.
+package syscgo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// Animation is a playable effect, see animations.Animation
+type Animation = animations.Animation
+
+var (
+	// ErrUnknownEffect is returned for effect names missing from the registry
+	ErrUnknownEffect = errors.New("syscgo: unknown effect")
+	// ErrUnknownTheme is returned for theme names that aren't built in
+	ErrUnknownTheme = errors.New("syscgo: unknown theme")
+	// ErrNoText is returned when a text effect is given no Options.Text
+	ErrNoText = errors.New("syscgo: effect needs text")
+)
+
+// Options configures New and Run. The zero value plays the fire effect in
+// the dracula theme on an 80x24 canvas at 20 frames per second until the
+// context is cancelled.
+type Options struct {
+	// Effect is the effect Run plays, one of animations.GetEffectNames
+	// (default "fire"). New takes the effect as an argument instead.
+	Effect string
+
+	// Theme is the built-in theme Run uses, one of
+	// animations.GetThemeNames (default "dracula"). New takes the theme as
+	// an argument instead.
+	Theme string
+
+	// Width and Height are the canvas size in cells (default 80x24)
+	Width  int
+	Height int
+
+	// Text is the text or ASCII art shown by text effects, which fail with
+	// ErrNoText without it. The life effect grows from it when given, and
+	// the command effect runs it as a shell command (default "date").
+	Text string
+
+	// Seed makes random effects reproducible; 0 seeds from the clock
+	Seed int64
+
+	// FPS is how many frames Run draws per second (default 20)
+	FPS int
+
+	// Duration stops Run after this long; 0 plays until the context is
+	// cancelled, the process is interrupted or the effect finishes
+	Duration time.Duration
+
+	// Frames stops Run after this many frames; 0 means no limit
+	Frames int
+
+	// AltScreen makes Run draw on the terminal's alternate screen with the
+	// cursor hidden, and restore the screen and cursor when it returns.
+	// Leave it off when w isn't a terminal.
+	AltScreen bool
+}
+
+// withDefaults fills in the defaults for zero fields
+func (o Options) withDefaults() Options {
+	if o.Effect == "" {
+		o.Effect = "fire"
+	}
+	if o.Theme == "" {
+		o.Theme = "dracula"
+	}
+	if o.Width <= 0 {
+		o.Width = 80
+	}
+	if o.Height <= 0 {
+		o.Height = 24
+	}
+	if o.FPS <= 0 {
+		o.FPS = 20
+	}
+	return o
+}
+
+// Run plays opts.Effect into w, one frame every 1/FPS seconds, each frame
+// starting with a cursor home escape so it repaints the previous one. It
+// returns once Duration or Frames is used up, the effect finishes or ctx is
+// cancelled, restoring the screen first when AltScreen is set. Run leaves
+// signals to the caller, so to stop on Ctrl+C pass a ctx from
+// signal.NotifyContext. The error is ctx.Err() when ctx ended the run, the
+// first write error, or nil.
+func Run(ctx context.Context, w io.Writer, opts Options) (err error) {
+	opts = opts.withDefaults()
+	anim, err := New(opts.Effect, opts.Theme, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.AltScreen {
+		if _, err := io.WriteString(w, "\033[?1049h\033[2J\033[?25l"); err != nil {
+			return err
+		}
+		defer func() {
+			if _, restoreErr := io.WriteString(w, "\033[0m\033[2J\033[?25h\033[?1049l"); err == nil {
+				err = restoreErr
+			}
+		}()
+	}
+
+	var deadline <-chan time.Time
+	if opts.Duration > 0 {
+		timer := time.NewTimer(opts.Duration)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	ticker := time.NewTicker(time.Second / time.Duration(opts.FPS))
+	defer ticker.Stop()
+
+	writer, streams := anim.(io.WriterTo)
+	finishing, _ := anim.(interface{ Done() bool })
+	for frame := 1; ; frame++ {
+		anim.Update()
+		if _, err := io.WriteString(w, "\033[H"); err != nil {
+			return err
+		}
+		if streams {
+			_, err = writer.WriteTo(w)
+		} else {
+			_, err = io.WriteString(w, anim.Render())
+		}
+		if err != nil {
+			return err
+		}
+		if (opts.Frames > 0 && frame >= opts.Frames) || (finishing != nil && finishing.Done()) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
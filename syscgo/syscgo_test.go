@@ -0,0 +1,123 @@
+package syscgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+func TestNew_EveryRegistryEffect(t *testing.T) {
+	for _, name := range animations.GetEffectNames() {
+		anim, err := New(name, "nord", Options{Text: testText(name), Seed: 1})
+		if err != nil {
+			t.Errorf("New(%q) failed: %v", name, err)
+			continue
+		}
+		anim.Update()
+		if anim.Render() == "" {
+			t.Errorf("%s rendered an empty frame", name)
+		}
+	}
+}
+
+// testText is the text each effect is built with in tests, a harmless
+// command for the command effect
+func testText(effect string) string {
+	if effect == "command" {
+		return "echo SYSC"
+	}
+	return "SYSC"
+}
+
+func TestNew_ResizeSmall(t *testing.T) {
+	for _, name := range animations.GetEffectNames() {
+		for _, size := range [][2]int{{40, 15}, {20, 10}, {10, 5}, {1, 1}} {
+			anim, err := New(name, "nord", Options{Text: testText(name), Seed: 1})
+			if err != nil {
+				t.Fatalf("New(%q) failed: %v", name, err)
+			}
+			resizable, ok := anim.(animations.Resizable)
+			if !ok {
+				continue
+			}
+			for range 20 {
+				anim.Update()
+			}
+			resizable.Resize(size[0], size[1])
+			for range 200 {
+				anim.Update()
+				anim.Render()
+			}
+		}
+	}
+}
+
+func TestNew_Scaled(t *testing.T) {
+	for _, name := range animations.GetEffectNames() {
+		for _, scale := range []int{2, 4, 8} {
+			anim, err := New(name, "nord", Options{Text: testText(name), Seed: 1})
+			if err != nil {
+				t.Fatalf("New(%q) failed: %v", name, err)
+			}
+			scalable, ok := anim.(interface {
+				Update()
+				animations.CellRenderer
+				animations.Resizable
+			})
+			if !ok {
+				continue
+			}
+			scaled := animations.NewScaledEffect(scalable, scale, 80, 24)
+			for range 200 {
+				scaled.Update()
+				if cells := scaled.RenderCells(); len(cells) != 24 || len(cells[0]) != 80 {
+					t.Fatalf("%s at scale %d drew %dx%d cells, want 80x24", name, scale, len(cells[0]), len(cells))
+				}
+			}
+		}
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	tests := []struct {
+		effect, theme, text string
+		want                error
+	}{
+		{"nope", "nord", "", ErrUnknownEffect},
+		{"fire", "nope", "", ErrUnknownTheme},
+		{"decrypt", "nord", "", ErrNoText},
+		{"ring-text", "nord", strings.Repeat("#", 81), animations.ErrTextTooLarge},
+	}
+	for _, tt := range tests {
+		if _, err := New(tt.effect, tt.theme, Options{Text: tt.text}); !errors.Is(err, tt.want) {
+			t.Errorf("New(%q, %q) error = %v, want %v", tt.effect, tt.theme, err, tt.want)
+		}
+	}
+}
+
+func TestRun_Frames(t *testing.T) {
+	var out bytes.Buffer
+	err := Run(context.Background(), &out, Options{Effect: "starfield", Frames: 3, FPS: 1000, AltScreen: true})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	got := out.String()
+	if n := strings.Count(got, "\033[H"); n != 3 {
+		t.Errorf("wrote %d frames, want 3", n)
+	}
+	if !strings.HasPrefix(got, "\033[?1049h") || !strings.HasSuffix(got, "\033[?1049l") {
+		t.Error("alternate screen not entered and restored")
+	}
+}
+
+func TestRun_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := Run(ctx, &bytes.Buffer{}, Options{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,23 @@
+package tui
+
+import "testing"
+
+func TestCreateAnimation_SmallTerminals(t *testing.T) {
+	m := NewModel()
+	for _, size := range [][2]int{{minTerminalWidth, minTerminalHeight}, {70, 16}} {
+		m.width, m.height = size[0], size[1]
+		m.canvasHeight = m.layout().canvasHeight
+		for i := range m.animations {
+			m.selectedAnimation = i
+			anim := m.createAnimation()
+			if anim == nil {
+				continue
+			}
+			// Effects that spawn things on a timer do so in the first frames
+			for range 100 {
+				anim.Update()
+				anim.Render()
+			}
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"slices"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -18,6 +20,11 @@ func (m Model) handleBitEditorKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleColorPickerKeyPress(msg)
 	}
 
+	// Handle shadow color picker
+	if m.bitShadowPicker {
+		return m.handleShadowPickerKeyPress(msg)
+	}
+
 	// Handle export prompt
 	if m.showExportPrompt {
 		return m.handleBitExportPromptKeyPress(msg)
@@ -55,7 +62,7 @@ func (m Model) handleBitEditorKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "tab":
 		// Next control
 		m.bitFocusedControl++
-		if m.bitFocusedControl > 6 {
+		if m.bitFocusedControl > 8 {
 			m.bitFocusedControl = 0
 		}
 		// Update input focus
@@ -70,7 +77,7 @@ func (m Model) handleBitEditorKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Previous control
 		m.bitFocusedControl--
 		if m.bitFocusedControl < 0 {
-			m.bitFocusedControl = 6
+			m.bitFocusedControl = 8
 		}
 		// Update input focus
 		if m.bitFocusedControl == 0 {
@@ -87,6 +94,14 @@ func (m Model) handleBitEditorKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.bitShowFontList = true
 		case 3: // Color
 			m.bitColorPicker = true
+		case 5: // Shadow color
+			m.bitShadowPicker = true
+		case 7: // Layout
+			m.bitSmushing = !m.bitSmushing
+			m = m.updateBitPreview()
+		case 8: // Gradient
+			m.bitUseGradient = !m.bitUseGradient
+			m = m.updateBitPreview()
 		}
 		return m, nil
 
@@ -168,19 +183,7 @@ func (m Model) handleFontBrowserKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // handleColorPickerKeyPress handles color picker navigation
 func (m Model) handleColorPickerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	themeColors := []string{
-		"#88C0D0", "#A3BE8C", "#B48EAD", "#D08770", "#BF616A", "#EBCB8B",
-		"#BD93F9", "#FF79C6", "#8BE9FD", "#50FA7B", "#FFFFFF", "#808080",
-	}
-
-	// Find current color index
-	currentIdx := 0
-	for i, c := range themeColors {
-		if c == m.bitColor {
-			currentIdx = i
-			break
-		}
-	}
+	currentIdx := m.colorPickerIndex()
 
 	switch msg.String() {
 	case "esc":
@@ -189,14 +192,14 @@ func (m Model) handleColorPickerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "up", "k":
 		if currentIdx > 0 {
-			m.bitColor = themeColors[currentIdx-1]
+			m = m.applyColorPickerOption(colorPickerOptions[currentIdx-1])
 			m = m.updateBitPreview()
 		}
 		return m, nil
 
 	case "down", "j":
-		if currentIdx < len(themeColors)-1 {
-			m.bitColor = themeColors[currentIdx+1]
+		if currentIdx < len(colorPickerOptions)-1 {
+			m = m.applyColorPickerOption(colorPickerOptions[currentIdx+1])
 			m = m.updateBitPreview()
 		}
 		return m, nil
@@ -209,6 +212,77 @@ func (m Model) handleColorPickerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// colorPickerIndex returns the index of the color picker option matching the
+// current color or gradient preset, or 0 if none matches
+func (m Model) colorPickerIndex() int {
+	for i, option := range colorPickerOptions {
+		if len(option.Stops) == 1 && m.bitGradientStops == nil && option.Stops[0] == m.bitColor {
+			return i
+		}
+		if len(option.Stops) > 1 && slices.Equal(option.Stops, m.bitGradientStops) {
+			return i
+		}
+	}
+	return 0
+}
+
+// applyColorPickerOption selects a solid color, or a gradient preset which also turns the gradient on
+func (m Model) applyColorPickerOption(option ColorPickerOption) Model {
+	m.bitColor = option.Stops[0]
+	if len(option.Stops) == 1 {
+		m.bitGradientStops = nil
+		return m
+	}
+	m.bitGradientStops = option.Stops
+	m.bitUseGradient = true
+	return m
+}
+
+// handleShadowPickerKeyPress handles shadow color picker navigation
+func (m Model) handleShadowPickerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	colors := shadowPickerColors()
+	currentIdx := max(slices.Index(colors, m.bitShadowColor), 0)
+
+	switch msg.String() {
+	case "esc", "enter":
+		m.bitShadowPicker = false
+		return m, nil
+
+	case "up", "k":
+		if currentIdx > 0 {
+			m.bitShadowColor = colors[currentIdx-1]
+			m = m.updateBitPreview()
+		}
+		return m, nil
+
+	case "down", "j":
+		if currentIdx < len(colors)-1 {
+			m.bitShadowColor = colors[currentIdx+1]
+			m = m.updateBitPreview()
+		}
+		return m, nil
+
+	case "s":
+		m.bitShadowSoft = !m.bitShadowSoft
+		m = m.updateBitPreview()
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// shadowPickerColors returns the shadow color choices, an empty string to
+// follow the text color and then every solid color of the color picker
+func shadowPickerColors() []string {
+	colors := []string{""}
+	for _, option := range colorPickerOptions {
+		if len(option.Stops) == 1 {
+			colors = append(colors, option.Stops[0])
+		}
+	}
+	return colors
+}
+
 // handleBitExportPromptKeyPress handles export target selection
 func (m Model) handleBitExportPromptKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
@@ -307,6 +381,16 @@ func (m Model) handleBitControlLeft() Model {
 			m.bitCharSpacing--
 			m = m.updateBitPreview()
 		}
+
+	case 7: // Layout
+		m.bitSmushing = !m.bitSmushing
+		m = m.updateBitPreview()
+
+	case 8: // Gradient - previous direction
+		if m.bitUseGradient {
+			m.bitGradientDir = (m.bitGradientDir + len(gradientDirectionNames) - 1) % len(gradientDirectionNames)
+			m = m.updateBitPreview()
+		}
 	}
 
 	return m
@@ -358,6 +442,16 @@ func (m Model) handleBitControlRight() Model {
 			m.bitCharSpacing++
 			m = m.updateBitPreview()
 		}
+
+	case 7: // Layout
+		m.bitSmushing = !m.bitSmushing
+		m = m.updateBitPreview()
+
+	case 8: // Gradient - next direction
+		if m.bitUseGradient {
+			m.bitGradientDir = (m.bitGradientDir + 1) % len(gradientDirectionNames)
+			m = m.updateBitPreview()
+		}
 	}
 
 	return m
@@ -396,6 +490,10 @@ func (m Model) handleBitControlDown() Model {
 			m.bitWordSpacing--
 			m = m.updateBitPreview()
 		}
+
+	case 8: // Gradient - toggle
+		m.bitUseGradient = !m.bitUseGradient
+		m = m.updateBitPreview()
 	}
 
 	return m
@@ -403,6 +501,8 @@ func (m Model) handleBitControlDown() Model {
 
 // updateBitPreview regenerates the preview with current settings
 func (m Model) updateBitPreview() Model {
+	m = m.persistBitSettings()
+
 	text := m.bitTextInput.Value()
 	if text == "" || m.bitCurrentFont == nil {
 		m.bitPreviewLines = []string{}
@@ -419,11 +519,15 @@ func (m Model) updateBitPreview() Model {
 		ShadowOffsetX: m.bitShadowOffsetX,
 		ShadowOffsetY: m.bitShadowOffsetY,
 		ShadowStyle:   m.bitShadowStyle,
+		ShadowColor:   m.bitShadowColor,
+		ShadowSoft:    m.bitShadowSoft,
 		CharSpacing:   m.bitCharSpacing,
 		WordSpacing:   m.bitWordSpacing,
 		LineSpacing:   m.bitLineSpacing,
+		Smushing:      m.bitSmushing,
 		UseGradient:   m.bitUseGradient,
 		GradientColor: m.bitGradientColor,
+		GradientStops: m.bitGradientStops,
 		GradientDir:   m.bitGradientDir,
 		MaxWidth:      m.width - 10,
 	}
@@ -17,6 +17,10 @@ func (m Model) renderBitEditorView() string {
 		return m.renderColorPicker()
 	}
 
+	if m.bitShadowPicker {
+		return m.renderShadowPicker()
+	}
+
 	if m.showExportPrompt {
 		return m.renderExportPrompt() // Reuse existing export prompt
 	}
@@ -120,6 +124,14 @@ func (m Model) renderBitControls() string {
 	)
 	controls = append(controls, row2)
 
+	// Row 3: Layout, Gradient
+	row3 := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		m.renderLayoutControl(),
+		m.renderGradientControl(),
+	)
+	controls = append(controls, row3)
+
 	return controlsStyle.Render(lipgloss.JoinVertical(lipgloss.Left, controls...))
 }
 
@@ -199,6 +211,9 @@ func (m Model) renderColorControl() string {
 
 	label := "Color: "
 	value := "███ " + m.bitColor
+	if m.bitGradientStops != nil {
+		value = "▓▓▓ " + colorPickerOptions[m.colorPickerIndex()].Name
+	}
 
 	return style.Render(label + "\n" + value)
 }
@@ -246,9 +261,17 @@ func (m Model) renderShadowControl() string {
 	status := "Off"
 	if m.bitShadow {
 		status = fmt.Sprintf("On (%d,%d)", m.bitShadowOffsetX, m.bitShadowOffsetY)
+		if m.bitShadowSoft {
+			status += " soft"
+		}
 	}
 
-	return style.Render(label + "\n" + status)
+	color := "Text color"
+	if m.bitShadowColor != "" {
+		color = "███ " + m.bitShadowColor
+	}
+
+	return style.Render(label + "\n" + status + "\n" + color)
 }
 
 // renderSpacingControl renders spacing controls
@@ -273,6 +296,58 @@ func (m Model) renderSpacingControl() string {
 	return style.Render(label + "\n" + value)
 }
 
+// renderLayoutControl renders the glyph layout toggle
+func (m Model) renderLayoutControl() string {
+	focused := m.bitFocusedControl == 7
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#8FBCBB")).
+		Padding(0, 1).
+		Width(20)
+
+	if focused {
+		style = style.
+			Background(lipgloss.Color("#8FBCBB")).
+			Foreground(lipgloss.Color("#2E3440")).
+			Bold(true)
+	}
+
+	label := "Layout: "
+
+	value := "Kerned"
+	if m.bitSmushing {
+		value = "Smushed"
+	}
+
+	return style.Render(label + "\n" + value)
+}
+
+// renderGradientControl renders the gradient toggle and direction
+func (m Model) renderGradientControl() string {
+	focused := m.bitFocusedControl == 8
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#81A1C1")).
+		Padding(0, 1).
+		Width(20)
+
+	if focused {
+		style = style.
+			Background(lipgloss.Color("#81A1C1")).
+			Foreground(lipgloss.Color("#2E3440")).
+			Bold(true)
+	}
+
+	label := "Gradient: "
+
+	status := "Off"
+	if m.bitUseGradient {
+		status = "On " + gradientDirectionNames[m.bitGradientDir]
+	}
+
+	return style.Render(label + "\n" + status)
+}
+
 // renderBitHelp renders help text for BIT editor
 func (m Model) renderBitHelp() string {
 	helpText := "Tab/Shift+Tab Controls • ←/→ Adjust • Enter Select • Ctrl+F Font • Ctrl+C Color • Ctrl+S Save • Esc Back"
@@ -357,40 +432,30 @@ func (m Model) renderColorPicker() string {
 		Render("Select Color")
 	sections = append(sections, title)
 
-	// Theme colors
-	themeColors := []struct {
-		name  string
-		color string
-	}{
-		{"Nord Blue", "#88C0D0"},
-		{"Nord Green", "#A3BE8C"},
-		{"Nord Purple", "#B48EAD"},
-		{"Nord Orange", "#D08770"},
-		{"Nord Red", "#BF616A"},
-		{"Nord Yellow", "#EBCB8B"},
-		{"Dracula Purple", "#BD93F9"},
-		{"Dracula Pink", "#FF79C6"},
-		{"Dracula Cyan", "#8BE9FD"},
-		{"Dracula Green", "#50FA7B"},
-		{"White", "#FFFFFF"},
-		{"Gray", "#808080"},
-	}
-
 	listStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color("#88C0D0")).
 		Padding(1, 2).
 		Width(m.width - 8)
 
+	selected := m.colorPickerIndex()
+
 	var colorItems []string
-	for _, c := range themeColors {
-		swatch := lipgloss.NewStyle().
-			Foreground(lipgloss.Color(c.color)).
-			Render("███ ")
+	for i, c := range colorPickerOptions {
+		var swatch strings.Builder
+		for j := range 3 {
+			stop := c.Stops[j*(len(c.Stops)-1)/2]
+			swatch.WriteString(lipgloss.NewStyle().
+				Foreground(lipgloss.Color(stop)).
+				Render("█"))
+		}
 
-		item := swatch + c.name + " " + c.color
+		item := swatch.String() + " " + c.Name
+		if len(c.Stops) == 1 {
+			item += " " + c.Stops[0]
+		}
 
-		if c.color == m.bitColor {
+		if i == selected {
 			colorItems = append(colorItems, lipgloss.NewStyle().
 				Foreground(lipgloss.Color("#A3BE8C")).
 				Bold(true).
@@ -411,6 +476,59 @@ func (m Model) renderColorPicker() string {
 	return content
 }
 
+// renderShadowPicker renders the shadow color picker
+func (m Model) renderShadowPicker() string {
+	var sections []string
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#88C0D0")).
+		Padding(1, 0).
+		Render("Select Shadow Color")
+	sections = append(sections, title)
+
+	listStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#B48EAD")).
+		Padding(1, 2).
+		Width(m.width - 8)
+
+	var colorItems []string
+	for _, color := range shadowPickerColors() {
+		item := "    Text color"
+		if color != "" {
+			item = lipgloss.NewStyle().
+				Foreground(lipgloss.Color(color)).
+				Render("███ ") + color
+		}
+
+		if color == m.bitShadowColor {
+			colorItems = append(colorItems, lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#A3BE8C")).
+				Bold(true).
+				Render("▸ "+item))
+		} else {
+			colorItems = append(colorItems, lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#ECEFF4")).
+				Render("  "+item))
+		}
+	}
+
+	soft := "Off"
+	if m.bitShadowSoft {
+		soft = "On"
+	}
+	colorItems = append(colorItems, "", "Soft shadow: "+soft)
+
+	sections = append(sections, listStyle.Render(strings.Join(colorItems, "\n")))
+
+	helpText := "↑/↓ Navigate • S Soft shadow • Enter Select • Esc Cancel"
+	sections = append(sections, m.styles.Help.Render(helpText))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, sections...)
+	return content
+}
+
 // renderBitSavePrompt renders the save dialog for BIT editor
 func (m Model) renderBitSavePrompt() string {
 	var sections []string
@@ -6,6 +6,8 @@ const (
 	GradientDownUp
 	GradientLeftRight
 	GradientRightLeft
+	GradientDiagonalDown
+	GradientDiagonalUp
 )
 
 // Shadow style constants for TUI usage
@@ -27,11 +29,15 @@ type TUIRenderOptions struct {
 	ShadowOffsetX int
 	ShadowOffsetY int
 	ShadowStyle   int
+	ShadowColor   string // Empty to follow the text color
+	ShadowSoft    bool
 	CharSpacing   int
 	WordSpacing   int
 	LineSpacing   int
+	Smushing      bool
 	UseGradient   bool
 	GradientColor string
+	GradientStops []string // Preset stops, overrides Color to GradientColor when set
 	GradientDir   int
 	MaxWidth      int // Canvas width for alignment
 }
@@ -41,9 +47,11 @@ type RenderOptions struct {
 	CharSpacing            int
 	WordSpacing            int
 	LineSpacing            int
+	Smushing               bool
 	Alignment              TextAlignment
 	TextColor              string
 	GradientColor          string
+	GradientStops          []string
 	GradientDirection      GradientDirection
 	UseGradient            bool
 	ScaleFactor            float64
@@ -51,6 +59,8 @@ type RenderOptions struct {
 	ShadowHorizontalOffset int
 	ShadowVerticalOffset   int
 	ShadowStyle            ShadowStyle
+	ShadowColor            string
+	ShadowSoft             bool
 	TextLines              []string
 }
 
@@ -81,13 +91,17 @@ func convertToBITOptions(opts TUIRenderOptions) RenderOptions {
 		CharSpacing:            opts.CharSpacing,
 		WordSpacing:            opts.WordSpacing,
 		LineSpacing:            opts.LineSpacing,
+		Smushing:               opts.Smushing,
 		TextColor:              opts.Color,
 		ScaleFactor:            opts.Scale,
 		ShadowEnabled:          opts.Shadow,
 		ShadowHorizontalOffset: opts.ShadowOffsetX,
 		ShadowVerticalOffset:   opts.ShadowOffsetY,
+		ShadowColor:            opts.ShadowColor,
+		ShadowSoft:             opts.ShadowSoft,
 		UseGradient:            opts.UseGradient,
 		GradientColor:          opts.GradientColor,
+		GradientStops:          opts.GradientStops,
 	}
 
 	// Default values
@@ -111,6 +125,10 @@ func convertToBITOptions(opts TUIRenderOptions) RenderOptions {
 		bitOpts.GradientDirection = LeftRight
 	case GradientRightLeft:
 		bitOpts.GradientDirection = RightLeft
+	case GradientDiagonalDown:
+		bitOpts.GradientDirection = DiagonalDown
+	case GradientDiagonalUp:
+		bitOpts.GradientDirection = DiagonalUp
 	default:
 		bitOpts.GradientDirection = UpDown
 	}
@@ -155,6 +173,8 @@ const (
 	DownUp
 	LeftRight
 	RightLeft
+	DiagonalDown
+	DiagonalUp
 )
 
 // ShadowStyle from BIT
@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+)
+
+// hexColorPattern matches a #RRGGBB color
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// bitSettings is the BIT editor state saved between sessions.
+// The font is stored by name so it survives fonts being added or removed.
+type bitSettings struct {
+	Font          string   `json:"font"`
+	Alignment     int      `json:"alignment"`
+	Color         string   `json:"color"`
+	Scale         float64  `json:"scale"`
+	Shadow        bool     `json:"shadow"`
+	ShadowOffsetX int      `json:"shadow_offset_x"`
+	ShadowOffsetY int      `json:"shadow_offset_y"`
+	ShadowStyle   int      `json:"shadow_style"`
+	ShadowColor   string   `json:"shadow_color"`
+	ShadowSoft    bool     `json:"shadow_soft"`
+	CharSpacing   int      `json:"char_spacing"`
+	WordSpacing   int      `json:"word_spacing"`
+	LineSpacing   int      `json:"line_spacing"`
+	Smushing      bool     `json:"smushing"`
+	Gradient      bool     `json:"gradient"`
+	GradientDir   int      `json:"gradient_direction"`
+	GradientStops []string `json:"gradient_stops,omitempty"`
+}
+
+// bitSettingsPath returns the path of the BIT editor settings file
+func bitSettingsPath() string {
+	return filepath.Join(os.Getenv("HOME"), ".config", "syscgo", "biteditor.json")
+}
+
+// loadBitSettings reads BIT editor settings from path on top of settings,
+// so fields missing from the file keep their current values
+func loadBitSettings(path string, settings bitSettings) (bitSettings, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, fmt.Errorf("invalid BIT editor settings %s: %w", path, err)
+	}
+	return settings, nil
+}
+
+// saveBitSettings writes BIT editor settings to path, creating its directory if needed
+func saveBitSettings(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save BIT editor settings: %w", err)
+	}
+	return nil
+}
+
+// bitSettings returns the current BIT editor settings
+func (m Model) bitSettings() bitSettings {
+	settings := bitSettings{
+		Alignment:     m.bitAlignment,
+		Color:         m.bitColor,
+		Scale:         m.bitScale,
+		Shadow:        m.bitShadow,
+		ShadowOffsetX: m.bitShadowOffsetX,
+		ShadowOffsetY: m.bitShadowOffsetY,
+		ShadowStyle:   m.bitShadowStyle,
+		ShadowColor:   m.bitShadowColor,
+		ShadowSoft:    m.bitShadowSoft,
+		CharSpacing:   m.bitCharSpacing,
+		WordSpacing:   m.bitWordSpacing,
+		LineSpacing:   m.bitLineSpacing,
+		Smushing:      m.bitSmushing,
+		Gradient:      m.bitUseGradient,
+		GradientDir:   m.bitGradientDir,
+		GradientStops: m.bitGradientStops,
+	}
+	if m.bitSelectedFont < len(m.bitFonts) {
+		settings.Font = m.bitFonts[m.bitSelectedFont]
+	}
+	return settings
+}
+
+// applyBitSettings restores saved BIT editor settings, keeping the current
+// value of anything that is out of range
+func (m Model) applyBitSettings(settings bitSettings) Model {
+	if i := slices.Index(m.bitFonts, settings.Font); i >= 0 && i != m.bitSelectedFont {
+		if fontPath, err := FindFontPath(settings.Font); err == nil {
+			if font, err := LoadBitFont(fontPath); err == nil {
+				m.bitSelectedFont = i
+				m.bitCurrentFont = font
+			}
+		}
+	}
+	if settings.Alignment >= 0 && settings.Alignment <= 2 {
+		m.bitAlignment = settings.Alignment
+	}
+	if isValidHexColor(settings.Color) {
+		m.bitColor = settings.Color
+	}
+	if slices.Contains([]float64{0.5, 1.0, 2.0, 3.0, 4.0}, settings.Scale) {
+		m.bitScale = settings.Scale
+	}
+	m.bitShadow = settings.Shadow
+	m.bitShadowOffsetX = clamp(settings.ShadowOffsetX, -5, 5)
+	m.bitShadowOffsetY = clamp(settings.ShadowOffsetY, -5, 5)
+	if settings.ShadowStyle >= 0 && settings.ShadowStyle < len(shadowStyleOptions) {
+		m.bitShadowStyle = settings.ShadowStyle
+	}
+	if settings.ShadowColor == "" || isValidHexColor(settings.ShadowColor) {
+		m.bitShadowColor = settings.ShadowColor
+	}
+	m.bitShadowSoft = settings.ShadowSoft
+	m.bitCharSpacing = clamp(settings.CharSpacing, 0, 10)
+	m.bitWordSpacing = clamp(settings.WordSpacing, 0, 20)
+	m.bitLineSpacing = clamp(settings.LineSpacing, 0, 10)
+	m.bitSmushing = settings.Smushing
+	m.bitUseGradient = settings.Gradient
+	if settings.GradientDir >= 0 && settings.GradientDir < len(gradientDirectionNames) {
+		m.bitGradientDir = settings.GradientDir
+	}
+	if len(settings.GradientStops) > 1 && !slices.ContainsFunc(settings.GradientStops, func(c string) bool { return !isValidHexColor(c) }) {
+		m.bitGradientStops = settings.GradientStops
+	}
+	return m
+}
+
+// isValidHexColor reports whether color is a #RRGGBB color
+func isValidHexColor(color string) bool {
+	return hexColorPattern.MatchString(color)
+}
+
+// bitSettingsJSON returns the current BIT editor settings as they are written to disk
+func (m Model) bitSettingsJSON() string {
+	data, _ := json.MarshalIndent(m.bitSettings(), "", "  ")
+	return string(data)
+}
+
+// persistBitSettings saves the BIT editor settings if they changed since the last save.
+// A failed save is retried on the next change.
+func (m Model) persistBitSettings() Model {
+	data := m.bitSettingsJSON()
+	if data == m.bitSavedSettings {
+		return m
+	}
+	if err := saveBitSettings(bitSettingsPath(), []byte(data)); err == nil {
+		m.bitSavedSettings = data
+	}
+	return m
+}
@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"os"
+	"testing"
+)
+
+func TestBitSettings_RoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	m := Model{
+		bitFonts:         []string{"block"},
+		bitColor:         "#BD93F9",
+		bitScale:         2.0,
+		bitShadow:        true,
+		bitShadowOffsetX: -2,
+		bitShadowOffsetY: 3,
+		bitCharSpacing:   4,
+		bitWordSpacing:   6,
+		bitLineSpacing:   2,
+		bitAlignment:     2,
+		bitSmushing:      true,
+		bitUseGradient:   true,
+		bitGradientStops: []string{"#8BE9FD", "#BD93F9", "#FF79C6"},
+	}
+	m = m.persistBitSettings()
+	if m.bitSavedSettings == "" {
+		t.Fatal("settings were not saved")
+	}
+
+	defaults := Model{bitFonts: []string{"block"}, bitColor: "#88C0D0", bitScale: 1.0, bitCharSpacing: 1}
+	settings, err := loadBitSettings(bitSettingsPath(), defaults.bitSettings())
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	restored := defaults.applyBitSettings(settings)
+	if got, want := restored.bitSettingsJSON(), m.bitSettingsJSON(); got != want {
+		t.Errorf("restored settings = %s, want %s", got, want)
+	}
+}
+
+func TestBitSettings_MissingFieldsKeepDefaults(t *testing.T) {
+	path := t.TempDir() + "/biteditor.json"
+	if err := os.WriteFile(path, []byte(`{"color": "#A3BE8C", "scale": 7}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	defaults := Model{bitColor: "#88C0D0", bitScale: 1.0, bitCharSpacing: 1, bitWordSpacing: 2}
+	settings, err := loadBitSettings(path, defaults.bitSettings())
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	m := defaults.applyBitSettings(settings)
+	if m.bitColor != "#A3BE8C" {
+		t.Errorf("color = %s, want #A3BE8C", m.bitColor)
+	}
+	if m.bitScale != 1.0 {
+		t.Errorf("invalid scale was applied: %v", m.bitScale)
+	}
+	if m.bitCharSpacing != 1 || m.bitWordSpacing != 2 {
+		t.Errorf("spacing = %d/%d, want defaults 1/2", m.bitCharSpacing, m.bitWordSpacing)
+	}
+}
@@ -29,3 +29,36 @@ var shadowStyleOptions = []ShadowStyleOption{
 	{"Medium Shade", '▒', ""}, // U+2592 MEDIUM SHADE - Uses main text color
 	{"Dark Shade", '▓', ""},   // U+2593 DARK SHADE - Uses main text color
 }
+
+// Brightness of the inner and outer layers of a soft shadow relative to the shadow color
+var softShadowBrightness = []float64{0.6, 0.3}
+
+// ColorPickerOption is a solid color or a preset gradient in the BIT color picker
+type ColorPickerOption struct {
+	Name  string
+	Stops []string // One stop for a solid color
+}
+
+// Color picker options, solid colors first and then theme gradients
+var colorPickerOptions = []ColorPickerOption{
+	{"Nord Blue", []string{"#88C0D0"}},
+	{"Nord Green", []string{"#A3BE8C"}},
+	{"Nord Purple", []string{"#B48EAD"}},
+	{"Nord Orange", []string{"#D08770"}},
+	{"Nord Red", []string{"#BF616A"}},
+	{"Nord Yellow", []string{"#EBCB8B"}},
+	{"Dracula Purple", []string{"#BD93F9"}},
+	{"Dracula Pink", []string{"#FF79C6"}},
+	{"Dracula Cyan", []string{"#8BE9FD"}},
+	{"Dracula Green", []string{"#50FA7B"}},
+	{"White", []string{"#FFFFFF"}},
+	{"Gray", []string{"#808080"}},
+	{"Nord Frost Gradient", []string{"#8FBCBB", "#88C0D0", "#81A1C1", "#5E81AC"}},
+	{"Nord Aurora Gradient", []string{"#BF616A", "#D08770", "#EBCB8B", "#A3BE8C", "#B48EAD"}},
+	{"Dracula Gradient", []string{"#8BE9FD", "#BD93F9", "#FF79C6"}},
+	{"Tokyo Night Gradient", []string{"#7AA2F7", "#BB9AF7", "#F7768E"}},
+	{"Fire Gradient", []string{"#FFF1C1", "#FFB347", "#E0561B", "#8B1E1E"}},
+}
+
+// Gradient direction labels, indexed by the TUI gradient direction constants
+var gradientDirectionNames = []string{"↓", "↑", "→", "←", "↘", "↗"}
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+)
+
+// frameExportDir returns the directory preview frames are exported to
+func frameExportDir() string {
+	return filepath.Join(os.Getenv("HOME"), ".local", "share", "syscgo", "frames")
+}
+
+// exportFrame writes frame to dir twice, as name.ans with its ANSI colors
+// and as name.txt in plain text, and returns the path of the plain text file
+func exportFrame(dir, name, frame string) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create frames directory: %w", err)
+	}
+
+	ansPath := filepath.Join(dir, name+".ans")
+	if err := os.WriteFile(ansPath, []byte(frame+"\033[0m\n"), 0600); err != nil {
+		return "", fmt.Errorf("failed to save frame: %w", err)
+	}
+
+	txtPath := filepath.Join(dir, name+".txt")
+	if err := os.WriteFile(txtPath, []byte(plainFrame(frame)+"\n"), 0600); err != nil {
+		return "", fmt.Errorf("failed to save frame: %w", err)
+	}
+
+	return txtPath, nil
+}
+
+// plainFrame strips the colors from a rendered frame and trims trailing spaces from each line
+func plainFrame(frame string) string {
+	lines := strings.Split(stripANSI(frame), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// exportCurrentFrame saves the frame the preview is showing and reports where it went
+func (m Model) exportCurrentFrame() Model {
+	name := fmt.Sprintf("%s-%s-%s",
+		m.animations[m.selectedAnimation], m.themes[m.selectedTheme], time.Now().Format("20060102-150405"))
+
+	path, err := exportFrame(frameExportDir(), name, m.currentAnim.Render())
+	if err != nil {
+		m.frameStatus = err.Error()
+		return m
+	}
+	m.frameStatus = "Frame saved to " + strings.TrimSuffix(path, ".txt") + ".{ans,txt}"
+	return m
+}
+
+// copyCurrentFrame copies the frame the preview is showing to the clipboard as plain text
+func (m Model) copyCurrentFrame() Model {
+	if err := clipboard.WriteAll(plainFrame(m.currentAnim.Render())); err != nil {
+		m.frameStatus = "Clipboard unavailable, use Ctrl+S to save the frame instead"
+		return m
+	}
+	m.frameStatus = "Frame copied to clipboard"
+	return m
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestExportFrame_WritesANSIAndPlainText(t *testing.T) {
+	dir := t.TempDir()
+	frame := "\033[38;2;255;0;0m█\033[0m  \n \033[38;2;0;255;0m▓\033[0m"
+
+	path, err := exportFrame(dir, "fire-nord", frame)
+	if err != nil {
+		t.Fatalf("exportFrame: %v", err)
+	}
+
+	plain, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(plain), "█\n ▓\n"; got != want {
+		t.Errorf("plain text frame = %q, want %q", got, want)
+	}
+
+	ans, err := os.ReadFile(strings.TrimSuffix(path, ".txt") + ".ans")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(ans), frame) {
+		t.Errorf("ANSI frame lost its colors: %q", ans)
+	}
+}
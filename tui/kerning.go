@@ -187,3 +187,50 @@ func computeKerning(glyphA, glyphB []string) int {
 	// Finally, use +1 (extra space needed)
 	return 1 // Need extra space to prevent collision
 }
+
+// computeSmush returns how many columns glyphB can slide left over glyphA,
+// FIGlet kerning style, before an ink pixel in some row would land on or
+// past the last ink pixel of glyphA in that row. Rows where either glyph is
+// blank don't constrain the overlap, which is capped at the narrower glyph.
+func computeSmush(glyphA, glyphB []string) int {
+	widthA, widthB := maxRowLen(glyphA), maxRowLen(glyphB)
+	if widthA == 0 || widthB == 0 {
+		return 0
+	}
+
+	overlap := min(widthA, widthB)
+	for y := range min(len(glyphA), len(glyphB)) {
+		lastA := lastInkColumn(glyphA[y])
+		firstB := firstInkColumn(glyphB[y])
+		if lastA < 0 || firstB < 0 {
+			continue
+		}
+		overlap = min(overlap, widthA-1-lastA+firstB)
+	}
+	return overlap
+}
+
+// firstInkColumn returns the column of the first non-space rune in row, or -1 if it is blank
+func firstInkColumn(row string) int {
+	x := 0
+	for _, r := range row {
+		if r != ' ' && r != 0 {
+			return x
+		}
+		x++
+	}
+	return -1
+}
+
+// lastInkColumn returns the column of the last non-space rune in row, or -1 if it is blank
+func lastInkColumn(row string) int {
+	last := -1
+	x := 0
+	for _, r := range row {
+		if r != ' ' && r != 0 {
+			last = x
+		}
+		x++
+	}
+	return last
+}
@@ -38,6 +38,17 @@ type Model struct {
 	currentAnim      animations.Animation
 	animFrames       int // Frame counter
 
+	// Auto-preview restarts the animation whenever the selection settles
+	autoPreview bool
+	previewSeq  int // Bumped on every selection change so stale previews are dropped
+
+	// Parameter panel for tuning the selected effect
+	showParams   bool
+	paramFocus   int            // Focused parameter of the selected effect
+	paramChoices map[string]int // Chosen value index by "effect/param", defaults when missing
+
+	frameStatus string // Result of the last frame export or copy
+
 	// Editor mode for custom text creation
 	editorMode       bool
 	textarea         textarea.Model
@@ -61,16 +72,22 @@ type Model struct {
 	bitShadowOffsetX  int      // Shadow horizontal offset
 	bitShadowOffsetY  int      // Shadow vertical offset
 	bitShadowStyle    int      // 0=light, 1=medium, 2=dark
+	bitShadowColor    string   // Shadow color (hex), empty to follow the text color
+	bitShadowSoft     bool     // Soft double-offset shadow in dimmed colors
 	bitCharSpacing    int      // Character spacing (0-10)
 	bitWordSpacing    int      // Word spacing (0-20)
 	bitLineSpacing    int      // Line spacing (0-10)
+	bitSmushing       bool     // Slide glyphs together until they touch
 	bitUseGradient    bool     // Gradient enabled
 	bitGradientColor  string   // Gradient end color (hex)
-	bitGradientDir    int      // 0=up-down, 1=down-up, 2=left-right, 3=right-left
+	bitGradientStops  []string // Preset gradient stops from the color picker, nil for none
+	bitGradientDir    int      // 0=up-down, 1=down-up, 2=left-right, 3=right-left, 4/5=diagonal
 	bitPreviewLines   []string // Rendered preview output
 	bitFocusedControl int      // Which control has focus
 	bitColorPicker    bool     // Color picker open
+	bitShadowPicker   bool     // Shadow color picker open
 	bitShowFontList   bool     // Font browser open
+	bitSavedSettings  string   // Settings JSON last written to disk
 
 	// Styles
 	styles Styles
@@ -135,7 +152,7 @@ func NewModel() Model {
 		}
 	}
 
-	return Model{
+	m := Model{
 		animations: []string{
 			"fire",
 			"fire-text",
@@ -204,18 +221,29 @@ func NewModel() Model {
 		bitShadowOffsetX:  1,
 		bitShadowOffsetY:  1,
 		bitShadowStyle:    0,
+		bitShadowColor:    "",
+		bitShadowSoft:     false,
 		bitCharSpacing:    1,
 		bitWordSpacing:    2,
 		bitLineSpacing:    1,
+		bitSmushing:       false,
 		bitUseGradient:    false,
 		bitGradientColor:  "#FFFFFF",
 		bitGradientDir:    0,
 		bitPreviewLines:   []string{},
 		bitFocusedControl: 0,
 		bitColorPicker:    false,
+		bitShadowPicker:   false,
 		bitShowFontList:   false,
 		styles:            NewStyles(),
 	}
+
+	// Restore the BIT editor settings from the last session
+	if settings, err := loadBitSettings(bitSettingsPath(), m.bitSettings()); err == nil {
+		m = m.applyBitSettings(settings)
+	}
+	m.bitSavedSettings = m.bitSettingsJSON()
+	return m
 }
 
 // NewStyles creates the dark theme styles
@@ -281,3 +309,18 @@ func tickCmd() tea.Cmd {
 		return TickMsg(t)
 	})
 }
+
+// previewDebounce is how long the selection has to stay put before auto-preview restarts
+const previewDebounce = 250 * time.Millisecond
+
+// PreviewMsg is sent once the selection has settled while auto-preview is on
+type PreviewMsg struct {
+	seq int
+}
+
+// previewCmd returns a command that sends a preview message for selection change seq
+func previewCmd(seq int) tea.Cmd {
+	return tea.Tick(previewDebounce, func(time.Time) tea.Msg {
+		return PreviewMsg{seq: seq}
+	})
+}
@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"strconv"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// effectParam is one knob in the TUI parameter panel
+type effectParam struct {
+	key     string   // Name createAnimation looks the value up by
+	label   string   // Name shown in the panel
+	choices []string // Values in the order ←/→ steps through them
+	def     int      // Index of the choice matching the effect's TUI default
+}
+
+// effectParams lists the parameter panel knobs for each effect.
+// Effects that aren't listed have nothing to tune yet.
+var effectParams = map[string][]effectParam{
+	"fire": {
+		{"wind", "Wind", []string{"-1", "-0.5", "-0.25", "0", "0.25", "0.5", "1"}, 3},
+		{"sway", "Sway", []string{"0", "0.5", "1", "2"}, 0},
+		{"fill", "Fill", []string{"off", "on"}, 0},
+	},
+	"fire-text": {
+		{"align", "Align", animations.TextAligns, 0},
+		{"mode", "Mode", []string{"hold", "burn-in", "burn-away"}, 0},
+	},
+	"matrix": {
+		{"density", "Density", []string{"0.05", "0.1", "0.2", "0.35", "0.5", "0.75", "1"}, 1},
+		{"trail", "Trail", []string{"off", "6", "12", "20", "30"}, 0},
+		{"speed", "Speed", []string{"slow", "normal", "fast"}, 1},
+		{"glyphs", "Glyphs", animations.MatrixGlyphSets, 0},
+	},
+	"rain": {
+		{"density", "Density", []string{"0.1", "0.2", "0.33", "0.5", "0.75", "1"}, 2},
+		{"speed", "Speed", []string{"slow", "normal", "fast"}, 1},
+		{"splash", "Splash", []string{"off", "on"}, 0},
+	},
+	"fireworks": {
+		{"shape", "Shape", animations.FireworksShapes, 0},
+		{"bold", "Bold", []string{"0", "1", "2", "3"}, 0},
+	},
+	"pour": {
+		{"direction", "Direction", animations.PourDirections, 0},
+		{"easing", "Easing", animations.PourEasings, 2},
+		{"speed", "Speed", []string{"1", "2", "3", "5", "8"}, 2},
+	},
+	"ring-text": {
+		{"cycles", "Spin cycles", []string{"1", "2", "3", "4", "6"}, 1},
+		{"gap", "Ring gap", []string{"0.1", "0.15", "0.2", "0.3"}, 1},
+		{"intro", "Intro", []string{"on", "off"}, 0},
+	},
+	"blackhole-text": {
+		{"intro", "Intro", []string{"on", "off"}, 0},
+	},
+	"aquarium": {
+		{"fish", "Max fish", []string{"10", "20", "30", "50", "80"}, 2},
+	},
+}
+
+// paramIndex returns the chosen index of an effect parameter
+func (m Model) paramIndex(anim string, param effectParam) int {
+	if i, ok := m.paramChoices[anim+"/"+param.key]; ok {
+		return i
+	}
+	return param.def
+}
+
+// paramValue returns the chosen value of an effect parameter, or "" if the effect has no such parameter
+func (m Model) paramValue(anim, key string) string {
+	for _, param := range effectParams[anim] {
+		if param.key == key {
+			return param.choices[m.paramIndex(anim, param)]
+		}
+	}
+	return ""
+}
+
+// paramFloat returns the chosen value of a numeric effect parameter, 0 for "off" or unknown
+func (m Model) paramFloat(anim, key string) float64 {
+	value, _ := strconv.ParseFloat(m.paramValue(anim, key), 64)
+	return value
+}
+
+// paramInt returns the chosen value of an integer effect parameter, 0 for "off" or unknown
+func (m Model) paramInt(anim, key string) int {
+	value, _ := strconv.Atoi(m.paramValue(anim, key))
+	return value
+}
+
+// paramIntro returns the static intro frames and whether the intro shows on
+// the first loop only: frames with the intro on, a short beat with it off
+func (m Model) paramIntro(anim string, frames int) (int, bool) {
+	if m.paramValue(anim, "intro") == "off" {
+		return 5, true
+	}
+	return frames, false
+}
+
+// paramSpeedRange maps a slow/normal/fast choice to a speed range, where
+// normal is the zero range that selects the effect's own default
+func (m Model) paramSpeedRange(anim string, slow, fast [2]float64) [2]float64 {
+	switch m.paramValue(anim, "speed") {
+	case "slow":
+		return slow
+	case "fast":
+		return fast
+	}
+	return [2]float64{}
+}
+
+// adjustParam steps the focused parameter of the selected effect by delta
+func (m Model) adjustParam(delta int) Model {
+	anim := m.animations[m.selectedAnimation]
+	params := effectParams[anim]
+	if m.paramFocus >= len(params) {
+		return m
+	}
+
+	param := params[m.paramFocus]
+	i := m.paramIndex(anim, param) + delta
+	if i < 0 || i >= len(param.choices) {
+		return m
+	}
+
+	if m.paramChoices == nil {
+		m.paramChoices = make(map[string]int)
+	}
+	m.paramChoices[anim+"/"+param.key] = i
+	return m
+}
@@ -6,6 +6,8 @@ import (
 	"regexp"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
 )
 
 // DetectHalfPixelUsage checks if the current text rendering would use half-pixels
@@ -79,7 +81,7 @@ func RenderTextWithFont(text string, fontData FontData, options RenderOptions) [
 			continue
 		}
 
-		lineRendered := renderTextWithFont(line, fontData, options.CharSpacing, float64(options.WordSpacing), options.ScaleFactor)
+		lineRendered := renderTextWithFont(line, fontData, options.CharSpacing, float64(options.WordSpacing), options.ScaleFactor, options.Smushing)
 		lineRendered = stripEmptyLines(lineRendered)
 
 		lineWidth := 0
@@ -140,28 +142,33 @@ func applyStylingAndShadow(plainBlock []string, options RenderOptions) []string
 	}
 
 	// --- Parameter Setup ---
-	var shadowPixels, verticalShadowPixels int
+	// A soft shadow is drawn twice, at the offset and at double the offset
+	var shadowPixels, verticalShadowPixels, shadowLayers int
 	var shadowChar rune
 	if options.ShadowEnabled {
-		shadowPixels = options.ShadowHorizontalOffset
-		verticalShadowPixels = options.ShadowVerticalOffset
+		shadowLayers = 1
+		if options.ShadowSoft {
+			shadowLayers = 2
+		}
+		shadowPixels = options.ShadowHorizontalOffset * shadowLayers
+		verticalShadowPixels = options.ShadowVerticalOffset * shadowLayers
 		shadowChar = shadowStyleOptions[options.ShadowStyle].Char
 	}
 
-	// Gradient color setup
-	isGradient := options.UseGradient && options.GradientColor != options.TextColor
+	// Gradient color setup, preset stops win over the text to gradient color pair
 	startColorHex := options.TextColor
-	var endColorHex string
-	if isGradient {
-		endColorHex = options.GradientColor
+	stops := options.GradientStops
+	if len(stops) < 2 {
+		stops = []string{options.TextColor, options.GradientColor}
 	}
-	startR, startG, startB := hexToRGB(startColorHex)
-	endR, endG, endB := hexToRGB(endColorHex)
+	isGradient := options.UseGradient && (len(options.GradientStops) > 1 || options.GradientColor != options.TextColor)
 
 	// Single color setup
 	shadowStyleHex := shadowStyleOptions[options.ShadowStyle].Hex
 	var shadowColorForStyle string
-	if shadowStyleHex != "" {
+	if options.ShadowColor != "" {
+		shadowColorForStyle = options.ShadowColor
+	} else if shadowStyleHex != "" {
 		shadowColorForStyle = shadowStyleHex
 	} else {
 		shadowColorForStyle = startColorHex // Shadow inherits main text color by default
@@ -190,12 +197,18 @@ func applyStylingAndShadow(plainBlock []string, options RenderOptions) []string
 	canvasWidth := canvasMaxX - canvasMinX
 	canvasHeight := canvasMaxY - canvasMinY
 
+	var gradient *animations.Gradient
+	if isGradient {
+		gradient = animations.NewGradient(stops, max(canvasWidth, canvasHeight, 2)*len(stops))
+	}
+
 	// --- Canvas Creation ---
 	type canvasCell struct {
-		char    rune
-		isMain  bool
-		lineIdx int // Original row index for gradient calculation
-		charIdx int // Original col index for gradient calculation
+		char        rune
+		isMain      bool
+		shadowLayer int // 1 for the shadow nearest the text, 2 for the outer soft shadow
+		lineIdx     int // Original row index for gradient calculation
+		charIdx     int // Original col index for gradient calculation
 	}
 	canvas := make([][]canvasCell, canvasHeight)
 	for i := range canvas {
@@ -208,16 +221,16 @@ func applyStylingAndShadow(plainBlock []string, options RenderOptions) []string
 	}
 
 	// --- Render to Canvas (Shadow first, then Main Text) ---
-	if options.ShadowEnabled {
-		shadowOffsetX := -canvasMinX + shadowPixels
-		shadowOffsetY := -canvasMinY + verticalShadowPixels
+	for layer := shadowLayers; layer >= 1; layer-- {
+		shadowOffsetX := -canvasMinX + options.ShadowHorizontalOffset*layer
+		shadowOffsetY := -canvasMinY + options.ShadowVerticalOffset*layer
 		for y, line := range plainBlock {
 			lineRunes := []rune(line)
 			for x, r := range lineRunes {
 				if r != ' ' {
 					targetX, targetY := shadowOffsetX+x, shadowOffsetY+y
 					if targetX >= 0 && targetX < canvasWidth && targetY >= 0 && targetY < canvasHeight {
-						canvas[targetY][targetX] = canvasCell{char: shadowChar, isMain: false, lineIdx: y, charIdx: x}
+						canvas[targetY][targetX] = canvasCell{char: shadowChar, isMain: false, shadowLayer: layer, lineIdx: y, charIdx: x}
 					}
 				}
 			}
@@ -250,7 +263,7 @@ func applyStylingAndShadow(plainBlock []string, options RenderOptions) []string
 			}
 
 			var cellColorHex string
-			if isGradient {
+			if isGradient && (cell.isMain || options.ShadowColor == "") {
 				var factor float64
 				switch options.GradientDirection {
 				case UpDown: // Up-Down
@@ -273,11 +286,16 @@ func applyStylingAndShadow(plainBlock []string, options RenderOptions) []string
 					if options.GradientDirection == RightLeft {
 						factor = 1.0 - factor
 					}
+				case DiagonalDown, DiagonalUp: // Top-left to bottom-right, bottom-left to top-right
+					row := y
+					if options.GradientDirection == DiagonalUp {
+						row = canvasHeight - 1 - y
+					}
+					if span := canvasWidth + canvasHeight - 2; span > 0 {
+						factor = float64(x+row) / float64(span)
+					}
 				}
-				r := int(float64(startR) + factor*float64(endR-startR))
-				g := int(float64(startG) + factor*float64(endG-startG))
-				b := int(float64(startB) + factor*float64(endB-startB))
-				cellColorHex = rgbToHex(clamp(r, 0, 255), clamp(g, 0, 255), clamp(b, 0, 255))
+				cellColorHex = gradient.At(factor)
 			} else {
 				if cell.isMain {
 					cellColorHex = startColorHex
@@ -285,6 +303,9 @@ func applyStylingAndShadow(plainBlock []string, options RenderOptions) []string
 					cellColorHex = shadowColorForStyle
 				}
 			}
+			if options.ShadowSoft && !cell.isMain {
+				cellColorHex = dimColor(cellColorHex, softShadowBrightness[cell.shadowLayer-1])
+			}
 			// Use true color (24-bit RGB) for smoother gradients
 			r, g, b := hexToRGB(cellColorHex)
 			builder.WriteString(fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s\x1b[0m", r, g, b, string(cell.char)))
@@ -387,8 +408,10 @@ func stripEmptyLines(lines []string) []string {
 	return lines[start : end+1]
 }
 
-// renderTextWithFont renders text using the specified font with proven rendering logic
-func renderTextWithFont(text string, fontData FontData, baseCharSpacing int, wordSpacing float64, scaleFactor float64) []string {
+// renderTextWithFont renders text using the specified font with proven rendering logic.
+// With smushing, each glyph slides left until its ink touches the previous glyph's
+// and baseCharSpacing is added on top of that instead of the regular kerning.
+func renderTextWithFont(text string, fontData FontData, baseCharSpacing int, wordSpacing float64, scaleFactor float64, smushing bool) []string {
 	if text == "" {
 		return []string{}
 	}
@@ -509,6 +532,8 @@ func renderTextWithFont(text string, fontData FontData, baseCharSpacing int, wor
 
 					if !leftExists || !rightExists {
 						kerningCache[pair] = 0
+					} else if smushing {
+						kerningCache[pair] = -computeSmush(leftBitmap, rightBitmap)
 					} else {
 						kerningCache[pair] = computeKerning(leftBitmap, rightBitmap)
 					}
@@ -551,8 +576,9 @@ func renderTextWithFont(text string, fontData FontData, baseCharSpacing int, wor
 					heightDiff := charHeights[prevCharStr] - charHeights[charStr]
 					halfPixelAdjustment := 0.0
 
-					// If heights differ by an odd number, adjust by half a pixel
-					if heightDiff%2 != 0 && i >= charHeights[charStr] {
+					// If heights differ by an odd number, adjust by half a pixel.
+					// Smushed glyphs are placed on exact columns so they can touch.
+					if heightDiff%2 != 0 && i >= charHeights[charStr] && !smushing {
 						halfPixelAdjustment = 0.5
 					}
 
@@ -598,8 +624,11 @@ func renderTextWithFont(text string, fontData FontData, baseCharSpacing int, wor
 			// Convert float64 position to integer for rendering with proper rounding
 			renderXOffset := int(math.Round(currentXOffset))
 
-			// Update cumulative error for next character
-			cumulativeError += currentXOffset - float64(renderXOffset)
+			// Update cumulative error for next character. Smushed glyphs are
+			// already packed to the column, so carrying the error would overlap them.
+			if !smushing {
+				cumulativeError += currentXOffset - float64(renderXOffset)
+			}
 
 			// Ensure lineRunes has enough capacity for main text
 			requiredLength := renderXOffset + utf8.RuneCountInString(fragment)
@@ -700,9 +729,10 @@ func hexToRGB(hex string) (int, int, int) {
 	return r, g, b
 }
 
-// rgbToHex converts RGB values to a hex color string
-func rgbToHex(r, g, b int) string {
-	return fmt.Sprintf("#%02X%02X%02X", r, g, b)
+// dimColor scales a hex color's channels by brightness, from 0 (black) to 1 (unchanged)
+func dimColor(hex string, brightness float64) string {
+	r, g, b := hexToRGB(hex)
+	return fmt.Sprintf("#%02X%02X%02X", int(float64(r)*brightness), int(float64(g)*brightness), int(float64(b)*brightness))
 }
 
 // clamp ensures a value is within a specified range
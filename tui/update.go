@@ -11,28 +11,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handleKeyPress(msg)
 
 	case tea.WindowSizeMsg:
-		// Enforce minimum terminal dimensions (at least reasonable full screen)
-		minWidth := 100
-		minHeight := 30
-
 		m.width = msg.Width
 		m.height = msg.Height
 
-		// Check if terminal is too small
-		if m.width < minWidth || m.height < minHeight {
-			// Terminal too small - show warning instead
-			m.width = msg.Width
-			m.height = msg.Height
-			m.canvasHeight = 5
-			return m, nil
-		}
-
-		// Canvas takes up maximum available screen space
-		// Total overhead: Border (2) + Selectors (4) + Guidance (1) + Help (1) = 8 lines
-		m.canvasHeight = m.height - 8 // Account for canvas border + UI elements
-		if m.canvasHeight < 20 {
-			m.canvasHeight = 20 // Minimum viewport height
-		}
+		// Canvas takes up whatever the selectors, help and guidance leave over
+		m.canvasHeight = m.layout().canvasHeight
 		// Update textarea size if in editor mode
 		if m.editorMode {
 			m.textarea.SetWidth(m.width - 10)
@@ -81,6 +64,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tickCmd()
 		}
 		return m, nil
+
+	case PreviewMsg:
+		// Only the last of a burst of selection changes restarts the preview
+		if !m.autoPreview || msg.seq != m.previewSeq {
+			return m, nil
+		}
+		return m.restartPreview()
 	}
 
 	return m, nil
@@ -103,16 +93,45 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Quit
 	}
 
-	// If animation is running, only allow ESC to stop it
+	if msg.String() == "p" {
+		m.autoPreview = !m.autoPreview
+		return m.selectionChanged()
+	}
+
+	// Grab the frame the preview is showing
+	if m.animationRunning && m.currentAnim != nil {
+		switch msg.String() {
+		case "ctrl+s":
+			return m.exportCurrentFrame(), nil
+		case "y":
+			return m.copyCurrentFrame(), nil
+		}
+	}
+
+	// Tab opens and closes the parameter panel, which takes over the arrow keys
+	if msg.String() == "tab" {
+		m.showParams = !m.showParams
+		m.paramFocus = 0
+		return m, nil
+	}
+	if m.showParams {
+		return m.handleParamKeyPress(msg)
+	}
+
+	// If animation is running, only allow ESC to stop it, unless
+	// auto-preview is on so the selection can be browsed while it runs
 	if m.animationRunning {
 		if msg.String() == "esc" {
 			m.animationRunning = false
 			m.currentAnim = nil
 			m.animFrames = 0
+			m.frameStatus = ""
+			return m, nil
+		}
+		if !m.autoPreview {
+			// Ignore other keys while animation is running
 			return m, nil
 		}
-		// Ignore other keys while animation is running
-		return m, nil
 	}
 
 	// Normal navigation when not running animation
@@ -128,10 +147,10 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case "up":
-		return m.navigateUp(), nil
+		return m.navigateUp().selectionChanged()
 
 	case "down":
-		return m.navigateDown(), nil
+		return m.navigateDown().selectionChanged()
 
 	case "left":
 		return m.navigateLeft(), nil
@@ -148,8 +167,8 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // navigateUp moves the selection up within the current selector
 func (m Model) navigateUp() Model {
-	// Don't allow navigation while animation is running
-	if m.animationRunning {
+	// Don't allow navigation while animation is running, unless auto-previewing
+	if m.animationRunning && !m.autoPreview {
 		return m
 	}
 
@@ -176,8 +195,8 @@ func (m Model) navigateUp() Model {
 
 // navigateDown moves the selection down within the current selector
 func (m Model) navigateDown() Model {
-	// Don't allow navigation while animation is running
-	if m.animationRunning {
+	// Don't allow navigation while animation is running, unless auto-previewing
+	if m.animationRunning && !m.autoPreview {
 		return m
 	}
 
@@ -204,8 +223,8 @@ func (m Model) navigateDown() Model {
 
 // navigateLeft moves focus to the previous selector
 func (m Model) navigateLeft() Model {
-	// Don't allow navigation while animation is running
-	if m.animationRunning {
+	// Don't allow navigation while animation is running, unless auto-previewing
+	if m.animationRunning && !m.autoPreview {
 		return m
 	}
 
@@ -217,8 +236,8 @@ func (m Model) navigateLeft() Model {
 
 // navigateRight moves focus to the next selector
 func (m Model) navigateRight() Model {
-	// Don't allow navigation while animation is running
-	if m.animationRunning {
+	// Don't allow navigation while animation is running, unless auto-previewing
+	if m.animationRunning && !m.autoPreview {
 		return m
 	}
 
@@ -228,6 +247,79 @@ func (m Model) navigateRight() Model {
 	return m
 }
 
+// handleParamKeyPress handles the parameter panel: ↑/↓ pick a parameter and
+// ←/→ change it, rebuilding the running effect with the new value
+func (m Model) handleParamKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	params := effectParams[m.animations[m.selectedAnimation]]
+
+	switch msg.String() {
+	case "esc":
+		m.showParams = false
+
+	case "up":
+		if m.paramFocus > 0 {
+			m.paramFocus--
+		}
+
+	case "down":
+		if m.paramFocus < len(params)-1 {
+			m.paramFocus++
+		}
+
+	case "left", "right":
+		delta := 1
+		if msg.String() == "left" {
+			delta = -1
+		}
+		m = m.adjustParam(delta)
+		if m.animationRunning && !isEditorFile(m.files[m.selectedFile]) {
+			if anim := m.createAnimation(); anim != nil {
+				m.currentAnim = anim
+				m.animFrames = 0
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// selectionChanged schedules an auto-preview restart once the selection
+// stops changing, so holding an arrow key doesn't rebuild every effect on the way
+func (m Model) selectionChanged() (Model, tea.Cmd) {
+	if !m.autoPreview {
+		return m, nil
+	}
+	m.previewSeq++
+	return m, previewCmd(m.previewSeq)
+}
+
+// restartPreview replaces the running animation with one built from the current
+// selection, or starts one if nothing is running. The editors are never opened.
+func (m Model) restartPreview() (Model, tea.Cmd) {
+	if isEditorFile(m.files[m.selectedFile]) {
+		return m, nil
+	}
+
+	anim := m.createAnimation()
+	if anim == nil {
+		return m, nil
+	}
+
+	m.currentAnim = anim
+	m.animFrames = 0
+	if m.animationRunning {
+		// The tick loop is already going
+		return m, nil
+	}
+	m.animationRunning = true
+	return m, tickCmd()
+}
+
+// isEditorFile reports whether a file selector entry opens an editor instead of a file
+func isEditorFile(fileName string) bool {
+	return fileName == "BIT Text Editor" || fileName == "Custom text"
+}
+
 // startAnimation creates and starts the animation in viewport
 func (m Model) startAnimation() (Model, tea.Cmd) {
 	// Create animation instance (this may set editor mode instead)
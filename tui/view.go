@@ -2,10 +2,51 @@ package tui
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
+// Absolute floor below which the selectors can't be laid out at all
+const (
+	minTerminalWidth  = 40
+	minTerminalHeight = 15
+)
+
+// minGuidedCanvasHeight is the smallest canvas worth keeping the guidance line for
+const minGuidedCanvasHeight = 10
+
+// tuiLayout holds the sizes of the main view's sections for the current terminal size
+type tuiLayout struct {
+	canvasWidth   int // Animation area inside the canvas border
+	canvasHeight  int
+	selectorWidth int
+	showGuidance  bool
+}
+
+// layout fits the main view to the terminal. The selectors and help always
+// show, the guidance line is dropped when space is tight, and the canvas
+// gets whatever is left.
+func (m Model) layout() tuiLayout {
+	l := tuiLayout{
+		canvasWidth:   max(m.width-10, 1),
+		selectorWidth: min(20, m.width/4),
+	}
+
+	// Canvas border (2) + Selectors (4) + Help, which wraps on narrow terminals.
+	// The longer idle help is measured so the canvas keeps its size while running.
+	overhead := 2 + 4 + lipgloss.Height(lipgloss.NewStyle().Width(m.width).Render(idleHelp))
+	if m.showParams {
+		overhead += 3 // Parameter panel and its border
+	}
+	l.showGuidance = m.height-overhead-1 >= minGuidedCanvasHeight
+	if l.showGuidance {
+		overhead++
+	}
+	l.canvasHeight = max(m.height-overhead, 1)
+	return l
+}
+
 // View renders the TUI
 func (m Model) View() string {
 	if m.width == 0 {
@@ -13,14 +54,13 @@ func (m Model) View() string {
 	}
 
 	// Check if terminal is too small
-	if m.width < 100 || m.height < 30 {
+	if m.width < minTerminalWidth || m.height < minTerminalHeight {
 		warning := fmt.Sprintf(
 			"Terminal too small!\n\n"+
 				"Current: %dx%d\n"+
-				"Minimum: 100x30\n\n"+
-				"Please resize your terminal to at least full screen.\n"+
+				"Minimum: %dx%d\n\n"+
 				"Press Q to quit.",
-			m.width, m.height,
+			m.width, m.height, minTerminalWidth, minTerminalHeight,
 		)
 		return warning
 	}
@@ -36,15 +76,23 @@ func (m Model) View() string {
 	}
 
 	var sections []string
+	layout := m.layout()
 
 	// Canvas area (viewport for animations)
-	sections = append(sections, m.renderCanvas())
+	sections = append(sections, m.renderCanvas(layout))
 
 	// Selector area
-	sections = append(sections, m.renderSelectors())
+	sections = append(sections, m.renderSelectors(layout))
 
-	// Guidance box (explains current selection)
-	sections = append(sections, m.renderGuidance())
+	// Parameter panel for the selected effect
+	if m.showParams {
+		sections = append(sections, m.renderParams(layout))
+	}
+
+	// Guidance box (explains current selection), dropped when space is tight
+	if layout.showGuidance {
+		sections = append(sections, m.renderGuidance())
+	}
 
 	// Help text (no j/k hints)
 	sections = append(sections, m.renderHelp())
@@ -62,7 +110,7 @@ func (m Model) View() string {
 }
 
 // renderCanvas renders the animation preview viewport
-func (m Model) renderCanvas() string {
+func (m Model) renderCanvas(layout tuiLayout) string {
 	var content string
 	if m.animationRunning && m.currentAnim != nil {
 		// Render actual animation frame (raw content)
@@ -72,19 +120,17 @@ func (m Model) renderCanvas() string {
 		content = m.renderWelcome()
 	}
 
-	// Calculate viewport dimensions - 80% of terminal height, full width minus padding
-	viewportHeight := int(float64(m.height) * 0.8)
-	viewportWidth := m.width - 10 // Leave some margin
-
 	// Wrap raw content in a styled box WITHOUT transforming the content itself
 	// Pattern from installer/sysc-greet: only border, NO padding/align on ASCII
-	// Set explicit dimensions for 80% height viewport
+	// Set explicit dimensions so the viewport matches the animation size
 	// NO Align() here - that distorts ASCII. Centering happens at outer container.
+	// MaxHeight crops the welcome screen when the canvas is smaller than it.
 	return lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color("#88C0D0")).
-		Width(viewportWidth).
-		Height(viewportHeight).
+		Width(layout.canvasWidth).
+		Height(layout.canvasHeight).
+		MaxHeight(layout.canvasHeight + 2).
 		Render(content)
 }
 
@@ -108,19 +154,25 @@ Press ESC to stop preview`
 }
 
 // renderSelectors renders the selector controls
-func (m Model) renderSelectors() string {
+func (m Model) renderSelectors(layout tuiLayout) string {
+	// Narrow selectors get short labels so their title boxes still fit
+	labels := []string{"Animation", "Theme", "File", "Duration"}
+	if layout.selectorWidth < 16 {
+		labels = []string{"Anim", "Theme", "File", "Time"}
+	}
+
 	selectors := []string{
-		m.renderSelector(0, "Animation", m.animations[m.selectedAnimation]),
-		m.renderSelector(1, "Theme", m.themes[m.selectedTheme]),
-		m.renderSelector(2, "File", m.files[m.selectedFile]),
-		m.renderSelector(3, "Duration", m.durations[m.selectedDuration]),
+		m.renderSelector(0, labels[0], m.animations[m.selectedAnimation], layout.selectorWidth),
+		m.renderSelector(1, labels[1], m.themes[m.selectedTheme], layout.selectorWidth),
+		m.renderSelector(2, labels[2], m.files[m.selectedFile], layout.selectorWidth),
+		m.renderSelector(3, labels[3], m.durations[m.selectedDuration], layout.selectorWidth),
 	}
 
 	return lipgloss.JoinHorizontal(lipgloss.Top, selectors...)
 }
 
-// renderSelector renders a single selector
-func (m Model) renderSelector(index int, label, value string) string {
+// renderSelector renders a single selector width columns wide
+func (m Model) renderSelector(index int, label, value string, width int) string {
 	// Check if this is the File selector and current animation doesn't need a file
 	isFileSelector := (index == 2)
 	animName := m.animations[m.selectedAnimation]
@@ -133,7 +185,7 @@ func (m Model) renderSelector(index int, label, value string) string {
 	}
 
 	// Truncate long values
-	maxValueLen := 14
+	maxValueLen := min(14, width-2)
 	if len(value) > maxValueLen {
 		value = value[:maxValueLen-2] + ".."
 	}
@@ -175,22 +227,70 @@ func (m Model) renderSelector(index int, label, value string) string {
 
 	// Outer container - minimal styling, just width constraint
 	container := lipgloss.NewStyle().
-		Width(20).
+		Width(width).
 		Align(lipgloss.Center, lipgloss.Top)
 
 	content := lipgloss.JoinVertical(lipgloss.Center, title, val)
 	return container.Render(content)
 }
 
+// renderParams renders the parameter panel as one line of knobs for the selected effect
+func (m Model) renderParams(layout tuiLayout) string {
+	anim := m.animations[m.selectedAnimation]
+	params := effectParams[anim]
+
+	var items []string
+	for i, param := range params {
+		value := param.choices[m.paramIndex(anim, param)]
+		if i == m.paramFocus {
+			items = append(items, lipgloss.NewStyle().
+				Background(lipgloss.Color("#88C0D0")).
+				Foreground(lipgloss.Color("#2E3440")).
+				Bold(true).
+				Render(fmt.Sprintf("%s ◂ %s ▸", param.label, value)))
+		} else {
+			items = append(items, lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#ECEFF4")).
+				Render(fmt.Sprintf("%s %s", param.label, value)))
+		}
+	}
+
+	content := strings.Join(items, "   ")
+	if len(params) == 0 {
+		content = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#4C566A")).
+			Render("No parameters for " + anim)
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#4C566A")).
+		Padding(0, 1).
+		MaxWidth(layout.canvasWidth + 2).
+		Render(content)
+}
+
 // renderHelp renders the help text
 func (m Model) renderHelp() string {
-	var helpText string
+	return m.styles.Help.Render(m.helpText())
+}
+
+// Key help shown below the selectors
+const (
+	idleHelp    = "↑/↓ Navigate options • ←/→ Change selector • ENTER Start animation • Tab Parameters • P Auto-preview • Ctrl+B BIT Editor • Q Quit"
+	runningHelp = "ESC Stop animation • Ctrl+S Save frame • Y Copy frame • Tab Parameters • P Auto-preview"
+	paramsHelp  = "↑/↓ Pick parameter • ←/→ Change value • Tab/ESC Close parameters"
+)
+
+// helpText returns the key help for the current state
+func (m Model) helpText() string {
+	if m.showParams {
+		return paramsHelp
+	}
 	if m.animationRunning {
-		helpText = "ESC Stop animation • ↑/↓ Navigate options • ←/→ Change selector"
-	} else {
-		helpText = "↑/↓ Navigate options • ←/→ Change selector • ENTER Start animation • Ctrl+B BIT Editor • Q Quit"
+		return runningHelp
 	}
-	return m.styles.Help.Render(helpText)
+	return idleHelp
 }
 
 // renderGuidance renders guidance/explainer box for current selection
@@ -241,6 +341,14 @@ func (m Model) renderGuidance() string {
 		guidance += " • " + displayName
 	}
 
+	if m.autoPreview {
+		guidance += " • Auto-preview on"
+	}
+
+	if m.frameStatus != "" {
+		guidance += " • " + m.frameStatus
+	}
+
 	return m.styles.GuidanceBox.Render(guidance)
 }
 